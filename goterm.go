@@ -18,14 +18,20 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 	"runtime/pprof"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/wardle/go-terminology/server"
+	"github.com/wardle/go-terminology/snomed"
 	"github.com/wardle/go-terminology/terminology"
+	"golang.org/x/text/language"
 )
 
 // automatically populated by linker flags
@@ -34,12 +40,38 @@ var build string
 
 // commands and flags
 var doVersion = flag.Bool("version", false, "show version information")
-var doImport = flag.Bool("import", false, "import SNOMED-CT data files from directories specified")
+var doImport = flag.Bool("import", false, "import SNOMED-CT data files from directories or ZIP archives specified")
+var full = flag.Bool("full", false, "import full rather than snapshot release files, recording every version of every component")
+var delta = flag.Bool("delta", false, "import delta rather than snapshot release files, updating any precomputations incrementally, or export a delta with -rf2")
+var strict = flag.Bool("strict", false, "stop an import at the first error in a file, rather than skipping and reporting rows in error")
 var runserver = flag.Bool("server", false, "run terminology server")
+var edition = flag.String("edition", "", "JSON file declaring the edition to import: its modules, in order of precedence, and any version constraints")
+var modules = flag.Bool("modules", false, "list the installed modules and their versions, reporting any unsatisfied dependencies")
+var extension = flag.String("extension", "", "configure the local extension in which components are authored, as namespace:module (e.g. 1000230:999000011000000103)")
+var languageTag = flag.String("language-tag", "", "configure the language of a language reference set, as refset:tag, such as to distinguish a dialect (e.g. 61000202103:nb-NO)")
+var author = flag.Bool("author", false, "enable the authoring service for the local extension on the admin port when running a server, opening the database for writing")
+var feedback = flag.String("feedback", "", "path to a record of the concepts selected from search results when running a server, enabling the search feedback service")
+var feedbackRanking = flag.Bool("feedback-ranking", true, "rank search results using the concepts previously selected for similar searches, if -feedback is specified")
+var feedbackHalfLife = flag.Duration("feedback-halflife", terminology.DefaultFeedbackHalfLife, "period after which a selection from search results counts half as much, 0 for no decay")
+var dictionary = flag.String("dictionary", "", "path to a local dictionary of synonyms and abbreviations merged into search results when running a server")
+var loadDictionary = flag.String("load-dictionary", "", "load a dictionary file, with a term and a concept identifier or expansion on each line separated by a tab, into the local dictionary")
 var precompute = flag.Bool("precompute", false, "perform precomputations and optimisations")
 var reset = flag.Bool("reset", false, "clear precomputations and optimisations")
 var stats = flag.Bool("status", false, "get statistics")
+var check = flag.Bool("check", false, "check the integrity of the database, reporting any problems in JSON format to stdout")
+var diff = flag.Bool("diff", false, "report the differences between releases: either the database specified and a newer database given as an argument,\nor two dates (YYYYMMDD) given as arguments for a database with recorded history")
+var format = flag.String("format", "text", "output format for reports: text or json")
+var refsets = flag.String("refsets", "", "comma-separated list of reference sets for which membership changes should be reported, or to which an RF2 export is limited")
 var export = flag.Bool("export", false, "export expanded descriptions in delimited protobuf format to stdout")
+var rf2 = flag.String("rf2", "", "export RF2 release files to the directory specified: a snapshot, or with -delta, the changes since the date given by -since")
+var since = flag.String("since", "", "date (YYYYMMDD) of the previous release, for an RF2 delta export")
+var exportModules = flag.String("export-modules", "", "comma-separated list of modules to which an RF2 export is limited, such as a local extension")
+var namespace = flag.String("namespace", "INT", "the country and namespace element of the filenames of an RF2 export, such as GB1000230")
+var convert = flag.String("convert", "", "convert the RF2 release files in the directories or ZIP archives specified into files in the directory specified,\nin the format given by -to, without a database")
+var to = flag.String("to", "jsonl", "format of the files written by -convert: jsonl or parquet")
+var extract = flag.String("extract", "", "extract a subset, defined by -ecl and/or -roots, into a new precomputed database at the path specified,\nwith the reference sets given by -refsets and descriptions in the languages given by -lang")
+var ecl = flag.String("ecl", "", "expression constraint (ECL) defining the concepts of a subset to extract")
+var roots = flag.String("roots", "", "comma-separated list of concepts which, with their descendants, define a subset to extract")
 
 // general flags
 var database = flag.String("db", "", "filename of database to open or create (e.g. ./snomed.db).\nCan also be set using environmental variable GTS_DATABASE")
@@ -48,6 +80,9 @@ var verbose = flag.Bool("v", false, "show verbose information")
 var cpuprofile = flag.String("cpuprofile", "", "write cpu profile to file specified")
 var port = flag.Int("port", 8081, "port to use for http server")
 var grpc = flag.Int("grpc", 9091, "port to use for grpc server")
+var admin = flag.Int("admin", 0, "port to use for the grpc admin service, listening only on localhost, or 0 to disable")
+var databases = flag.String("databases", "", "directory containing the databases to which the admin service may swap the server, by name")
+var cacheSize = flag.Int("cache", terminology.DefaultCacheSize, "maximum number of entries in each in-memory cache, 0 to disable")
 
 func main() {
 	flag.Parse()
@@ -55,6 +90,12 @@ func main() {
 		fmt.Printf("%s v%s (%s)\n", os.Args[0], version, build)
 		os.Exit(1)
 	}
+	if *convert != "" {
+		if err := convertRF2(*convert); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 	if *database == "" {
 		*database = os.Getenv("GTS_DATABASE")
 		if *database == "" {
@@ -64,7 +105,7 @@ func main() {
 		}
 	}
 	readOnly := true
-	if *doImport || *precompute || *reset {
+	if *doImport || *precompute || *reset || *extension != "" || *languageTag != "" || (*runserver && *author) {
 		readOnly = false
 	}
 	svc, err := terminology.NewService(*database, readOnly)
@@ -72,6 +113,7 @@ func main() {
 		log.Fatalf("couldn't open database: %v", err)
 	}
 	defer svc.Close()
+	svc.SetCacheSize(*cacheSize)
 
 	// turn on CPU profiling if a profile file is specified
 	if *cpuprofile != "" {
@@ -94,19 +136,81 @@ func main() {
 	if *doImport {
 		help = false
 		if flag.NArg() == 0 {
-			log.Fatalf("no input directories specified")
+			log.Fatalf("no input directories or archives specified")
+		}
+		ctx := context.Background()
+		if *edition != "" {
+			e, err := terminology.ReadEdition(*edition)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err := svc.SetEdition(e); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if *full {
+			if err := svc.RecordHistory(true); err != nil {
+				log.Fatal(err)
+			}
 		}
 		for _, filename := range flag.Args() {
-			ctx := context.Background()
 			importer := terminology.NewImporter(svc, 5000, 0, *verbose)
-			importer.Import(ctx, filename)
+			importer.SetStrict(*strict)
+			if *delta {
+				importer.SetReleaseType(snomed.Delta)
+			} else if *full {
+				importer.SetReleaseType(snomed.Full)
+			}
+			err := importer.Import(ctx, filename)
+			summary := importer.Summary()
+			fmt.Fprintf(os.Stderr, "%s: %v", filename, &summary)
+			if err != nil {
+				log.Fatalf("import failed; re-run the same import to resume: %v", err)
+			}
+		}
+		if err := svc.ClearImportCheckpoints(); err != nil {
+			log.Fatal(err)
+		}
+		// update indices for only those concepts affected, if the database was already precomputed
+		if err := svc.PerformIncrementalPrecomputations(ctx, *verbose); err != nil {
+			log.Fatal(err)
+		}
+		if svc.Precomputed() {
+			checkEdition(svc)
+		}
+	}
+
+	// configure the local extension, if requested
+	if *extension != "" {
+		help = false
+		if err := setExtension(svc, *extension); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// configure the language of a language reference set, if requested
+	if *languageTag != "" {
+		help = false
+		if err := setLanguageTag(svc, *languageTag); err != nil {
+			log.Fatal(err)
 		}
 	}
 
 	// perform precomputations if requested
 	if *precompute {
 		help = false
-		svc.PerformPrecomputations(context.Background(), 500, *verbose)
+		if err := svc.PerformPrecomputations(context.Background(), 500, *verbose); err != nil {
+			log.Fatalf("precomputations failed; re-run to resume: %v", err)
+		}
+		checkEdition(svc)
+	}
+
+	// list installed modules
+	if *modules {
+		help = false
+		if !listModules(svc) {
+			os.Exit(1)
+		}
 	}
 
 	// get statistics on store
@@ -119,6 +223,43 @@ func main() {
 		fmt.Printf("%v", s)
 	}
 
+	// check integrity of store
+	if *check {
+		help = false
+		report, err := svc.Verify(context.Background(), *verbose)
+		if err != nil {
+			log.Fatal(err)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(os.Stderr, "%v", report)
+		if !report.OK() {
+			os.Exit(1)
+		}
+	}
+
+	// compare two releases
+	if *diff {
+		help = false
+		report, err := compare(svc)
+		if err != nil {
+			log.Fatal(err)
+		}
+		switch *format {
+		case "json":
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(report); err != nil {
+				log.Fatal(err)
+			}
+		default:
+			fmt.Printf("%v", report)
+		}
+	}
+
 	// export descriptions data in expanded denormalised format
 	if *export {
 		help = false
@@ -128,6 +269,30 @@ func main() {
 		}
 	}
 
+	// export RF2 release files
+	if *rf2 != "" {
+		help = false
+		if err := exportRF2(svc, *rf2); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// extract a subset into a new database
+	if *extract != "" {
+		help = false
+		if err := extractSubset(svc, *extract); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// load local synonyms and abbreviations into a local dictionary
+	if *loadDictionary != "" {
+		help = false
+		if err := loadDictionaryFile(*dictionary, *loadDictionary); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	// optionally run a terminology server
 	if *runserver {
 		help = false
@@ -138,9 +303,291 @@ func main() {
 			opts.RPCPort = *grpc
 		}
 		opts.DefaultLanguage = *lang
+		opts.DatabasePath = *database
+		opts.AdminPort = *admin
+		opts.DatabaseDir = *databases
+		opts.Authoring = *author
+		opts.FeedbackPath = *feedback
+		opts.FeedbackRanking = *feedbackRanking
+		opts.FeedbackHalfLife = *feedbackHalfLife
+		opts.DictionaryPath = *dictionary
+		if *verbose {
+			go logCacheStatistics(svc, time.Minute)
+		}
 		log.Fatal(server.RunServer(svc, *opts))
 	}
 	if help {
 		flag.PrintDefaults()
 	}
 }
+
+// setExtension configures the local extension from a specification of the form namespace:module
+func setExtension(svc *terminology.Svc, spec string) error {
+	parts := strings.Split(spec, ":")
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid extension '%s': expected namespace:module", spec)
+	}
+	namespace, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid extension namespace '%s': %w", parts[0], err)
+	}
+	moduleID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid extension module '%s': %w", parts[1], err)
+	}
+	return svc.SetExtension(namespace, moduleID)
+}
+
+func setLanguageTag(svc *terminology.Svc, spec string) error {
+	parts := strings.SplitN(spec, ":", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid language tag '%s': expected refset:tag", spec)
+	}
+	refsetID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid language reference set '%s': %w", parts[0], err)
+	}
+	tag, err := language.Parse(parts[1])
+	if err != nil {
+		return fmt.Errorf("invalid language tag '%s': %w", parts[1], err)
+	}
+	return svc.SetLanguageTag(refsetID, tag)
+}
+
+// checkEdition exits if the dependencies of the declared edition, if any, are not satisfied
+func checkEdition(svc *terminology.Svc) {
+	if svc.Edition == nil {
+		return
+	}
+	unsatisfied, err := svc.CheckDependencies()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, ud := range unsatisfied {
+		log.Printf("unsatisfied dependency: %v", ud)
+	}
+	if len(unsatisfied) > 0 {
+		log.Fatalf("edition '%s' is not consistent: %d unsatisfied dependencies", svc.Edition.Name, len(unsatisfied))
+	}
+}
+
+// listModules prints the installed modules and any unsatisfied dependencies, returning whether all are satisfied
+func listModules(svc *terminology.Svc) bool {
+	mods, err := svc.Modules()
+	if err != nil {
+		log.Fatal(err)
+	}
+	unsatisfied, err := svc.CheckDependencies()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(struct {
+			Modules     []*terminology.Module
+			Unsatisfied []terminology.UnsatisfiedDependency
+		}{mods, unsatisfied}); err != nil {
+			log.Fatal(err)
+		}
+		return len(unsatisfied) == 0
+	}
+	tags, _, err := language.ParseAcceptLanguage(*lang)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, m := range mods {
+		term := ""
+		if d, err := svc.PreferredSynonym(m.ID, tags); err == nil {
+			term = d.Term
+		}
+		fmt.Printf("%d\t%s\t%s\t%s\n", m.ID, m.Version, m.Latest, term)
+		for _, dep := range m.Dependencies {
+			fmt.Printf("\t-> %d %s\n", dep.ModuleID, dep.Version)
+		}
+	}
+	for _, ud := range unsatisfied {
+		fmt.Printf("unsatisfied: %v\n", ud)
+	}
+	return len(unsatisfied) == 0
+}
+
+// parseIdentifiers parses a comma-separated list of identifiers
+func parseIdentifiers(s string) ([]int64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var result []int64
+	for _, v := range strings.Split(s, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid identifier '%s': %w", v, err)
+		}
+		result = append(result, id)
+	}
+	return result, nil
+}
+
+// exportRF2 exports RF2 release files, as specified by the command-line arguments
+func exportRF2(svc *terminology.Svc, dir string) error {
+	opts := terminology.RF2Options{Namespace: *namespace}
+	var err error
+	if opts.Modules, err = parseIdentifiers(*exportModules); err != nil {
+		return err
+	}
+	if opts.Refsets, err = parseIdentifiers(*refsets); err != nil {
+		return err
+	}
+	if *delta {
+		opts.Release = snomed.Delta
+		if opts.Since, err = time.Parse("20060102", *since); err != nil {
+			return fmt.Errorf("invalid date of previous release '%s': %w", *since, err)
+		}
+	}
+	files, err := svc.ExportRF2(context.Background(), dir, opts)
+	for filename, count := range files {
+		fmt.Fprintf(os.Stderr, "%s: %d\n", filename, count)
+	}
+	return err
+}
+
+// convertRF2 converts RF2 release files into files of the format specified by the command-line arguments
+func convertRF2(dir string) error {
+	if flag.NArg() == 0 {
+		return fmt.Errorf("no input directories or archives specified")
+	}
+	var storer terminology.FileStorer
+	var err error
+	switch *to {
+	case "jsonl":
+		storer, err = terminology.NewJSONLinesStorer(dir)
+	case "parquet":
+		storer, err = terminology.NewParquetStorer(dir)
+	default:
+		err = fmt.Errorf("unsupported format for conversion: %s", *to)
+	}
+	if err != nil {
+		return err
+	}
+	for _, filename := range flag.Args() {
+		importer := terminology.NewImporter(storer, 5000, 0, *verbose)
+		importer.SetStrict(*strict)
+		if *delta {
+			importer.SetReleaseType(snomed.Delta)
+		} else if *full {
+			importer.SetReleaseType(snomed.Full)
+		}
+		err := importer.Import(context.Background(), filename)
+		summary := importer.Summary()
+		fmt.Fprintf(os.Stderr, "%s: %v", filename, &summary)
+		if err != nil {
+			storer.Close()
+			return err
+		}
+	}
+	return storer.Close()
+}
+
+// extractSubset extracts a subset into a new database, as specified by the command-line arguments
+func extractSubset(svc *terminology.Svc, path string) error {
+	if *ecl == "" && *roots == "" {
+		return fmt.Errorf("no concepts specified for subset: use -ecl and/or -roots")
+	}
+	var opts terminology.SubsetOptions
+	var err error
+	if opts.Roots, err = parseIdentifiers(*roots); err != nil {
+		return err
+	}
+	if opts.Refsets, err = parseIdentifiers(*refsets); err != nil {
+		return err
+	}
+	if opts.Languages, _, err = language.ParseAcceptLanguage(*lang); err != nil {
+		return err
+	}
+	ctx := context.Background()
+	if *ecl != "" {
+		if opts.Concepts, err = svc.ExpandConstraint(ctx, *ecl); err != nil {
+			return err
+		}
+	}
+	summary, err := svc.ExtractSubset(ctx, path, opts)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "extracted %d concepts, %d descriptions, %d relationships and %d reference set items to %s\n",
+		summary.Concepts, summary.Descriptions, summary.Relationships, summary.ReferenceSetItems, path)
+	return nil
+}
+
+// compare generates a report of the differences between two releases, as specified by the command-line arguments
+func compare(svc *terminology.Svc) (*terminology.DiffReport, error) {
+	opts := terminology.DiffOptions{}
+	tags, _, err := language.ParseAcceptLanguage(*lang)
+	if err != nil {
+		return nil, err
+	}
+	opts.Tags = tags
+	if opts.ReferenceSets, err = parseIdentifiers(*refsets); err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	switch flag.NArg() {
+	case 1:
+		other, err := terminology.NewService(flag.Arg(0), true)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't open database: %w", err)
+		}
+		defer other.Close()
+		return terminology.CompareServices(ctx, svc, other, opts)
+	case 2:
+		from, err := time.Parse("20060102", flag.Arg(0))
+		if err != nil {
+			return nil, err
+		}
+		to, err := time.Parse("20060102", flag.Arg(1))
+		if err != nil {
+			return nil, err
+		}
+		return svc.CompareDates(ctx, from, to, opts)
+	}
+	return nil, fmt.Errorf("specify either a database or two dates with which to compare")
+}
+
+// logCacheStatistics periodically logs the in-memory cache metrics
+func logCacheStatistics(svc *terminology.Svc, interval time.Duration) {
+	for range time.Tick(interval) {
+		for _, cs := range svc.CacheStatistics() {
+			log.Print(cs)
+		}
+	}
+}
+
+// loadDictionaryFile loads the entries of a dictionary file into the local dictionary at the path specified
+func loadDictionaryFile(path string, filename string) error {
+	if path == "" {
+		return fmt.Errorf("no local dictionary specified: use -dictionary")
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	entries, err := terminology.ReadDictionary(f)
+	if err != nil {
+		return fmt.Errorf("invalid dictionary %s: %w", filename, err)
+	}
+	d, err := terminology.OpenDictionary(path)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	if err := d.Add(entries); err != nil {
+		return err
+	}
+	total, err := d.Count()
+	if err != nil {
+		return err
+	}
+	log.Printf("loaded %d entries into local dictionary %s (%d entries)", len(entries), path, total)
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"github.com/wardle/go-terminology/terminology"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// authoringServer authors components in the local extension of the database in use by the server
+type authoringServer struct {
+	releases *registry
+	mu       sync.Mutex // serialises changes, and the updates to indices that follow
+}
+
+// author performs a change to the local extension, and updates any precomputed indices so that the change is
+// immediately visible to other requests.
+func (as *authoringServer) author(ctx context.Context, change func(svc *terminology.Svc) error) error {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	svc := as.releases.service(ctx)
+	if err := change(svc); err != nil {
+		switch {
+		case errors.Is(err, terminology.ErrNoExtension):
+			return status.Errorf(codes.FailedPrecondition, "%v", err)
+		case errors.Is(err, terminology.ErrNotFound):
+			return status.Errorf(codes.NotFound, "%v", err)
+		case errors.Is(err, terminology.ErrInvalidComponent):
+			return status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+	if svc.Precomputed() {
+		if err := svc.PerformIncrementalPrecomputations(ctx, false); err != nil {
+			return status.Errorf(codes.Internal, "failed to update indices: %v", err)
+		}
+	}
+	return nil
+}
+
+// effectiveTime returns the effective time requested, or a zero time if omitted, so that today is used
+func effectiveTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// CreateConcept creates a new concept in the local extension
+func (as *authoringServer) CreateConcept(ctx context.Context, r *snomed.ConceptChange) (result *snomed.Concept, err error) {
+	if r.Concept == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing concept")
+	}
+	err = as.author(ctx, func(svc *terminology.Svc) (err error) {
+		result, err = svc.CreateConcept(ctx, r.Concept, effectiveTime(r.EffectiveTime))
+		return
+	})
+	return
+}
+
+// CreateDescription creates a new description in the local extension
+func (as *authoringServer) CreateDescription(ctx context.Context, r *snomed.DescriptionChange) (result *snomed.Description, err error) {
+	if r.Description == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing description")
+	}
+	err = as.author(ctx, func(svc *terminology.Svc) (err error) {
+		result, err = svc.CreateDescription(ctx, r.Description, effectiveTime(r.EffectiveTime))
+		return
+	})
+	return
+}
+
+// CreateRelationship creates a new relationship in the local extension
+func (as *authoringServer) CreateRelationship(ctx context.Context, r *snomed.RelationshipChange) (result *snomed.Relationship, err error) {
+	if r.Relationship == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing relationship")
+	}
+	err = as.author(ctx, func(svc *terminology.Svc) (err error) {
+		result, err = svc.CreateRelationship(ctx, r.Relationship, effectiveTime(r.EffectiveTime))
+		return
+	})
+	return
+}
+
+// CreateReferenceSetItem creates a new reference set item in the local extension
+func (as *authoringServer) CreateReferenceSetItem(ctx context.Context, r *snomed.ReferenceSetItemChange) (result *snomed.ReferenceSetItem, err error) {
+	if r.Item == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing reference set item")
+	}
+	err = as.author(ctx, func(svc *terminology.Svc) (err error) {
+		result, err = svc.CreateReferenceSetItem(ctx, r.Item, effectiveTime(r.EffectiveTime))
+		return
+	})
+	return
+}
+
+// UpdateConcept records a new version of a concept in the local extension
+func (as *authoringServer) UpdateConcept(ctx context.Context, r *snomed.ConceptChange) (result *snomed.Concept, err error) {
+	if r.Concept == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing concept")
+	}
+	err = as.author(ctx, func(svc *terminology.Svc) error {
+		updated, err := svc.Update(ctx, r.Concept, effectiveTime(r.EffectiveTime))
+		if err == nil {
+			result = updated.(*snomed.Concept)
+		}
+		return err
+	})
+	return
+}
+
+// UpdateDescription records a new version of a description in the local extension
+func (as *authoringServer) UpdateDescription(ctx context.Context, r *snomed.DescriptionChange) (result *snomed.Description, err error) {
+	if r.Description == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing description")
+	}
+	err = as.author(ctx, func(svc *terminology.Svc) error {
+		updated, err := svc.Update(ctx, r.Description, effectiveTime(r.EffectiveTime))
+		if err == nil {
+			result = updated.(*snomed.Description)
+		}
+		return err
+	})
+	return
+}
+
+// UpdateRelationship records a new version of a relationship in the local extension
+func (as *authoringServer) UpdateRelationship(ctx context.Context, r *snomed.RelationshipChange) (result *snomed.Relationship, err error) {
+	if r.Relationship == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing relationship")
+	}
+	err = as.author(ctx, func(svc *terminology.Svc) error {
+		updated, err := svc.Update(ctx, r.Relationship, effectiveTime(r.EffectiveTime))
+		if err == nil {
+			result = updated.(*snomed.Relationship)
+		}
+		return err
+	})
+	return
+}
+
+// UpdateReferenceSetItem records a new version of a reference set item in the local extension
+func (as *authoringServer) UpdateReferenceSetItem(ctx context.Context, r *snomed.ReferenceSetItemChange) (result *snomed.ReferenceSetItem, err error) {
+	if r.Item == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing reference set item")
+	}
+	err = as.author(ctx, func(svc *terminology.Svc) error {
+		updated, err := svc.Update(ctx, r.Item, effectiveTime(r.EffectiveTime))
+		if err == nil {
+			result = updated.(*snomed.ReferenceSetItem)
+		}
+		return err
+	})
+	return
+}
+
+var _ snomed.AuthoringServer = (*authoringServer)(nil)
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -24,22 +25,35 @@ import (
 )
 
 type coreServer struct {
-	svc  *terminology.Svc
-	lang []language.Tag // default language to use, if not explitly requested
+	releases *registry
+	lang     []language.Tag        // default language to use, if not explitly requested
+	feedback *terminology.Feedback // record of selections from search results, if enabled
 }
 
 // Options defines the options for a server.
 type Options struct {
-	RPCPort         int
-	RESTPort        int
-	DefaultLanguage string
+	RPCPort          int
+	RESTPort         int
+	AdminPort        int // port for the admin service, listening only on the loopback interface, or 0 to disable
+	DefaultLanguage  string
+	DatabasePath     string        // path from which the database is reloaded on SIGHUP or admin request; may be a symlink
+	DatabaseDir      string        // directory containing the databases to which the admin service may swap, by name
+	WatchInterval    time.Duration // interval at which to check whether a symlinked database path has changed, 0 to disable
+	Authoring        bool          // whether to enable the authoring service on the admin port, which requires a database opened for writing
+	FeedbackPath     string        // path to a record of selections from search results, or empty to disable search feedback
+	FeedbackRanking  bool          // whether to rank search results using the selections recorded
+	FeedbackHalfLife time.Duration // period after which a selection counts half as much, 0 for no decay
+	DictionaryPath   string        // path to a local dictionary of synonyms and abbreviations, or empty to disable
 }
 
 // DefaultOptions provides some default options
 var DefaultOptions = &Options{
-	RPCPort:         8081,
-	RESTPort:        8080,
-	DefaultLanguage: "en-GB",
+	RPCPort:          8081,
+	RESTPort:         8080,
+	DefaultLanguage:  "en-GB",
+	WatchInterval:    time.Minute,
+	FeedbackRanking:  true,
+	FeedbackHalfLife: terminology.DefaultFeedbackHalfLife,
 }
 
 // RunServer runs a GRPC and a gateway REST server concurrently
@@ -57,9 +71,61 @@ func RunServer(svc *terminology.Svc, opts Options) error {
 	if err != nil {
 		return err
 	}
+	var feedback *terminology.Feedback
+	if opts.FeedbackPath != "" {
+		feedback, err = terminology.OpenFeedback(opts.FeedbackPath, opts.FeedbackHalfLife)
+		if err != nil {
+			return fmt.Errorf("failed to open search feedback: %w", err)
+		}
+		defer feedback.Close()
+	}
+	var dictionary *terminology.Dictionary
+	if opts.DictionaryPath != "" {
+		dictionary, err = terminology.OpenDictionary(opts.DictionaryPath)
+		if err != nil {
+			return fmt.Errorf("failed to open local dictionary: %w", err)
+		}
+		defer dictionary.Close()
+	}
+	releases := newRegistry(svc, opts.DatabasePath)
+	if opts.FeedbackRanking {
+		releases.setFeedback(feedback)
+	}
+	releases.setDictionary(dictionary)
+	if opts.DatabasePath != "" && !opts.Authoring { // a database is reloaded read-only, so cannot be authored
+		go releases.reloadOnSignal(ctx)
+		if opts.WatchInterval > 0 {
+			go releases.watch(ctx, opts.WatchInterval)
+		}
+	}
+	if opts.Authoring && opts.AdminPort == 0 {
+		return fmt.Errorf("the authoring service requires an admin port")
+	}
+	if opts.AdminPort > 0 {
+		adminLis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", opts.AdminPort))
+		if err != nil {
+			return fmt.Errorf("failed to initialise admin TCP listen: %v", err)
+		}
+		defer adminLis.Close()
+		go func() {
+			server := grpc.NewServer(
+				grpc.UnaryInterceptor(releases.unaryInterceptor),
+				grpc.StreamInterceptor(releases.streamInterceptor),
+			)
+			snomed.RegisterAdminServer(server, &adminServer{releases: releases, databases: opts.DatabaseDir, dictionary: dictionary})
+			if opts.Authoring {
+				snomed.RegisterAuthoringServer(server, &authoringServer{releases: releases})
+			}
+			log.Printf("gRPC admin service listening on %s\n", adminLis.Addr().String())
+			server.Serve(adminLis)
+		}()
+	}
 	go func() {
-		impl := &coreServer{svc: svc, lang: tags}
-		server := grpc.NewServer()
+		impl := &coreServer{releases: releases, lang: tags, feedback: feedback}
+		server := grpc.NewServer(
+			grpc.UnaryInterceptor(releases.unaryInterceptor),
+			grpc.StreamInterceptor(releases.streamInterceptor),
+		)
 		health.RegisterHealthServer(server, impl)
 		snomed.RegisterSnomedCTServer(server, impl)
 		snomed.RegisterSearchServer(server, impl)
@@ -71,6 +137,7 @@ func RunServer(svc *terminology.Svc, opts Options) error {
 	dialOpts := []grpc.DialOption{grpc.WithInsecure()} // TODO:use better options
 	mux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(headerMatcher),                                    // handle Accept-Language
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),                            // return release version
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: false}), // handle JSON camelcase
 	)
 	if err := snomed.RegisterSnomedCTHandlerFromEndpoint(ctx, mux, clientAddr, dialOpts); err != nil {
@@ -100,6 +167,14 @@ func headerMatcher(headerName string) (mdName string, ok bool) {
 	return runtime.DefaultHeaderMatcher(headerName)
 }
 
+// passes the release version to HTTP clients as a standard header, rather than prefixed with Grpc-Metadata-.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == releaseHeader {
+		return "X-Terminology-Release", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 // determine preferred language tags from the context, or fallback to a reasonable default
 func (ss *coreServer) languageTags(ctx context.Context) ([]language.Tag, error) {
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
@@ -116,7 +191,8 @@ func (ss *coreServer) languageTags(ctx context.Context) ([]language.Tag, error)
 	return ss.lang, nil
 }
 func (ss *coreServer) GetConcept(ctx context.Context, conceptID *snomed.SctID) (*snomed.Concept, error) {
-	c, err := ss.svc.Concept(conceptID.Identifier)
+	svc := ss.service(ctx)
+	c, err := svc.Concept(conceptID.Identifier)
 	if err == terminology.ErrNotFound {
 		return nil, status.Errorf(codes.NotFound, "Concept not found with identifier %d", conceptID.Identifier)
 	}
@@ -124,20 +200,22 @@ func (ss *coreServer) GetConcept(ctx context.Context, conceptID *snomed.SctID) (
 }
 
 func (ss *coreServer) GetExtendedConcept(ctx context.Context, conceptID *snomed.SctID) (*snomed.ExtendedConcept, error) {
+	svc := ss.service(ctx)
 	tags, err := ss.languageTags(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return ss.svc.ExtendedConcept(conceptID.Identifier, tags)
+	return svc.ExtendedConcept(conceptID.Identifier, tags)
 }
 
 func (ss *coreServer) GetReferenceSets(conceptID *snomed.SctID, server snomed.SnomedCT_GetReferenceSetsServer) error {
-	refsets, err := ss.svc.ComponentReferenceSets(conceptID.Identifier)
+	svc := ss.service(server.Context())
+	refsets, err := svc.ComponentReferenceSets(conceptID.Identifier)
 	if err != nil {
 		return err
 	}
 	for _, refsetID := range refsets {
-		items, err := ss.svc.ComponentFromReferenceSet(refsetID, conceptID.Identifier)
+		items, err := svc.ComponentFromReferenceSet(refsetID, conceptID.Identifier)
 		if err != nil {
 			return err
 		}
@@ -149,28 +227,48 @@ func (ss *coreServer) GetReferenceSets(conceptID *snomed.SctID, server snomed.Sn
 }
 
 func (ss *coreServer) GetReferenceSetItem(ctx context.Context, itemID *snomed.ReferenceSetItemID) (*snomed.ReferenceSetItem, error) {
-	return ss.svc.ReferenceSetItem(itemID.Identifier)
+	svc := ss.service(ctx)
+	return svc.ReferenceSetItem(itemID.Identifier)
+}
+
+// FindReferenceSetItems returns the items of a reference set with the specified value for an additional field
+func (ss *coreServer) FindReferenceSetItems(r *snomed.FindReferenceSetItemsRequest, server snomed.SnomedCT_FindReferenceSetItemsServer) error {
+	svc := ss.service(server.Context())
+	if r.Field == "" {
+		return status.Errorf(codes.InvalidArgument, "missing field name")
+	}
+	items, err := svc.FindReferenceSetItems(r.RefsetId, r.Field, r.Value)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := server.Send(item); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (ss *coreServer) GetDescriptions(ctx context.Context, conceptID *snomed.SctID) (*snomed.ConceptDescriptions, error) {
+	svc := ss.service(ctx)
 	tags, err := ss.languageTags(ctx)
 	if err != nil {
 		return nil, err
 	}
 	result := new(snomed.ConceptDescriptions)
-	if result.Concept, err = ss.svc.Concept(conceptID.Identifier); err != nil {
+	if result.Concept, err = svc.Concept(conceptID.Identifier); err != nil {
 		if err == terminology.ErrNotFound {
 			return nil, status.Errorf(codes.NotFound, "Concept not found with identifier %d", conceptID.Identifier)
 		}
 		return nil, err
 	}
-	result.PreferredDescription, err = ss.svc.PreferredSynonym(conceptID.Identifier, tags)
+	result.PreferredDescription, err = svc.PreferredSynonym(conceptID.Identifier, tags)
 	if err != nil {
 		return nil, err
 	}
 	synonyms := make([]*snomed.Description, 0)
 	definitions := make([]*snomed.Description, 0)
-	descs, err := ss.svc.Descriptions(conceptID.Identifier)
+	descs, err := svc.Descriptions(conceptID.Identifier)
 	if err != nil {
 		return nil, err
 	}
@@ -190,12 +288,13 @@ func (ss *coreServer) GetDescriptions(ctx context.Context, conceptID *snomed.Sct
 }
 
 func (ss *coreServer) GetAllChildren(conceptID *snomed.SctID, stream snomed.SnomedCT_GetAllChildrenServer) error {
+	svc := ss.service(stream.Context())
 	tags, err := ss.languageTags(stream.Context())
 	if err != nil {
 		return err
 	}
-	children := ss.svc.StreamAllChildrenIDs(stream.Context(), conceptID.Identifier, 1000000)
-	crch := ss.svc.StreamConceptReferences(stream.Context(), children, 4, tags)
+	children := svc.StreamAllChildrenIDs(stream.Context(), conceptID.Identifier, 1000000)
+	crch := svc.StreamConceptReferences(stream.Context(), children, 4, tags)
 	for cr := range crch {
 		if cr.Err != nil {
 			return cr.Err
@@ -206,7 +305,8 @@ func (ss *coreServer) GetAllChildren(conceptID *snomed.SctID, stream snomed.Snom
 }
 
 func (ss *coreServer) GetDescription(ctx context.Context, id *snomed.SctID) (*snomed.Description, error) {
-	d, err := ss.svc.Description(id.Identifier)
+	svc := ss.service(ctx)
+	d, err := svc.Description(id.Identifier)
 	if err == terminology.ErrNotFound {
 		return nil, status.Errorf(codes.NotFound, "Description not found with identifier %d", id.Identifier)
 	}
@@ -216,10 +316,11 @@ func (ss *coreServer) GetDescription(ctx context.Context, id *snomed.SctID) (*sn
 // CrossMap translates a SNOMED CT concept into an external code system, as defined by the map reference
 // set specified in this request.
 func (ss *coreServer) CrossMap(tr *snomed.CrossMapRequest, stream snomed.SnomedCT_CrossMapServer) error {
+	svc := ss.service(stream.Context())
 	if tr.RefsetId == 0 {
 		return status.Error(codes.InvalidArgument, "missing reference set identifier refset_id")
 	}
-	targets, err := ss.svc.ComponentFromReferenceSet(tr.RefsetId, tr.ConceptId)
+	targets, err := svc.ComponentFromReferenceSet(tr.RefsetId, tr.ConceptId)
 	if err != nil {
 		return err
 	}
@@ -234,13 +335,14 @@ func (ss *coreServer) CrossMap(tr *snomed.CrossMapRequest, stream snomed.SnomedC
 
 // Map translates a SNOMED CT concept into the best match in a destination simple reference set
 func (ss *coreServer) Map(ctx context.Context, tr *snomed.MapRequest) (*snomed.MapResponse, error) {
+	svc := ss.service(ctx)
 	tags, err := ss.languageTags(ctx)
 	if err != nil {
 		return nil, err
 	}
 	members := make(map[int64]struct{})
 	if tr.RefsetId != 0 {
-		members, err = ss.svc.ReferenceSetComponents(tr.RefsetId) // get all reference set members
+		members, err = svc.ReferenceSetComponents(tr.RefsetId) // get all reference set members
 		if err != nil {
 			return nil, err
 		}
@@ -262,7 +364,7 @@ func (ss *coreServer) Map(ctx context.Context, tr *snomed.MapRequest) (*snomed.M
 		includeParents = true
 	}
 DoMap:
-	mapped, err := ss.svc.GenericiseTo(tr.ConceptId, includeParents, members)
+	mapped, err := svc.GenericiseTo(tr.ConceptId, includeParents, members)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to map %d: %s", tr.ConceptId, err)
 	}
@@ -276,7 +378,7 @@ DoMap:
 	}
 	translations := make([]*snomed.ConceptReference, count)
 	for i, c := range mapped {
-		cr, err := ss.svc.ConceptReference(c, tags)
+		cr, err := svc.ConceptReference(c, tags)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "error: %v", err)
 		}
@@ -289,7 +391,8 @@ DoMap:
 
 // FromCrossMap translates an external code into SNOMED CT, if possible.
 func (ss *coreServer) FromCrossMap(ctx context.Context, r *snomed.TranslateFromRequest) (*snomed.TranslateFromResponse, error) {
-	items, err := ss.svc.MapTarget(r.RefsetId, r.S)
+	svc := ss.service(ctx)
+	items, err := svc.MapTarget(r.RefsetId, r.S)
 	if err != nil {
 		return nil, err
 	}
@@ -302,7 +405,7 @@ func (ss *coreServer) FromCrossMap(ctx context.Context, r *snomed.TranslateFromR
 		if !item.Active && !r.IncludeInactive {
 			continue
 		}
-		c, err := ss.svc.Concept(item.ReferencedComponentId)
+		c, err := svc.Concept(item.ReferencedComponentId)
 		if err != nil {
 			return nil, err
 		}
@@ -315,19 +418,19 @@ func (ss *coreServer) FromCrossMap(ctx context.Context, r *snomed.TranslateFromR
 		rItem.Concept = c
 		if !c.Active { // for inactive concepts, help the client by providing associations.
 			var err error
-			rItem.SameAs, err = ss.svc.GetAssociations(c.Id, snomed.SameAsReferenceSet)
+			rItem.SameAs, err = svc.GetAssociations(c.Id, snomed.SameAsReferenceSet)
 			if err != nil {
 				return nil, err
 			}
-			rItem.PossiblyEquivalentTo, err = ss.svc.GetAssociations(c.Id, snomed.PossiblyEquivalentToReferenceSet)
+			rItem.PossiblyEquivalentTo, err = svc.GetAssociations(c.Id, snomed.PossiblyEquivalentToReferenceSet)
 			if err != nil {
 				return nil, err
 			}
-			rItem.SimilarTo, err = ss.svc.GetAssociations(c.Id, snomed.SimilarToReferenceSet)
+			rItem.SimilarTo, err = svc.GetAssociations(c.Id, snomed.SimilarToReferenceSet)
 			if err != nil {
 				return nil, err
 			}
-			rItem.ReplacedBy, err = ss.svc.GetAssociations(c.Id, snomed.ReplacedByReferenceSet)
+			rItem.ReplacedBy, err = svc.GetAssociations(c.Id, snomed.ReplacedByReferenceSet)
 			if err != nil {
 				return nil, err
 			}
@@ -337,28 +440,69 @@ func (ss *coreServer) FromCrossMap(ctx context.Context, r *snomed.TranslateFromR
 	return response, nil
 }
 
+// GetModules returns the installed modules, their versions and dependencies, and any that are unsatisfied
+func (ss *coreServer) GetModules(ctx context.Context, r *snomed.ModulesRequest) (*snomed.ModulesResponse, error) {
+	svc := ss.service(ctx)
+	tags, err := ss.languageTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	modules, err := svc.Modules()
+	if err != nil {
+		return nil, err
+	}
+	unsatisfied, err := svc.CheckDependencies()
+	if err != nil {
+		return nil, err
+	}
+	res := &snomed.ModulesResponse{
+		Modules:     make([]*snomed.Module, len(modules)),
+		Unsatisfied: make([]*snomed.UnsatisfiedDependency, len(unsatisfied)),
+	}
+	for i, m := range modules {
+		module := &snomed.Module{Id: m.ID, Version: m.Version, Latest: m.Latest}
+		if d, err := svc.PreferredSynonym(m.ID, tags); err == nil {
+			module.Term = d.Term
+		}
+		for _, dep := range m.Dependencies {
+			module.Dependencies = append(module.Dependencies, &snomed.ModuleDependency{ModuleId: dep.ModuleID, Version: dep.Version})
+		}
+		res.Modules[i] = module
+	}
+	for i, ud := range unsatisfied {
+		res.Unsatisfied[i] = &snomed.UnsatisfiedDependency{
+			ModuleId:         ud.ModuleID,
+			DependsOn:        ud.DependsOn,
+			RequiredVersion:  ud.RequiredVersion,
+			InstalledVersion: ud.InstalledVersion,
+		}
+	}
+	return res, nil
+}
+
 // Subsumes determines whether code A subsumes code B, according to the definition
 // in the HL7 FHIR terminology service specification.
 // See https://www.hl7.org/fhir/terminology-service.html
 func (ss *coreServer) Subsumes(ctx context.Context, r *snomed.SubsumptionRequest) (*snomed.SubsumptionResponse, error) {
+	svc := ss.service(ctx)
 	res := snomed.SubsumptionResponse{}
 	if r.CodeA == r.CodeB {
 		res.Result = snomed.SubsumptionResponse_EQUIVALENT
 		return &res, nil
 	}
-	c, err := ss.svc.Concept(r.CodeB)
+	c, err := svc.Concept(r.CodeB)
 	if err != nil {
 		return nil, err
 	}
-	if ss.svc.IsA(c, r.CodeA) {
+	if svc.IsA(c, r.CodeA) {
 		res.Result = snomed.SubsumptionResponse_SUBSUMES
 		return &res, nil
 	}
-	c, err = ss.svc.Concept(r.CodeA)
+	c, err = svc.Concept(r.CodeA)
 	if err != nil {
 		return nil, err
 	}
-	if ss.svc.IsA(c, r.CodeB) {
+	if svc.IsA(c, r.CodeB) {
 		res.Result = snomed.SubsumptionResponse_SUBSUMED_BY
 		return &res, nil
 	}
@@ -377,18 +521,19 @@ func (ss *coreServer) Parse(ctx context.Context, r *snomed.ParseRequest) (*snome
 // that would mean normalising any concept into an expression and *then* deriving
 // possible refinements for that expression, instead.
 func (ss *coreServer) Refinements(ctx context.Context, r *snomed.RefinementRequest) (*snomed.RefinementResponse, error) {
+	svc := ss.service(ctx)
 	tags, err := ss.languageTags(ctx)
 	if err != nil {
 		return nil, err
 	}
-	c, err := ss.svc.Concept(r.ConceptId)
+	c, err := svc.Concept(r.ConceptId)
 	if err != nil {
 		if err == terminology.ErrNotFound {
 			return nil, status.Errorf(codes.NotFound, "Concept %d not found", r.ConceptId)
 		}
 		return nil, err
 	}
-	rels, err := ss.svc.ParentRelationships(c.Id)
+	rels, err := svc.ParentRelationships(c.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -400,7 +545,7 @@ func (ss *coreServer) Refinements(ctx context.Context, r *snomed.RefinementReque
 				continue
 			}
 			properties[rel.DestinationId] = struct{}{}
-			attr, err := makeRefinement(ctx, ss.svc, rel.TypeId, rel.DestinationId, tags)
+			attr, err := makeRefinement(ctx, svc, rel.TypeId, rel.DestinationId, tags)
 			if err != nil {
 				return nil, err
 			}
@@ -408,12 +553,12 @@ func (ss *coreServer) Refinements(ctx context.Context, r *snomed.RefinementReque
 
 			if rel.TypeId == snomed.BodyStructure || rel.TypeId == snomed.ProcedureSiteDirect || rel.TypeId == snomed.FindingSite {
 				if _, done := properties[snomed.Side]; !done {
-					islat, err := isLateralisable(ss.svc, rel.DestinationId)
+					islat, err := isLateralisable(svc, rel.DestinationId)
 					if err != nil {
 						return nil, err
 					}
 					if islat {
-						lat, err := makeRefinement(ctx, ss.svc, snomed.Laterality, snomed.Side, tags)
+						lat, err := makeRefinement(ctx, svc, snomed.Laterality, snomed.Side, tags)
 						if err != nil {
 							return nil, err
 						}
@@ -475,11 +620,12 @@ func isLateralisable(svc *terminology.Svc, id int64) (bool, error) {
 }
 
 func (ss *coreServer) Extract(ctx context.Context, r *snomed.ExtractRequest) (*snomed.ExtractResponse, error) {
+	svc := ss.service(ctx)
 	tags, err := ss.languageTags(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return ss.svc.Extract(r, tags)
+	return svc.Extract(ctx, r, tags)
 }
 
 var _ snomed.SnomedCTServer = (*coreServer)(nil)
@@ -497,18 +643,49 @@ func (ss *coreServer) Watch(r *health.HealthCheckRequest, w health.Health_WatchS
 }
 
 func (ss *coreServer) Search(ctx context.Context, sr *snomed.SearchRequest) (*snomed.SearchResponse, error) {
+	svc := ss.service(ctx)
 	tags, err := ss.languageTags(ctx)
 	if err != nil {
 		return nil, err
 	}
-	response, err := ss.svc.Search(sr, tags)
+	response, err := svc.Search(ctx, sr, tags)
+	if errors.Is(err, terminology.ErrInvalidConstraint) || errors.Is(err, terminology.ErrTooManyConcepts) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, context.Canceled) {
+		return nil, status.Error(codes.Canceled, err.Error())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
 	return response, nil
 }
 
+// SearchFeedback records the concept selected from the results of a search
+func (ss *coreServer) SearchFeedback(ctx context.Context, r *snomed.SearchFeedback) (*snomed.SearchFeedbackResponse, error) {
+	if ss.feedback == nil {
+		return nil, status.Error(codes.Unimplemented, "search feedback not enabled")
+	}
+	if r.GetSelectedConcept() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing selected concept")
+	}
+	if _, err := ss.service(ctx).Concept(r.GetSelectedConcept()); err != nil {
+		if err == terminology.ErrNotFound {
+			return nil, status.Errorf(codes.NotFound, "Concept not found with identifier %d", r.GetSelectedConcept())
+		}
+		return nil, err
+	}
+	if err := ss.feedback.Record(r); err != nil {
+		return nil, err
+	}
+	return &snomed.SearchFeedbackResponse{}, nil
+}
+
 func (ss *coreServer) Synonyms(sr *snomed.SynonymRequest, response snomed.Search_SynonymsServer) error {
+	svc := ss.service(response.Context())
 	tags, err := ss.languageTags(response.Context())
 	if err != nil {
 		return err
@@ -520,7 +697,7 @@ func (ss *coreServer) Synonyms(sr *snomed.SynonymRequest, response snomed.Search
 		MaximumHits:     sr.MaximumHits,
 		S:               sr.S,
 	}
-	results, err := ss.svc.Search(&search, tags)
+	results, err := svc.Search(response.Context(), &search, tags)
 	if err != nil {
 		return err
 	}
@@ -532,7 +709,7 @@ func (ss *coreServer) Synonyms(sr *snomed.SynonymRequest, response snomed.Search
 	for _, result := range results.Items {
 		concepts[result.ConceptId] = struct{}{}
 		if sr.IncludeChildren {
-			children, err := ss.svc.AllChildrenIDs(response.Context(), result.ConceptId, maxChildren)
+			children, err := svc.AllChildrenIDs(response.Context(), result.ConceptId, maxChildren)
 			if err != nil {
 				return err
 			}
@@ -542,7 +719,7 @@ func (ss *coreServer) Synonyms(sr *snomed.SynonymRequest, response snomed.Search
 		}
 	}
 	for conceptID := range concepts {
-		descriptions, err := ss.svc.Descriptions(conceptID)
+		descriptions, err := svc.Descriptions(conceptID)
 		if err != nil {
 			return err
 		}
@@ -29,7 +29,7 @@ func Server(svc *terminology.Svc) {
 	}
 	s := grpc.NewServer()
 	tags, _, _ := language.ParseAcceptLanguage(lang)
-	snomed.RegisterSnomedCTServer(s, &coreServer{svc: svc, lang: tags})
+	snomed.RegisterSnomedCTServer(s, &coreServer{releases: newRegistry(svc, ""), lang: tags})
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
@@ -0,0 +1,319 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"github.com/wardle/go-terminology/terminology"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// releaseHeader is the response metadata key containing the version of the release used for a request
+const releaseHeader = "x-terminology-release"
+
+// release is a database in use by the server, tracking the requests using it
+type release struct {
+	svc      *terminology.Svc
+	version  string
+	inflight sync.WaitGroup
+}
+
+// done signals that a request using this release has completed
+func (r *release) done() {
+	r.inflight.Done()
+}
+
+// registry holds the current release, which can be replaced atomically while serving requests.
+// A replaced release is closed once all of the requests using it have completed.
+type registry struct {
+	mu         sync.RWMutex
+	current    *release
+	path       string                  // path from which to reload
+	target     string                  // resolved path of the current release, if loaded from path
+	swapMu     sync.Mutex              // serialises swaps
+	feedback   *terminology.Feedback   // selections from search results used to rank the results of each release, if any
+	dictionary *terminology.Dictionary // local synonyms and abbreviations merged into the search results of each release, if any
+}
+
+func newRegistry(svc *terminology.Svc, path string) *registry {
+	r := &registry{current: &release{svc: svc, version: svc.ReleaseVersion()}, path: path}
+	if path != "" {
+		r.target, _ = filepath.EvalSymlinks(path)
+	}
+	return r
+}
+
+// setFeedback sets the selections from search results used to rank the results of the current, and any future, release
+func (r *registry) setFeedback(fb *terminology.Feedback) {
+	r.swapMu.Lock()
+	defer r.swapMu.Unlock()
+	r.feedback = fb
+	r.current.svc.SetFeedback(fb)
+}
+
+// setDictionary sets the local dictionary used in searches of the current, and any future, release
+func (r *registry) setDictionary(d *terminology.Dictionary) {
+	r.swapMu.Lock()
+	defer r.swapMu.Unlock()
+	r.dictionary = d
+	r.current.svc.SetDictionary(d)
+}
+
+// acquire returns the current release, which must be released by calling done() when no longer needed
+func (r *registry) acquire() *release {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	r.current.inflight.Add(1)
+	return r.current
+}
+
+// swap opens the database at the path specified and replaces the current release, closing the previous
+// release once any requests using it have completed. If path is empty, the configured path is reopened.
+func (r *registry) swap(path string) (previous string, current string, err error) {
+	r.swapMu.Lock()
+	defer r.swapMu.Unlock()
+	if path == "" {
+		path = r.path
+	}
+	if path == "" {
+		return "", "", fmt.Errorf("no database path specified")
+	}
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", "", err
+	}
+	if !terminology.Exists(target) {
+		return "", "", fmt.Errorf("not a terminology database: %s", path)
+	}
+	svc, err := terminology.NewService(target, true)
+	if err != nil {
+		return "", "", err
+	}
+	if !svc.Precomputed() {
+		svc.Close()
+		return "", "", fmt.Errorf("database %s has not been precomputed", path)
+	}
+	svc.SetFeedback(r.feedback)
+	svc.SetDictionary(r.dictionary)
+	next := &release{svc: svc, version: svc.ReleaseVersion()}
+	r.mu.Lock()
+	old := r.current
+	r.current = next
+	r.target = target
+	r.mu.Unlock()
+	log.Printf("swapped database release %s for %s (%s)", old.version, next.version, target)
+	go func() { // drain requests using the previous release before closing it
+		old.inflight.Wait()
+		if err := old.svc.Close(); err != nil {
+			log.Printf("error closing database release %s: %v", old.version, err)
+		}
+	}()
+	return old.version, next.version, nil
+}
+
+// reloadOnSignal reloads the database from the configured path whenever a SIGHUP is received
+func (r *registry) reloadOnSignal(ctx context.Context) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+	defer signal.Stop(c)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c:
+			if _, _, err := r.swap(""); err != nil {
+				log.Printf("failed to reload database: %v", err)
+			}
+		}
+	}
+}
+
+// watch periodically checks whether the configured path, usually a symlink, now refers to a different
+// database, and swaps to that database if so.
+func (r *registry) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			target, err := filepath.EvalSymlinks(r.path)
+			if err != nil {
+				log.Printf("failed to check database path %s: %v", r.path, err)
+				continue
+			}
+			r.mu.RLock()
+			changed := target != r.target
+			r.mu.RUnlock()
+			if changed {
+				if _, _, err := r.swap(""); err != nil {
+					log.Printf("failed to swap to database %s: %v", target, err)
+				}
+			}
+		}
+	}
+}
+
+type releaseKey struct{}
+
+// unaryInterceptor binds a request to the current release for its duration
+func (r *registry) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	rel := r.acquire()
+	defer rel.done()
+	grpc.SetHeader(ctx, metadata.Pairs(releaseHeader, rel.version))
+	return handler(context.WithValue(ctx, releaseKey{}, rel), req)
+}
+
+// streamInterceptor binds a streaming request to the current release for its duration
+func (r *registry) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	rel := r.acquire()
+	defer rel.done()
+	ss.SetHeader(metadata.Pairs(releaseHeader, rel.version))
+	return handler(srv, &releaseStream{ServerStream: ss, ctx: context.WithValue(ss.Context(), releaseKey{}, rel)})
+}
+
+// releaseStream is a server stream with a context bound to a release
+type releaseStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (rs *releaseStream) Context() context.Context {
+	return rs.ctx
+}
+
+// service returns the terminology service bound to the request, or the current service
+func (r *registry) service(ctx context.Context) *terminology.Svc {
+	if rel, ok := ctx.Value(releaseKey{}).(*release); ok {
+		return rel.svc
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.current.svc
+}
+
+// service returns the terminology service bound to the request, or the current service
+func (ss *coreServer) service(ctx context.Context) *terminology.Svc {
+	return ss.releases.service(ctx)
+}
+
+type adminServer struct {
+	releases   *registry
+	databases  string                  // directory containing the databases to which the server may be swapped, if any
+	dictionary *terminology.Dictionary // local synonyms and abbreviations, if enabled
+}
+
+// databasePath returns the path of the named database within the database directory.
+// A name must not be a path, so that only databases within that directory can be opened.
+func (as *adminServer) databasePath(name string) (string, error) {
+	if name == "" {
+		return "", nil // reopen the configured path
+	}
+	if as.databases == "" {
+		return "", fmt.Errorf("no database directory configured")
+	}
+	if name != filepath.Base(name) || name == "." || name == ".." {
+		return "", fmt.Errorf("invalid database name: %s", name)
+	}
+	return filepath.Join(as.databases, name), nil
+}
+
+// SwapDatabase replaces the database in use by the server
+func (as *adminServer) SwapDatabase(ctx context.Context, r *snomed.SwapDatabaseRequest) (*snomed.SwapDatabaseResponse, error) {
+	path, err := as.databasePath(r.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to swap database: %v", err)
+	}
+	previous, current, err := as.releases.swap(path)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to swap database: %v", err)
+	}
+	return &snomed.SwapDatabaseResponse{PreviousRelease: previous, Release: current}, nil
+}
+
+// LoadDictionary adds entries to the local dictionary, from the contents of a dictionary file and/or from the request
+func (as *adminServer) LoadDictionary(ctx context.Context, r *snomed.LoadDictionaryRequest) (*snomed.LoadDictionaryResponse, error) {
+	if as.dictionary == nil {
+		return nil, status.Error(codes.Unimplemented, "local dictionary not enabled")
+	}
+	entries := r.GetEntries()
+	if r.GetContent() != "" {
+		fromFile, err := terminology.ReadDictionary(strings.NewReader(r.GetContent()))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid dictionary: %v", err)
+		}
+		entries = append(fromFile, entries...)
+	}
+	load := as.dictionary.Add
+	if r.GetReplace() {
+		load = as.dictionary.Replace
+	}
+	if err := load(entries); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to load dictionary: %v", err)
+	}
+	total, err := as.dictionary.Count()
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("loaded %d entries into local dictionary (%d entries)", len(entries), total)
+	return &snomed.LoadDictionaryResponse{Loaded: int32(len(entries)), Total: int32(total)}, nil
+}
+
+// ListDictionary returns all of the entries in the local dictionary
+func (as *adminServer) ListDictionary(r *snomed.ListDictionaryRequest, server snomed.Admin_ListDictionaryServer) error {
+	if as.dictionary == nil {
+		return status.Error(codes.Unimplemented, "local dictionary not enabled")
+	}
+	entries, err := as.dictionary.Entries()
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if err := server.Send(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveDictionaryEntries removes the entries for the terms specified from the local dictionary
+func (as *adminServer) RemoveDictionaryEntries(ctx context.Context, r *snomed.RemoveDictionaryEntriesRequest) (*snomed.RemoveDictionaryEntriesResponse, error) {
+	if as.dictionary == nil {
+		return nil, status.Error(codes.Unimplemented, "local dictionary not enabled")
+	}
+	removed, err := as.dictionary.Remove(r.GetTerms())
+	if err != nil {
+		return nil, err
+	}
+	return &snomed.RemoveDictionaryEntriesResponse{Removed: int32(removed)}, nil
+}
+
+var _ snomed.AdminServer = (*adminServer)(nil)
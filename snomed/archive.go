@@ -0,0 +1,212 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package snomed
+
+import (
+	"archive/zip"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	context "golang.org/x/net/context"
+)
+
+// archiveSeparator separates the name of an archive from the name of a file within it
+const archiveSeparator = "!/"
+
+// checksum file extensions, and the hash used, for release packages that provide them
+var checksumTypes = []struct {
+	extension string
+	newHash   func() hash.Hash
+}{
+	{".sha256", sha256.New},
+	{".md5", md5.New},
+}
+
+// isArchive returns whether the file is a ZIP archive
+func isArchive(filename string) bool {
+	return strings.EqualFold(path.Ext(filename), ".zip")
+}
+
+// archives tracks the ZIP archives opened during an import, so that they may be closed,
+// and any temporary copies of nested archives removed, once all files have been read.
+type archives struct {
+	mu      sync.Mutex
+	closers []func()
+}
+
+// open opens the ZIP archive at the path specified, verifying its checksum if a checksum file exists alongside
+func (a *archives) open(filename string) (*zip.Reader, error) {
+	if err := verifyFile(filename); err != nil {
+		return nil, err
+	}
+	zr, err := zip.OpenReader(filename)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open archive %s: %w", filename, err)
+	}
+	a.add(func() { zr.Close() })
+	return &zr.Reader, nil
+}
+
+// openNested opens a ZIP archive nested within another, by copying it to a temporary file,
+// verifying its checksum if a checksum file exists alongside it within the parent archive.
+func (a *archives) openNested(name string, f *zip.File, parent *zip.Reader) (*zip.Reader, error) {
+	tmp, err := ioutil.TempFile("", "rf2-*.zip")
+	if err != nil {
+		return nil, err
+	}
+	a.add(func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	})
+	r, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("unable to open archive %s: %w", name, err)
+	}
+	defer r.Close()
+	expected, h, err := nestedChecksum(f.Name, parent)
+	if err != nil {
+		return nil, err
+	}
+	var w io.Writer = tmp
+	if h != nil {
+		w = io.MultiWriter(tmp, h)
+	}
+	size, err := io.Copy(w, r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read archive %s: %w", name, err)
+	}
+	if h != nil {
+		if err := compareChecksum(name, expected, h); err != nil {
+			return nil, err
+		}
+	}
+	return zip.NewReader(tmp, size)
+}
+
+func (a *archives) add(f func()) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.closers = append(a.closers, f)
+}
+
+// close closes all opened archives and removes any temporary files
+func (a *archives) close() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, f := range a.closers {
+		f()
+	}
+	a.closers = nil
+}
+
+// walkArchive emits tasks for the SNOMED CT files within a ZIP archive, walking any nested archives in turn.
+// Files are named using the name of the archive, the separator "!/" and their path within the archive.
+func walkArchive(ctx context.Context, name string, zr *zip.Reader, opts ImportOptions, tasks chan<- task, arcs *archives) error {
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || ignoredEntry(f.Name) {
+			continue
+		}
+		entryName := name + archiveSeparator + f.Name
+		if isArchive(f.Name) {
+			nested, err := arcs.openNested(entryName, f, zr)
+			if err != nil {
+				return err
+			}
+			if err := walkArchive(ctx, entryName, nested, opts, tasks, arcs); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := emitTask(ctx, entryName, f.Open, opts, tasks); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ignoredEntry returns whether an archive entry is metadata added by an archiving tool, such as on macOS
+func ignoredEntry(name string) bool {
+	return strings.HasPrefix(name, "__MACOSX/") || strings.HasPrefix(path.Base(name), ".")
+}
+
+// verifyFile verifies the checksum of a file, if a checksum file exists alongside it
+func verifyFile(filename string) error {
+	for _, ct := range checksumTypes {
+		data, err := ioutil.ReadFile(filename + ct.extension)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		h := ct.newHash()
+		if _, err := io.Copy(h, f); err != nil {
+			return err
+		}
+		return compareChecksum(filename, string(data), h)
+	}
+	return nil
+}
+
+// nestedChecksum returns the expected checksum, and the hash to calculate it, for a file
+// within an archive, if a checksum file exists alongside it. Returns a nil hash otherwise.
+func nestedChecksum(filename string, parent *zip.Reader) (string, hash.Hash, error) {
+	for _, ct := range checksumTypes {
+		for _, f := range parent.File {
+			if f.Name != filename+ct.extension {
+				continue
+			}
+			r, err := f.Open()
+			if err != nil {
+				return "", nil, err
+			}
+			defer r.Close()
+			data, err := ioutil.ReadAll(r)
+			if err != nil {
+				return "", nil, err
+			}
+			return string(data), ct.newHash(), nil
+		}
+	}
+	return "", nil, nil
+}
+
+// compareChecksum compares the calculated hash with the contents of a checksum file, which contains
+// the hexadecimal digest, optionally followed by the filename, as generated by sha256sum or md5sum.
+func compareChecksum(filename string, checksum string, h hash.Hash) error {
+	fields := strings.Fields(checksum)
+	if len(fields) == 0 {
+		return fmt.Errorf("%s: empty checksum file", filename)
+	}
+	if actual := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(fields[0], actual) {
+		return fmt.Errorf("%s: checksum mismatch: expected %s, got %s", filename, fields[0], actual)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package snomed
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Writer writes components to a set of RF2 distribution files, laid out as in a release, creating
+// each file, with its header, when the first component of that type is written.
+// Files are tab-delimited UTF-8, with each line terminated by CR LF, as per the RF2 specification.
+type Writer struct {
+	dir       string
+	release   ReleaseType
+	namespace string           // the country and namespace element of each filename, such as INT or GB1000000
+	date      string           // the release date element of each filename (YYYYMMDD)
+	languages map[int64]string // language code of each language reference set
+	files     map[string]*rf2File
+}
+
+type rf2File struct {
+	f    *os.File
+	w    *bufio.Writer
+	rows int
+}
+
+// NewWriter creates a writer for the files of a release of the type specified, within the directory specified.
+// The namespace and date form part of each filename, such as "INT" and the date of release.
+func NewWriter(dir string, release ReleaseType, namespace string, date time.Time) *Writer {
+	return &Writer{
+		dir:       dir,
+		release:   release,
+		namespace: namespace,
+		date:      date.Format("20060102"),
+		languages: make(map[int64]string),
+		files:     make(map[string]*rf2File),
+	}
+}
+
+// SetLanguage sets the language code of a language reference set, which forms part of the name of its file.
+// The language of a reference set not set is assumed to be English.
+func (w *Writer) SetLanguage(refsetID int64, languageCode string) {
+	w.languages[refsetID] = languageCode
+}
+
+// filename returns the path of a file within a release, relative to the directory of the writer
+func (w *Writer) filename(folder string, prefix string, contentType string, language string) string {
+	if language != "" {
+		language = "-" + language
+	}
+	return filepath.Join(w.release.String(), folder, fmt.Sprintf("%s_%s%s%s_%s_%s.txt", prefix, contentType, w.release, language, w.namespace, w.date))
+}
+
+// write writes a row to the file specified, creating that file with the columns specified if necessary
+func (w *Writer) write(filename string, columns []string, row []string) error {
+	f, ok := w.files[filename]
+	if !ok {
+		path := filepath.Join(w.dir, filename)
+		if err := os.MkdirAll(filepath.Dir(path), 0771); err != nil {
+			return err
+		}
+		file, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		f = &rf2File{f: file, w: bufio.NewWriter(file)}
+		w.files[filename] = f
+		if err := writeRow(f.w, columns); err != nil {
+			return err
+		}
+	}
+	f.rows++
+	return writeRow(f.w, row)
+}
+
+func writeRow(w *bufio.Writer, row []string) error {
+	if _, err := w.WriteString(strings.Join(row, "\t")); err != nil {
+		return err
+	}
+	_, err := w.WriteString("\r\n")
+	return err
+}
+
+// Files returns the number of components written, by the path of each file relative to the directory of the writer
+func (w *Writer) Files() map[string]int {
+	result := make(map[string]int, len(w.files))
+	for filename, f := range w.files {
+		result[filename] = f.rows
+	}
+	return result
+}
+
+// Close flushes and closes all of the files written
+func (w *Writer) Close() error {
+	var result error
+	for filename, f := range w.files {
+		if err := f.w.Flush(); err != nil && result == nil {
+			result = fmt.Errorf("error writing %s: %w", filename, err)
+		}
+		if err := f.f.Close(); err != nil && result == nil {
+			result = err
+		}
+	}
+	return result
+}
+
+func formatIdentifier(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func formatDate(ts *timestamppb.Timestamp) string {
+	return ts.AsTime().Format("20060102")
+}
+
+func formatBoolean(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// WriteConcept writes a concept to the concept file
+func (w *Writer) WriteConcept(c *Concept) error {
+	return w.write(w.filename("Terminology", "sct2", "Concept_", ""), conceptsFileType.cols(), []string{
+		formatIdentifier(c.Id), formatDate(c.EffectiveTime), formatBoolean(c.Active), formatIdentifier(c.ModuleId),
+		formatIdentifier(c.DefinitionStatusId),
+	})
+}
+
+// WriteDescription writes a description to the description file for its language, or to the text definition
+// file if it is a definition.
+func (w *Writer) WriteDescription(d *Description) error {
+	contentType := "Description_"
+	if d.TypeId == int64(Definition) {
+		contentType = "TextDefinition_"
+	}
+	return w.write(w.filename("Terminology", "sct2", contentType, d.LanguageCode), descriptionsFileType.cols(), []string{
+		formatIdentifier(d.Id), formatDate(d.EffectiveTime), formatBoolean(d.Active), formatIdentifier(d.ModuleId),
+		formatIdentifier(d.ConceptId), d.LanguageCode, formatIdentifier(d.TypeId), d.Term, formatIdentifier(d.CaseSignificance),
+	})
+}
+
+// WriteRelationship writes a relationship to the stated or inferred relationship file, by its characteristic type
+func (w *Writer) WriteRelationship(r *Relationship) error {
+	contentType := "Relationship_"
+	if r.CharacteristicTypeId == StatedRelationship {
+		contentType = "StatedRelationship_"
+	}
+	return w.write(w.filename("Terminology", "sct2", contentType, ""), relationshipsFileType.cols(), []string{
+		formatIdentifier(r.Id), formatDate(r.EffectiveTime), formatBoolean(r.Active), formatIdentifier(r.ModuleId),
+		formatIdentifier(r.SourceId), formatIdentifier(r.DestinationId), strconv.FormatInt(r.RelationshipGroup, 10),
+		formatIdentifier(r.TypeId), formatIdentifier(r.CharacteristicTypeId), formatIdentifier(r.ModifierId),
+	})
+}
+
+// WriteReferenceSetItem writes a reference set item to the file for its type of reference set.
+// The items of a generic reference set are written to a file specific to that reference set, named
+// using its identifier, with the pattern and column names derived from the fields of the item.
+func (w *Writer) WriteReferenceSetItem(item *ReferenceSetItem) error {
+	row := []string{item.Id, formatDate(item.EffectiveTime), formatBoolean(item.Active), formatIdentifier(item.ModuleId),
+		formatIdentifier(item.RefsetId), formatIdentifier(item.ReferencedComponentId)}
+	var ft fileType
+	var folder, contentType, language string
+	switch body := item.Body.(type) {
+	case *ReferenceSetItem_RefsetDescriptor:
+		ft, folder, contentType = refsetDescriptorRefsetFileType, "Metadata", "cciRefset_RefsetDescriptor"
+		rd := body.RefsetDescriptor
+		row = append(row, formatIdentifier(rd.AttributeDescriptionId), formatIdentifier(rd.AttributeTypeId), strconv.FormatUint(uint64(rd.AttributeOrder), 10))
+	case *ReferenceSetItem_Language:
+		ft, folder, contentType = languageRefsetFileType, "Language", "cRefset_Language"
+		if language = w.languages[item.RefsetId]; language == "" {
+			language = "en"
+		}
+		row = append(row, formatIdentifier(body.Language.AcceptabilityId))
+	case *ReferenceSetItem_Simple:
+		ft, folder, contentType = simpleRefsetFileType, "Content", "Refset_Simple"
+	case *ReferenceSetItem_SimpleMap:
+		ft, folder, contentType = simpleMapRefsetFileType, "Map", "sRefset_SimpleMap"
+		row = append(row, body.SimpleMap.MapTarget)
+	case *ReferenceSetItem_ComplexMap:
+		cm := body.ComplexMap
+		row = append(row, strconv.FormatInt(cm.MapGroup, 10), strconv.FormatInt(cm.MapPriority, 10), cm.MapRule, cm.MapAdvice, cm.MapTarget, formatIdentifier(cm.Correlation))
+		if cm.MapCategory != 0 {
+			ft, folder, contentType = extendedMapRefsetFileType, "Map", "iisssccRefset_ExtendedMap"
+			row = append(row, formatIdentifier(cm.MapCategory))
+		} else {
+			ft, folder, contentType = complexMapRefsetFileType, "Map", "iisssciRefset_ExtendedMap"
+			row = append(row, strconv.FormatInt(cm.MapBlock, 10))
+		}
+	case *ReferenceSetItem_AttributeValue:
+		ft, folder, contentType = attributeValueRefsetFileType, "Content", "cRefset_AttributeValue"
+		row = append(row, formatIdentifier(body.AttributeValue.ValueId))
+	case *ReferenceSetItem_Association:
+		ft, folder, contentType = associationRefsetFileType, "Content", "cRefset_Association"
+		row = append(row, formatIdentifier(body.Association.TargetComponentId))
+	case *ReferenceSetItem_ModuleDependency:
+		ft, folder, contentType = moduleDependencyRefsetFileType, "Metadata", "ssRefset_ModuleDependency"
+		md := body.ModuleDependency
+		row = append(row, formatDate(md.SourceEffectiveTime), formatDate(md.TargetEffectiveTime))
+	case *ReferenceSetItem_Generic:
+		columns := append([]string{}, genericRefsetFileType.cols()...)
+		var pattern strings.Builder
+		for _, f := range body.Generic.Fields {
+			switch f.Value.(type) {
+			case *ReferenceSetField_ComponentId:
+				pattern.WriteByte('c')
+			case *ReferenceSetField_IntegerValue:
+				pattern.WriteByte('i')
+			default:
+				pattern.WriteByte('s')
+			}
+			columns = append(columns, f.Name)
+			row = append(row, f.Format())
+		}
+		return w.write(w.filename(filepath.Join("Refset", "Content"), "der2", pattern.String()+"Refset_"+formatIdentifier(item.RefsetId), ""), columns, row)
+	default:
+		return fmt.Errorf("reference set item %s: unsupported type %T", item.Id, item.Body)
+	}
+	return w.write(w.filename(filepath.Join("Refset", folder), "der2", contentType, language), ft.cols(), row)
+}
@@ -0,0 +1,127 @@
+package snomed
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestWriterRoundTrip(t *testing.T) {
+	root, err := ioutil.TempDir("", "rf2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	date := time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+	et := timestamppb.New(date)
+	module := int64(999000011000000103)
+	concept := &Concept{Id: 24700007, EffectiveTime: et, Active: true, ModuleId: module, DefinitionStatusId: 900000000000074008}
+	description := &Description{Id: 41398015, EffectiveTime: et, Active: true, ModuleId: module, ConceptId: 24700007, LanguageCode: "en", TypeId: int64(Synonym), Term: "Multiple sclerosis", CaseSignificance: 900000000000448009}
+	relationship := &Relationship{Id: 1399025, EffectiveTime: et, Active: true, ModuleId: module, SourceId: 24700007, DestinationId: 6118003, TypeId: IsA, CharacteristicTypeId: StatedRelationship, ModifierId: 900000000000451002}
+	items := []*ReferenceSetItem{
+		{Id: "2d0b9d58-3d1f-4d5e-8d1c-000000000001", EffectiveTime: et, Active: true, ModuleId: module, RefsetId: 999001261000000100, ReferencedComponentId: 41398015,
+			Body: &ReferenceSetItem_Language{Language: &LanguageReferenceSet{AcceptabilityId: 900000000000548007}}},
+		{Id: "2d0b9d58-3d1f-4d5e-8d1c-000000000002", EffectiveTime: et, Active: false, ModuleId: module, RefsetId: 991381000000107, ReferencedComponentId: 24700007,
+			Body: &ReferenceSetItem_Simple{Simple: &SimpleReferenceSet{}}},
+		{Id: "2d0b9d58-3d1f-4d5e-8d1c-000000000003", EffectiveTime: et, Active: true, ModuleId: module, RefsetId: 447562003, ReferencedComponentId: 24700007,
+			Body: &ReferenceSetItem_ComplexMap{ComplexMap: &ComplexMapReferenceSet{MapGroup: 1, MapPriority: 1, MapRule: "TRUE", MapAdvice: "ALWAYS G35", MapTarget: "G35", Correlation: 447561005, MapCategory: 447637006}}},
+		{Id: "2d0b9d58-3d1f-4d5e-8d1c-000000000004", EffectiveTime: et, Active: true, ModuleId: module, RefsetId: ModuleDependencyRefset, ReferencedComponentId: 900000000000207008,
+			Body: &ReferenceSetItem_ModuleDependency{ModuleDependency: &ModuleDependencyReferenceSet{SourceEffectiveTime: et, TargetEffectiveTime: et}}},
+		{Id: "2d0b9d58-3d1f-4d5e-8d1c-000000000005", EffectiveTime: et, Active: true, ModuleId: module, RefsetId: 991411000000109, ReferencedComponentId: 24700007,
+			Body: &ReferenceSetItem_Generic{Generic: &GenericReferenceSet{Fields: []*ReferenceSetField{
+				{Name: "targetComponentId", Value: &ReferenceSetField_ComponentId{ComponentId: 6118003}},
+				{Name: "priority", Value: &ReferenceSetField_IntegerValue{IntegerValue: 2}},
+				{Name: "note", Value: &ReferenceSetField_StringValue{StringValue: "demyelinating"}},
+			}}}},
+	}
+	w := NewWriter(root, Snapshot, "GB1000000", date)
+	if err := w.WriteConcept(concept); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteDescription(description); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRelationship(relationship); err != nil {
+		t.Fatal(err)
+	}
+	for _, item := range items {
+		if err := w.WriteReferenceSetItem(item); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	files := w.Files()
+	for _, filename := range []string{
+		"Snapshot/Terminology/sct2_Concept_Snapshot_GB1000000_20200401.txt",
+		"Snapshot/Terminology/sct2_Description_Snapshot-en_GB1000000_20200401.txt",
+		"Snapshot/Terminology/sct2_StatedRelationship_Snapshot_GB1000000_20200401.txt",
+		"Snapshot/Refset/Language/der2_cRefset_LanguageSnapshot-en_GB1000000_20200401.txt",
+		"Snapshot/Refset/Content/der2_cisRefset_991411000000109Snapshot_GB1000000_20200401.txt",
+	} {
+		if files[filepath.FromSlash(filename)] != 1 {
+			t.Errorf("file %s not written: %v", filename, files)
+		}
+	}
+	channels := Import(context.Background(), root, 500)
+	var concepts []*Concept
+	var descriptions []*Description
+	var relationships []*Relationship
+	var imported []*ReferenceSetItem
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for batch := range channels.Descriptions {
+			descriptions = append(descriptions, batch.Descriptions...)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for batch := range channels.Relationships {
+			relationships = append(relationships, batch.Relationships...)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for batch := range channels.Refsets {
+			imported = append(imported, batch.Items...)
+		}
+	}()
+	for batch := range channels.Concepts {
+		concepts = append(concepts, batch.Concepts...)
+	}
+	wg.Wait()
+	if err := channels.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(concepts) != 1 || !proto.Equal(concepts[0], concept) {
+		t.Errorf("concept not round-tripped: %v", concepts)
+	}
+	if len(descriptions) != 1 || !proto.Equal(descriptions[0], description) {
+		t.Errorf("description not round-tripped: %v", descriptions)
+	}
+	if len(relationships) != 1 || !proto.Equal(relationships[0], relationship) {
+		t.Errorf("relationship not round-tripped: %v", relationships)
+	}
+	if len(imported) != len(items) {
+		t.Fatalf("expected %d reference set items, got %d", len(items), len(imported))
+	}
+	for _, item := range items {
+		found := false
+		for _, i := range imported {
+			found = found || proto.Equal(item, i)
+		}
+		if !found {
+			t.Errorf("reference set item not round-tripped: %v", item)
+		}
+	}
+}
@@ -97,3 +97,43 @@ func (id Identifier) partitionIdentifier() string {
 	l := len(s)
 	return s[l-3 : l-1]
 }
+
+// Partition is the type of component identified by an identifier
+type Partition int
+
+// Partitions for the components identified by an SCTID
+const (
+	ConceptPartition Partition = iota
+	DescriptionPartition
+	RelationshipPartition
+)
+
+// maximum item identifier within a namespace; an SCTID is at most 18 digits, of which the namespace, partition and check digit take ten.
+const maxNamespaceItem = 99999999
+
+// NewNamespaceIdentifier generates an identifier in the long format for a component of the partition
+// specified, within a namespace allocated to an issuing organisation, from an item identifier that
+// must be unique within that namespace and partition.
+// See https://confluence.ihtsdotools.org/display/DOCRELFMT/5.4+Namespace+Identifier
+func NewNamespaceIdentifier(namespace int64, partition Partition, item int64) (Identifier, error) {
+	if namespace < 1000000 || namespace > 9999999 {
+		return 0, fmt.Errorf("invalid namespace '%d': must be seven digits", namespace)
+	}
+	if partition < ConceptPartition || partition > RelationshipPartition {
+		return 0, fmt.Errorf("invalid partition: %d", partition)
+	}
+	if item < 1 || item > maxNamespaceItem {
+		return 0, fmt.Errorf("invalid item identifier '%d': must be between 1 and %d", item, maxNamespaceItem)
+	}
+	return ParseIdentifier(verhoeff.AppendCheckDigit(fmt.Sprintf("%d%07d1%d", item, namespace, partition)))
+}
+
+// Namespace returns the namespace of this identifier, or zero if it is in the short format without a namespace
+func (id Identifier) Namespace() int64 {
+	s := strconv.FormatInt(int64(id), 10)
+	if len(s) < 11 || s[len(s)-3] != '1' {
+		return 0
+	}
+	ns, _ := strconv.ParseInt(s[len(s)-10:len(s)-3], 10, 64)
+	return ns
+}
@@ -49,3 +49,49 @@ func testIdentifier(t *testing.T, identifier int64, concept bool, description bo
 		}
 	}
 }
+
+func TestNamespaceIdentifiers(t *testing.T) {
+	tests := []struct {
+		namespace int64
+		partition Partition
+		item      int64
+		expected  Identifier
+	}{
+		{1000000, ConceptPartition, 99900001, 999000011000000103}, // UK clinical extension module
+		{1000000, ConceptPartition, 99138, 991381000000107},
+	}
+	for _, test := range tests {
+		id, err := NewNamespaceIdentifier(test.namespace, test.partition, test.item)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != test.expected {
+			t.Errorf("expected %d, got %d", test.expected, id)
+		}
+		if id.Namespace() != test.namespace {
+			t.Errorf("incorrect namespace for %d: %d", id, id.Namespace())
+		}
+	}
+	for p, check := range map[Partition]func(Identifier) bool{
+		ConceptPartition:      Identifier.IsConcept,
+		DescriptionPartition:  Identifier.IsDescription,
+		RelationshipPartition: Identifier.IsRelationship,
+	} {
+		id, err := NewNamespaceIdentifier(1000230, p, 12)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !id.IsValid() || !check(id) {
+			t.Errorf("identifier %d invalid or in incorrect partition", id)
+		}
+	}
+	if Identifier(24700007).Namespace() != 0 {
+		t.Error("short format identifier has a namespace")
+	}
+	if _, err := NewNamespaceIdentifier(123, ConceptPartition, 1); err == nil {
+		t.Error("invalid namespace accepted")
+	}
+	if _, err := NewNamespaceIdentifier(1000000, ConceptPartition, 0); err == nil {
+		t.Error("invalid item identifier accepted")
+	}
+}
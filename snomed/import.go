@@ -18,6 +18,7 @@ package snomed
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -49,6 +50,8 @@ const (
 	complexMapRefsetFileType
 	attributeValueRefsetFileType
 	associationRefsetFileType
+	moduleDependencyRefsetFileType
+	genericRefsetFileType // any other reference set, interpreted using the pattern in its filename
 	lastFileType
 )
 
@@ -64,6 +67,8 @@ var fileTypeNames = [...]string{
 	"Complex map refset",
 	"Attribute value refset",
 	"Association refset",
+	"Module dependency refset",
+	"Refset",
 }
 var columnNames = [...][]string{
 	{"id", "effectiveTime", "active", "moduleId", "definitionStatusId"},
@@ -77,26 +82,54 @@ var columnNames = [...][]string{
 	{"id", "effectiveTime", "active", "moduleId", "refsetId", "referencedComponentId", "mapGroup", "mapPriority", "mapRule", "mapAdvice", "mapTarget", "correlationId", "mapBlock"},
 	{"id", "effectiveTime", "active", "moduleId", "refsetId", "referencedComponentId", "valueId"},
 	{"id", "effectiveTime", "active", "moduleId", "refsetId", "referencedComponentId", "targetComponentId"},
+	{"id", "effectiveTime", "active", "moduleId", "refsetId", "referencedComponentId", "sourceEffectiveTime", "targetEffectiveTime"},
+	{"id", "effectiveTime", "active", "moduleId", "refsetId", "referencedComponentId"}, // followed by columns defined by the pattern
 }
 
-// Filename patterns for the supported file types
+// Filename patterns for the supported file types, with a placeholder for the release type
 var fileTypeFilenamePatterns = [...]string{
-	"sct2_Concept_Snapshot_\\S+_\\S+.txt",
-	"sct2_Description_Snapshot-en\\S+_\\S+.txt",
-	"sct2_(Stated)*Relationship_Snapshot_\\S+_\\S+.txt",
-	"der2_cciRefset_RefsetDescriptorSnapshot_\\S+_\\S+.txt",
-	"der2_cRefset_LanguageSnapshot-\\S+_\\S+.txt",
-	"der2_Refset_SimpleSnapshot_\\S+_\\S+.txt",
-	"der2_sRefset_SimpleMapSnapshot_\\S+_\\S+.txt",
-	"der2_iisssccRefset_ExtendedMapSnapshot_\\S+_\\S+.txt", // extended
-	"der2_iisssciRefset_ExtendedMapSnapshot_\\S+_\\S+.txt", // complex
-	"der2_cRefset_AttributeValueSnapshot_\\S+_\\S+.txt",
-	"der2_cRefset_AssociationSnapshot_\\S+_\\S+.txt",
+	"sct2_Concept_%s_\\S+_\\S+.txt",
+	"sct2_Description_%s-\\S+_\\S+.txt",
+	"sct2_(Stated)*Relationship_%s_\\S+_\\S+.txt",
+	"der2_cciRefset_RefsetDescriptor%s_\\S+_\\S+.txt",
+	"der2_cRefset_Language%s-\\S+_\\S+.txt",
+	"der2_Refset_Simple%s_\\S+_\\S+.txt",
+	"der2_sRefset_SimpleMap%s_\\S+_\\S+.txt",
+	"der2_iisssccRefset_ExtendedMap%s_\\S+_\\S+.txt", // extended
+	"der2_iisssciRefset_ExtendedMap%s_\\S+_\\S+.txt", // complex
+	"der2_cRefset_AttributeValue%s_\\S+_\\S+.txt",
+	"der2_cRefset_Association%s_\\S+_\\S+.txt",
+	"der2_ssRefset_ModuleDependency%s_\\S+_\\S+.txt",
+	"(der2|sct2)_[cis]*Refset_\\S*%s(-\\S+)?_\\S+_\\S+.txt",
+}
+
+// refsetPattern matches the pattern of the additional fields of a reference set from its filename,
+// in which 'c' represents a component, 'i' an integer and 's' a string.
+var refsetPattern = regexp.MustCompile("^(?:der2|sct2)_([cis]*)Refset_")
+
+// ReleaseType represents the type of an RF2 release
+type ReleaseType int
+
+// Supported release types
+const (
+	Snapshot ReleaseType = iota // the most recent version of every component
+	Delta                       // only those components that have changed since the previous release
+	Full                        // every version of every component
+)
+
+var releaseTypeNames = [...]string{
+	"Snapshot",
+	"Delta",
+	"Full",
+}
+
+func (rt ReleaseType) String() string {
+	return releaseTypeNames[rt]
 }
 
-// return the filename pattern for this file type
-func (ft fileType) pattern() string {
-	return fileTypeFilenamePatterns[ft]
+// return the filename pattern for this file type, for the specified type of release
+func (ft fileType) pattern(release ReleaseType) string {
+	return fmt.Sprintf(fileTypeFilenamePatterns[ft], release)
 }
 
 // column names for this file type
@@ -112,14 +145,33 @@ type task struct {
 	filename  string
 	batchSize int
 	fileType  fileType
+	open      func() (io.ReadCloser, error) // opens the file, if not on the filesystem, such as within an archive
+	pattern   string                        // pattern of the additional fields of a generic reference set, such as "ci"
+	columns   []string                      // column names, as read from the file
+}
+
+// checkColumns checks that the column names are those expected for the file.
+// A generic reference set has the standard columns followed by one for each character of its pattern.
+func (t task) checkColumns(headings []string) error {
+	expected := t.fileType.cols()
+	if t.fileType == genericRefsetFileType {
+		if len(headings) != len(expected)+len(t.pattern) {
+			return fmt.Errorf("expecting %d columns for pattern '%s', got: %v", len(expected)+len(t.pattern), t.pattern, headings)
+		}
+		headings = headings[:len(expected)]
+	}
+	if !reflect.DeepEqual(headings, expected) {
+		return fmt.Errorf("expecting column names: %v, got: %v", expected, headings)
+	}
+	return nil
 }
 
 // calculateFileType determines the type of file from its filename, returning a
 // boolean to indicate whether a file type was successfully determined.
-func calculateFileType(path string) (fileType, bool) {
+func calculateFileType(path string, release ReleaseType) (fileType, bool) {
 	filename := filepath.Base(path)
 	for ft := conceptsFileType; ft < lastFileType; ft++ {
-		matched, _ := regexp.MatchString(ft.pattern(), filename)
+		matched, _ := regexp.MatchString(ft.pattern(release), filename)
 		if matched {
 			return ft, true
 		}
@@ -127,28 +179,46 @@ func calculateFileType(path string) (fileType, bool) {
 	return -1, false
 }
 
-func parseIdentifier(s string, errs *[]error) int64 {
-	return parseInt(s, errs)
+// fieldError is an error parsing the value in a column of a row, or the whole row if the column is negative
+type fieldError struct {
+	column int
+	err    error
+}
+
+func (fe fieldError) Error() string {
+	return fe.err.Error()
+}
+
+// addFieldError records an error parsing the value in the column specified
+func addFieldError(errs *[]error, column int, err error) {
+	if ne, ok := err.(*strconv.NumError); ok {
+		err = ne.Err // the value is reported separately
+	}
+	*errs = append(*errs, fieldError{column: column, err: err})
+}
+
+func parseIdentifier(row []string, col int, errs *[]error) int64 {
+	return parseInt(row, col, errs)
 }
 
-func parseInt(s string, errs *[]error) int64 {
-	i, err := strconv.ParseInt(s, 10, 64)
+func parseInt(row []string, col int, errs *[]error) int64 {
+	i, err := strconv.ParseInt(row[col], 10, 64)
 	if err != nil {
-		*errs = append(*errs, err)
+		addFieldError(errs, col, err)
 	}
 	return int64(i)
 }
-func parseBoolean(s string, errs *[]error) bool {
-	b, err := strconv.ParseBool(s)
+func parseBoolean(row []string, col int, errs *[]error) bool {
+	b, err := strconv.ParseBool(row[col])
 	if err != nil {
-		*errs = append(*errs, err)
+		addFieldError(errs, col, err)
 	}
 	return b
 }
-func parseDate(s string, errs *[]error) *timestamppb.Timestamp {
-	t, err := time.Parse("20060102", s)
+func parseDate(row []string, col int, errs *[]error) *timestamppb.Timestamp {
+	t, err := time.Parse("20060102", row[col])
 	if err != nil {
-		*errs = append(*errs, err)
+		addFieldError(errs, col, fmt.Errorf("invalid date"))
 		return nil
 	}
 	ts := timestamppb.New(t)
@@ -157,45 +227,45 @@ func parseDate(s string, errs *[]error) *timestamppb.Timestamp {
 
 func parseConcept(row []string, errs *[]error) *Concept {
 	return &Concept{
-		Id:                 parseIdentifier(row[0], errs),
-		EffectiveTime:      parseDate(row[1], errs),
-		Active:             parseBoolean(row[2], errs),
-		ModuleId:           parseIdentifier(row[3], errs),
-		DefinitionStatusId: parseIdentifier(row[4], errs)}
+		Id:                 parseIdentifier(row, 0, errs),
+		EffectiveTime:      parseDate(row, 1, errs),
+		Active:             parseBoolean(row, 2, errs),
+		ModuleId:           parseIdentifier(row, 3, errs),
+		DefinitionStatusId: parseIdentifier(row, 4, errs)}
 }
 
 // id      effectiveTime   active  moduleId        conceptId       languageCode    typeId  term    caseSignificanceId
 func parseDescription(row []string, errs *[]error) *Description {
 	return &Description{
-		Id:               parseIdentifier(row[0], errs),
-		EffectiveTime:    parseDate(row[1], errs),
-		Active:           parseBoolean(row[2], errs),
-		ModuleId:         parseIdentifier(row[3], errs),
-		ConceptId:        parseIdentifier(row[4], errs),
+		Id:               parseIdentifier(row, 0, errs),
+		EffectiveTime:    parseDate(row, 1, errs),
+		Active:           parseBoolean(row, 2, errs),
+		ModuleId:         parseIdentifier(row, 3, errs),
+		ConceptId:        parseIdentifier(row, 4, errs),
 		LanguageCode:     row[5],
-		TypeId:           parseIdentifier(row[6], errs),
+		TypeId:           parseIdentifier(row, 6, errs),
 		Term:             row[7],
-		CaseSignificance: parseIdentifier(row[8], errs)}
+		CaseSignificance: parseIdentifier(row, 8, errs)}
 }
 
 // id      effectiveTime   active  moduleId        sourceId        destinationId   relationshipGroup       typeId  characteristicTypeId    modifierId
 func parseRelationship(row []string, errs *[]error) *Relationship {
 	return &Relationship{
-		Id:                   parseIdentifier(row[0], errs),
-		EffectiveTime:        parseDate(row[1], errs),
-		Active:               parseBoolean(row[2], errs),
-		ModuleId:             parseIdentifier(row[3], errs),
-		SourceId:             parseIdentifier(row[4], errs),
-		DestinationId:        parseIdentifier(row[5], errs),
-		RelationshipGroup:    parseInt(row[6], errs),
-		TypeId:               parseIdentifier(row[7], errs),
-		CharacteristicTypeId: parseIdentifier(row[8], errs),
-		ModifierId:           parseIdentifier(row[9], errs)}
+		Id:                   parseIdentifier(row, 0, errs),
+		EffectiveTime:        parseDate(row, 1, errs),
+		Active:               parseBoolean(row, 2, errs),
+		ModuleId:             parseIdentifier(row, 3, errs),
+		SourceId:             parseIdentifier(row, 4, errs),
+		DestinationId:        parseIdentifier(row, 5, errs),
+		RelationshipGroup:    parseInt(row, 6, errs),
+		TypeId:               parseIdentifier(row, 7, errs),
+		CharacteristicTypeId: parseIdentifier(row, 8, errs),
+		ModifierId:           parseIdentifier(row, 9, errs)}
 
 }
 
-func parseReferenceSetItem(ft fileType, row []string, err *[]error) *ReferenceSetItem {
-	switch ft {
+func parseReferenceSetItem(t task, row []string, err *[]error) *ReferenceSetItem {
+	switch t.fileType {
 	case refsetDescriptorRefsetFileType:
 		return parseRefsetDescriptorRefset(row, err)
 	case languageRefsetFileType:
@@ -212,8 +282,12 @@ func parseReferenceSetItem(ft fileType, row []string, err *[]error) *ReferenceSe
 		return parseAttributeValueRefset(row, err)
 	case associationRefsetFileType:
 		return parseAssociationRefset(row, err)
+	case moduleDependencyRefsetFileType:
+		return parseModuleDependencyRefset(row, err)
+	case genericRefsetFileType:
+		return parseGenericRefset(t.pattern, t.columns, row, err)
 	}
-	*err = append(*err, fmt.Errorf("error: unable to process filetype %s", ft))
+	*err = append(*err, fmt.Errorf("error: unable to process filetype %s", t.fileType))
 	return nil
 }
 
@@ -221,11 +295,11 @@ func parseReferenceSetItem(ft fileType, row []string, err *[]error) *ReferenceSe
 func parseReferenceSetHeader(row []string, errs *[]error) *ReferenceSetItem {
 	return &ReferenceSetItem{
 		Id:                    row[0], // identifier is a long unique uuid string,
-		EffectiveTime:         parseDate(row[1], errs),
-		Active:                parseBoolean(row[2], errs),
-		ModuleId:              parseIdentifier(row[3], errs),
-		RefsetId:              parseIdentifier(row[4], errs),
-		ReferencedComponentId: parseIdentifier(row[5], errs),
+		EffectiveTime:         parseDate(row, 1, errs),
+		Active:                parseBoolean(row, 2, errs),
+		ModuleId:              parseIdentifier(row, 3, errs),
+		RefsetId:              parseIdentifier(row, 4, errs),
+		ReferencedComponentId: parseIdentifier(row, 5, errs),
 	}
 }
 
@@ -234,9 +308,9 @@ func parseRefsetDescriptorRefset(row []string, errs *[]error) *ReferenceSetItem
 	item := parseReferenceSetHeader(row, errs)
 	item.Body = &ReferenceSetItem_RefsetDescriptor{
 		RefsetDescriptor: &RefSetDescriptorReferenceSet{
-			AttributeDescriptionId: parseInt(row[6], errs),
-			AttributeTypeId:        parseInt(row[7], errs),
-			AttributeOrder:         uint32(parseInt(row[8], errs)),
+			AttributeDescriptionId: parseInt(row, 6, errs),
+			AttributeTypeId:        parseInt(row, 7, errs),
+			AttributeOrder:         uint32(parseInt(row, 8, errs)),
 		},
 	}
 	return item
@@ -246,7 +320,7 @@ func parseLanguageRefset(row []string, errs *[]error) *ReferenceSetItem {
 	item := parseReferenceSetHeader(row, errs)
 	item.Body = &ReferenceSetItem_Language{
 		Language: &LanguageReferenceSet{
-			AcceptabilityId: parseInt(row[6], errs),
+			AcceptabilityId: parseInt(row, 6, errs),
 		},
 	}
 	return item
@@ -274,13 +348,13 @@ func parseExtendedMapRefset(row []string, errs *[]error) *ReferenceSetItem {
 	item := parseReferenceSetHeader(row, errs)
 	item.Body = &ReferenceSetItem_ComplexMap{
 		ComplexMap: &ComplexMapReferenceSet{
-			MapGroup:    parseInt(row[6], errs),
-			MapPriority: parseInt(row[7], errs),
+			MapGroup:    parseInt(row, 6, errs),
+			MapPriority: parseInt(row, 7, errs),
 			MapRule:     row[8],
 			MapAdvice:   row[9],
 			MapTarget:   strings.TrimSpace(row[10]),
-			Correlation: parseInt(row[11], errs),
-			MapCategory: parseInt(row[12], errs),
+			Correlation: parseInt(row, 11, errs),
+			MapCategory: parseInt(row, 12, errs),
 		},
 	}
 	return item
@@ -289,13 +363,13 @@ func parseComplexMapRefset(row []string, errs *[]error) *ReferenceSetItem {
 	item := parseReferenceSetHeader(row, errs)
 	item.Body = &ReferenceSetItem_ComplexMap{
 		ComplexMap: &ComplexMapReferenceSet{
-			MapGroup:    parseInt(row[6], errs),
-			MapPriority: parseInt(row[7], errs),
+			MapGroup:    parseInt(row, 6, errs),
+			MapPriority: parseInt(row, 7, errs),
 			MapRule:     row[8],
 			MapAdvice:   row[9],
 			MapTarget:   strings.TrimSpace(row[10]),
-			Correlation: parseInt(row[11], errs),
-			MapBlock:    parseInt(row[12], errs),
+			Correlation: parseInt(row, 11, errs),
+			MapBlock:    parseInt(row, 12, errs),
 		},
 	}
 	return item
@@ -304,7 +378,7 @@ func parseAttributeValueRefset(row []string, errs *[]error) *ReferenceSetItem {
 	item := parseReferenceSetHeader(row, errs)
 	item.Body = &ReferenceSetItem_AttributeValue{
 		AttributeValue: &AttributeValueReferenceSet{
-			ValueId: parseInt(row[6], errs),
+			ValueId: parseInt(row, 6, errs),
 		},
 	}
 	return item
@@ -313,18 +387,136 @@ func parseAssociationRefset(row []string, errs *[]error) *ReferenceSetItem {
 	item := parseReferenceSetHeader(row, errs)
 	item.Body = &ReferenceSetItem_Association{
 		Association: &AssociationReferenceSet{
-			TargetComponentId: parseInt(row[6], errs),
+			TargetComponentId: parseInt(row, 6, errs),
 		},
 	}
 	return item
 }
+func parseModuleDependencyRefset(row []string, errs *[]error) *ReferenceSetItem {
+	item := parseReferenceSetHeader(row, errs)
+	item.Body = &ReferenceSetItem_ModuleDependency{
+		ModuleDependency: &ModuleDependencyReferenceSet{
+			SourceEffectiveTime: parseDate(row, 6, errs),
+			TargetEffectiveTime: parseDate(row, 7, errs),
+		},
+	}
+	return item
+}
+
+// parseGenericRefset parses a reference set item of any type, interpreting the additional
+// fields using the pattern specified. An item without additional fields is a simple reference set item.
+func parseGenericRefset(pattern string, columns []string, row []string, errs *[]error) *ReferenceSetItem {
+	item := parseReferenceSetHeader(row, errs)
+	if pattern == "" {
+		item.Body = &ReferenceSetItem_Simple{Simple: &SimpleReferenceSet{}}
+		return item
+	}
+	fields := make([]*ReferenceSetField, len(pattern))
+	for i, p := range pattern {
+		col := 6 + i
+		field := &ReferenceSetField{Name: columns[col]}
+		switch p {
+		case 'c':
+			field.Value = &ReferenceSetField_ComponentId{ComponentId: parseIdentifier(row, col, errs)}
+		case 'i':
+			field.Value = &ReferenceSetField_IntegerValue{IntegerValue: parseInt(row, col, errs)}
+		default:
+			field.Value = &ReferenceSetField_StringValue{StringValue: row[col]}
+		}
+		fields[i] = field
+	}
+	item.Body = &ReferenceSetItem_Generic{Generic: &GenericReferenceSet{Fields: fields}}
+	return item
+}
+
+// Provenance identifies the source of a batch of components
+type Provenance struct {
+	Filename string // the file from which the batch was read
+	Batch    int    // the index of the batch within the file, from zero
+	Last     bool   // whether this is the final batch from the file
+}
 
-// ImportChannels defines the channels through which batches of data will be returned
+// ConceptBatch is a batch of concepts read from a single file
+type ConceptBatch struct {
+	Provenance
+	Concepts []*Concept
+}
+
+// DescriptionBatch is a batch of descriptions read from a single file
+type DescriptionBatch struct {
+	Provenance
+	Descriptions []*Description
+}
+
+// RelationshipBatch is a batch of relationships read from a single file
+type RelationshipBatch struct {
+	Provenance
+	Relationships []*Relationship
+}
+
+// ReferenceSetItemBatch is a batch of reference set items read from a single file
+type ReferenceSetItemBatch struct {
+	Provenance
+	Items []*ReferenceSetItem
+}
+
+// ImportOptions defines the options for an import
+type ImportOptions struct {
+	BatchSize int
+	Release   ReleaseType
+	// SkipFile, if not nil, is called for each file found, and files for which it returns true are not read.
+	SkipFile func(filename string) bool
+	// SkipBatch, if not nil, is called for each batch read, and batches for which it returns true are not
+	// parsed or returned. This permits an interrupted import to be resumed.
+	SkipBatch func(p Provenance) bool
+	// Strict, if true, stops the import at the first error in a file. Otherwise, rows that cannot be parsed,
+	// and files with unexpected columns, are skipped and reported to OnError.
+	Strict bool
+	// OnError, if not nil, is called for each error encountered when not in strict mode. It may be called concurrently.
+	OnError func(err *ParseError)
+}
+
+// ParseError is an error in a distribution file, identifying the line, and the column and value, in error
+type ParseError struct {
+	Filename string
+	Line     int    // line number, from one, the first line being the column names
+	Column   string // name of the column, or empty if the error relates to the whole line
+	Value    string // value in error, if the error relates to a single column
+	Err      error
+}
+
+func (pe *ParseError) Error() string {
+	if pe.Column == "" {
+		return fmt.Sprintf("%s:%d: %v", pe.Filename, pe.Line, pe.Err)
+	}
+	return fmt.Sprintf("%s:%d: %s '%s': %v", pe.Filename, pe.Line, pe.Column, pe.Value, pe.Err)
+}
+
+func (pe *ParseError) Unwrap() error {
+	return pe.Err
+}
+
+// report handles an error in a file, returning it in strict mode, or otherwise passing it to OnError
+func (opts ImportOptions) report(pe *ParseError) error {
+	if opts.Strict {
+		return pe
+	}
+	if opts.OnError != nil {
+		opts.OnError(pe)
+	}
+	return nil
+}
+
+// ImportChannels defines the channels through which batches of data will be returned.
+// Once all channels are closed, Err reports the first error encountered, if any.
 type ImportChannels struct {
-	Concepts      chan []*Concept
-	Descriptions  chan []*Description
-	Relationships chan []*Relationship
-	Refsets       chan []*ReferenceSetItem
+	Concepts      chan ConceptBatch
+	Descriptions  chan DescriptionBatch
+	Relationships chan RelationshipBatch
+	Refsets       chan ReferenceSetItemBatch
+	mu            sync.Mutex
+	err           error
+	cancel        context.CancelFunc
 }
 
 // Close all results channels
@@ -335,16 +527,48 @@ func (ir *ImportChannels) Close() {
 	close(ir.Refsets)
 }
 
-// Import imports all SNOMED datafiles from the specified root, returning data in batches through
-// the returned channels.
+// Err returns the first error encountered during import, which will have stopped the import
+func (ir *ImportChannels) Err() error {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+	return ir.err
+}
+
+// fail records an error, stopping the import
+func (ir *ImportChannels) fail(err error) {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+	if ir.err == nil {
+		ir.err = err
+		ir.cancel()
+	}
+}
+
+// Import imports all SNOMED snapshot datafiles from the specified root, which may be a directory
+// or a ZIP archive, returning data in batches through the returned channels.
 func Import(ctx context.Context, root string, batchSize int) *ImportChannels {
+	return ImportRelease(ctx, root, batchSize, Snapshot)
+}
+
+// ImportRelease imports all SNOMED datafiles of the specified release type from the specified root,
+// returning data in batches through the returned channels.
+func ImportRelease(ctx context.Context, root string, batchSize int, release ReleaseType) *ImportChannels {
+	return ImportWithOptions(ctx, root, ImportOptions{BatchSize: batchSize, Release: release})
+}
+
+// ImportWithOptions imports SNOMED datafiles from the specified root using the options specified,
+// returning data in batches through the returned channels.
+func ImportWithOptions(ctx context.Context, root string, opts ImportOptions) *ImportChannels {
+	ctx, cancel := context.WithCancel(ctx)
 	result := new(ImportChannels)
-	result.Concepts = make(chan []*Concept)
-	result.Descriptions = make(chan []*Description)
-	result.Relationships = make(chan []*Relationship)
-	result.Refsets = make(chan []*ReferenceSetItem)
+	result.Concepts = make(chan ConceptBatch)
+	result.Descriptions = make(chan DescriptionBatch)
+	result.Relationships = make(chan RelationshipBatch)
+	result.Refsets = make(chan ReferenceSetItemBatch)
+	result.cancel = cancel
 
-	taskc := walkFiles(ctx, root, batchSize)
+	arcs := new(archives)
+	taskc := walkFiles(ctx, root, opts, result, arcs)
 
 	// processFiles: takes tasks from walkfiles and turn into rows
 	batchc := make(chan batch) // channel to handle batches of rows for processing
@@ -352,12 +576,13 @@ func Import(ctx context.Context, root string, batchSize int) *ImportChannels {
 	for i := 0; i < runtime.NumCPU(); i++ {
 		wg.Add(1)
 		go func() {
-			processFiles(ctx, taskc, batchc)
+			processFiles(ctx, taskc, batchc, opts, result)
 			wg.Done()
 		}()
 	}
 	go func() {
 		wg.Wait()
+		arcs.close() // all files have been read
 		close(batchc)
 	}()
 
@@ -366,7 +591,7 @@ func Import(ctx context.Context, root string, batchSize int) *ImportChannels {
 	for i := 0; i < runtime.NumCPU(); i++ {
 		batchWg.Add(1)
 		go func() {
-			processBatch(ctx, batchc, result)
+			processBatch(ctx, batchc, opts, result)
 			batchWg.Done()
 		}()
 	}
@@ -380,12 +605,41 @@ func Import(ctx context.Context, root string, batchSize int) *ImportChannels {
 
 type batch struct {
 	task
-	rows [][]string
+	Provenance
+	rows  [][]string
+	lines []int // line number of each row
+}
+
+// parseRows parses each row of the batch using the function specified, which should record any errors.
+// In strict mode, the first error is returned. Otherwise, errors are reported and the row skipped.
+func (b batch) parseRows(opts ImportOptions, parse func(row []string, errs *[]error)) error {
+	for i, row := range b.rows {
+		var errs []error
+		if len(row) != len(b.columns) {
+			errs = append(errs, fieldError{column: -1, err: fmt.Errorf("expected %d columns, got %d", len(b.columns), len(row))})
+		} else {
+			parse(row, &errs)
+		}
+		for _, err := range errs {
+			pe := &ParseError{Filename: b.filename, Line: b.lines[i], Err: err}
+			if fe, ok := err.(fieldError); ok {
+				pe.Err = fe.err
+				if fe.column >= 0 {
+					pe.Column, pe.Value = b.columns[fe.column], row[fe.column]
+				}
+			}
+			if err := opts.report(pe); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // walkFiles walks the directory tree from the root specified and identifies
-// SNOMED CT files and their type, emitting tasks on the created channel
-func walkFiles(ctx context.Context, root string, batchSize int) <-chan task {
+// SNOMED CT files and their type, emitting tasks on the created channel.
+// ZIP archives, including those nested within other archives, are walked in turn.
+func walkFiles(ctx context.Context, root string, opts ImportOptions, results *ImportChannels, arcs *archives) <-chan task {
 	tasks := make(chan task)
 	go func() {
 		defer close(tasks)
@@ -393,85 +647,136 @@ func walkFiles(ctx context.Context, root string, batchSize int) <-chan task {
 			if err != nil {
 				return fmt.Errorf("error processing %s : %s", path, err)
 			}
-			ft, success := calculateFileType(path)
-			if !success {
-				return nil
-			}
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case tasks <- task{filename: path, batchSize: batchSize, fileType: ft}: // when output channel free, send it a task
+			if !info.IsDir() && isArchive(path) {
+				zr, err := arcs.open(path)
+				if err != nil {
+					return err
+				}
+				return walkArchive(ctx, path, zr, opts, tasks, arcs)
 			}
-			return nil
+			return emitTask(ctx, path, nil, opts, tasks)
 		})
-		if err != nil {
-			panic(err)
+		if err != nil && err != context.Canceled {
+			results.fail(err)
 		}
 	}()
 	return tasks
 }
 
+// emitTask sends a task to process the specified file, if it is a SNOMED CT file of a supported type
+func emitTask(ctx context.Context, path string, open func() (io.ReadCloser, error), opts ImportOptions, tasks chan<- task) error {
+	ft, success := calculateFileType(path, opts.Release)
+	if !success {
+		return nil
+	}
+	if opts.SkipFile != nil && opts.SkipFile(path) {
+		return nil
+	}
+	t := task{filename: path, batchSize: opts.BatchSize, fileType: ft, open: open}
+	if ft == genericRefsetFileType {
+		t.pattern = refsetPattern.FindStringSubmatch(filepath.Base(path))[1]
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case tasks <- t: // when output channel free, send it a task
+	}
+	return nil
+}
+
 // processFiles will drain the tasks channel and then return, sending out batches of work to the batch channel
-func processFiles(ctx context.Context, tasks <-chan task, batchc chan<- batch) {
+func processFiles(ctx context.Context, tasks <-chan task, batchc chan<- batch, opts ImportOptions, results *ImportChannels) {
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case task := <-tasks:
-			if task.filename == "" {
+		case task, ok := <-tasks:
+			if !ok {
 				return
 			}
-			f, err := os.Open(task.filename)
-			if err != nil {
-				panic(fmt.Sprintf("unable to process file %s: %s", task.filename, err))
-			}
-			defer f.Close()
-			scanner := bufio.NewScanner(f)
-			// read the first line and check that we have the right column names
-			if !scanner.Scan() {
-				panic(fmt.Errorf("empty file %s", task.filename))
-			}
-			headings := strings.Split(scanner.Text(), "\t")
-			if !reflect.DeepEqual(headings, task.fileType.cols()) {
-				panic(fmt.Errorf("expecting column names: %v, got: %v", task.fileType.cols(), headings))
-			}
-			batch := batch{
-				task: task,
-				rows: make([][]string, 0, task.batchSize),
-			}
-			count := 0
-			for scanner.Scan() {
-				count++
-				row := strings.Split(scanner.Text(), "\t")
-				batch.rows = append(batch.rows, row)
-				if count == task.batchSize {
-					select {
-					case batchc <- batch:
-					case <-ctx.Done():
-						return
-					}
-					batch.rows = nil
-					count = 0
+			if err := processFile(ctx, task, batchc, opts); err != nil {
+				if err != context.Canceled {
+					results.fail(err)
 				}
-			}
-			select {
-			case batchc <- batch:
-			case <-ctx.Done():
 				return
 			}
 		}
 	}
 }
 
-func processBatch(ctx context.Context, batchc <-chan batch, results *ImportChannels) {
+// processFile reads a single file, sending out batches of rows to the batch channel
+func processFile(ctx context.Context, task task, batchc chan<- batch, opts ImportOptions) error {
+	var f io.ReadCloser
+	var err error
+	if task.open != nil {
+		f, err = task.open()
+	} else {
+		f, err = os.Open(task.filename)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to process file %s: %w", task.filename, err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	// read the first line and check that we have the right column names
+	if !scanner.Scan() {
+		return fmt.Errorf("empty file %s", task.filename)
+	}
+	headings := strings.Split(strings.TrimSuffix(scanner.Text(), "\r"), "\t") // lines may be terminated by CR LF
+	if err := task.checkColumns(headings); err != nil {
+		return opts.report(&ParseError{Filename: task.filename, Line: 1, Err: err}) // skip file unless strict
+	}
+	task.columns = headings
+	send := func(b batch) error {
+		if opts.SkipBatch != nil && opts.SkipBatch(b.Provenance) {
+			return nil
+		}
+		select {
+		case batchc <- b:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	b := batch{
+		task:       task,
+		Provenance: Provenance{Filename: task.filename},
+		rows:       make([][]string, 0, task.batchSize),
+		lines:      make([]int, 0, task.batchSize),
+	}
+	for line := 2; scanner.Scan(); line++ {
+		text := strings.TrimSuffix(scanner.Text(), "\r")
+		if text == "" {
+			continue
+		}
+		b.rows = append(b.rows, strings.Split(text, "\t"))
+		b.lines = append(b.lines, line)
+		if len(b.rows) == task.batchSize {
+			if err := send(b); err != nil {
+				return err
+			}
+			b.rows = make([][]string, 0, task.batchSize)
+			b.lines = make([]int, 0, task.batchSize)
+			b.Batch++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading %s: %w", task.filename, err)
+	}
+	b.Last = true
+	return send(b)
+}
+
+func processBatch(ctx context.Context, batchc <-chan batch, opts ImportOptions, results *ImportChannels) {
 	for batch := range batchc {
+		var err error
 		switch batch.fileType {
 		case conceptsFileType:
-			processConcepts(ctx, batch, results.Concepts)
+			err = processConcepts(ctx, batch, opts, results.Concepts)
 		case descriptionsFileType:
-			processDescriptions(ctx, batch, results.Descriptions)
+			err = processDescriptions(ctx, batch, opts, results.Descriptions)
 		case relationshipsFileType:
-			processRelationships(ctx, batch, results.Relationships)
+			err = processRelationships(ctx, batch, opts, results.Relationships)
 		case refsetDescriptorRefsetFileType,
 			languageRefsetFileType,
 			simpleRefsetFileType,
@@ -479,75 +784,84 @@ func processBatch(ctx context.Context, batchc <-chan batch, results *ImportChann
 			complexMapRefsetFileType,
 			extendedMapRefsetFileType,
 			attributeValueRefsetFileType,
-			associationRefsetFileType:
-			processReferenceSetItems(ctx, batch, results.Refsets)
+			associationRefsetFileType,
+			moduleDependencyRefsetFileType,
+			genericRefsetFileType:
+			err = processReferenceSetItems(ctx, batch, opts, results.Refsets)
 		default:
-			panic(fmt.Errorf("unsupported file type: %s", batch.fileType))
+			err = fmt.Errorf("unsupported file type: %s", batch.fileType)
+		}
+		if err != nil && err != context.Canceled {
+			results.fail(err)
 		}
 	}
 }
 
-func processConcepts(ctx context.Context, batch batch, concepts chan<- []*Concept) {
+func processConcepts(ctx context.Context, batch batch, opts ImportOptions, concepts chan<- ConceptBatch) error {
 	result := make([]*Concept, 0, len(batch.rows))
-	for _, row := range batch.rows {
-		var errs []error
-		c := parseConcept(row, &errs)
-		if len(errs) > 0 {
-			panic(fmt.Errorf("failed to parse concept %s : %v", row[0], errs))
+	err := batch.parseRows(opts, func(row []string, errs *[]error) {
+		if c := parseConcept(row, errs); len(*errs) == 0 {
+			result = append(result, c)
 		}
-		result = append(result, c)
+	})
+	if err != nil {
+		return err
 	}
 	select {
-	case concepts <- result:
+	case concepts <- ConceptBatch{Provenance: batch.Provenance, Concepts: result}:
+		return nil
 	case <-ctx.Done():
-		return
+		return ctx.Err()
 	}
 }
-func processDescriptions(ctx context.Context, batch batch, descriptions chan<- []*Description) {
+func processDescriptions(ctx context.Context, batch batch, opts ImportOptions, descriptions chan<- DescriptionBatch) error {
 	result := make([]*Description, 0, len(batch.rows))
-	for _, row := range batch.rows {
-		var errs []error
-		c := parseDescription(row, &errs)
-		if len(errs) > 0 {
-			panic(fmt.Errorf("failed to parse description %s : %v", row[0], errs))
+	err := batch.parseRows(opts, func(row []string, errs *[]error) {
+		if c := parseDescription(row, errs); len(*errs) == 0 {
+			result = append(result, c)
 		}
-		result = append(result, c)
+	})
+	if err != nil {
+		return err
 	}
 	select {
-	case descriptions <- result:
+	case descriptions <- DescriptionBatch{Provenance: batch.Provenance, Descriptions: result}:
+		return nil
 	case <-ctx.Done():
-		return
+		return ctx.Err()
 	}
 }
-func processRelationships(ctx context.Context, batch batch, outc chan<- []*Relationship) {
+func processRelationships(ctx context.Context, batch batch, opts ImportOptions, outc chan<- RelationshipBatch) error {
 	result := make([]*Relationship, 0, len(batch.rows))
-	for _, row := range batch.rows {
-		var errs []error
-		c := parseRelationship(row, &errs)
-		if len(errs) > 0 {
-			panic(fmt.Errorf("failed to parse description %s : %v", row[0], errs))
+	err := batch.parseRows(opts, func(row []string, errs *[]error) {
+		if c := parseRelationship(row, errs); len(*errs) == 0 {
+			result = append(result, c)
 		}
-		result = append(result, c)
+	})
+	if err != nil {
+		return err
 	}
 	select {
-	case outc <- result:
+	case outc <- RelationshipBatch{Provenance: batch.Provenance, Relationships: result}:
+		return nil
 	case <-ctx.Done():
-		return
+		return ctx.Err()
 	}
 }
-func processReferenceSetItems(ctx context.Context, batch batch, outc chan<- []*ReferenceSetItem) {
+func processReferenceSetItems(ctx context.Context, batch batch, opts ImportOptions, outc chan<- ReferenceSetItemBatch) error {
 	result := make([]*ReferenceSetItem, 0, len(batch.rows))
-	for _, row := range batch.rows {
-		var errs []error
-		o := parseReferenceSetItem(batch.fileType, row, &errs)
-		if len(errs) > 0 {
-			panic(fmt.Errorf("failed to parse reference set item %s : %v", row[0], errs))
+	err := batch.parseRows(opts, func(row []string, errs *[]error) {
+		if c := parseReferenceSetItem(batch.task, row, errs); len(*errs) == 0 {
+			result = append(result, c)
 		}
-		result = append(result, o)
+	})
+	if err != nil {
+		return err
 	}
 	select {
-	case outc <- result:
+	case outc <- ReferenceSetItemBatch{Provenance: batch.Provenance, Items: result}:
+		return nil
 	case <-ctx.Done():
-		return
+		return ctx.Err()
 	}
 }
@@ -0,0 +1,258 @@
+package snomed
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestCalculateFileType(t *testing.T) {
+	tests := []struct {
+		filename string
+		release  ReleaseType
+		fileType fileType
+		ok       bool
+	}{
+		{"sct2_Concept_Snapshot_INT_20190731.txt", Snapshot, conceptsFileType, true},
+		{"sct2_Concept_Snapshot_INT_20190731.txt", Delta, -1, false},
+		{"sct2_Concept_Delta_INT_20190731.txt", Delta, conceptsFileType, true},
+		{"sct2_Description_Delta-en_INT_20190731.txt", Delta, descriptionsFileType, true},
+		{"sct2_Description_Snapshot-sv_SE1000052_20200531.txt", Snapshot, descriptionsFileType, true},
+		{"der2_cRefset_LanguageDelta-en_GB1000000_20190731.txt", Delta, languageRefsetFileType, true},
+		{"der2_cRefset_LanguageDelta-en_GB1000000_20190731.txt", Snapshot, -1, false},
+		{"sct2_Relationship_Full_INT_20190731.txt", Full, relationshipsFileType, true},
+		{"der2_iisssciRefset_ExtendedMapSnapshot_INT_20190731.txt", Snapshot, complexMapRefsetFileType, true},
+		{"der2_ssRefset_ModuleDependencySnapshot_INT_20190731.txt", Snapshot, moduleDependencyRefsetFileType, true},
+		{"der2_cissccRefset_MRCMAttributeDomainSnapshot_INT_20190731.txt", Snapshot, genericRefsetFileType, true},
+		{"sct2_sRefset_OWLExpressionSnapshot_INT_20190731.txt", Snapshot, genericRefsetFileType, true},
+		{"der2_ciRefset_DescriptionTypeDelta_INT_20190731.txt", Snapshot, -1, false},
+		{"der2_Refset_SimpleSnapshot_GB1000000_20190731.txt", Snapshot, simpleRefsetFileType, true},
+	}
+	for _, test := range tests {
+		ft, ok := calculateFileType(test.filename, test.release)
+		if ok != test.ok || ft != test.fileType {
+			t.Errorf("%s (%s): expected %d/%v, got %d/%v", test.filename, test.release, test.fileType, test.ok, ft, ok)
+		}
+	}
+}
+
+// makeZip creates a ZIP archive containing the files specified
+func makeZip(t *testing.T, files map[string][]byte) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, data := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestImportArchive(t *testing.T) {
+	concepts := []byte("id\teffectiveTime\tactive\tmoduleId\tdefinitionStatusId\n" +
+		"24700007\t20190731\t1\t900000000000207008\t900000000000074008\n" +
+		"6118003\t20190731\t1\t900000000000207008\t900000000000074008\n")
+	inner := makeZip(t, map[string][]byte{
+		"SnomedCT_UKClinicalRF2/Snapshot/Terminology/sct2_Concept_Snapshot_GB1000000_20190731.txt": concepts,
+		"__MACOSX/SnomedCT_UKClinicalRF2/._sct2_Concept_Snapshot_GB1000000_20190731.txt":           []byte("junk"),
+	})
+	sum := sha256.Sum256(inner)
+	tests := []struct {
+		checksum string
+		ok       bool
+	}{
+		{hex.EncodeToString(sum[:]) + "  uk_clinical.zip\n", true},
+		{"0000000000000000000000000000000000000000000000000000000000000000\n", false},
+	}
+	for _, test := range tests {
+		root, err := ioutil.TempDir("", "rf2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(root)
+		outer := makeZip(t, map[string][]byte{
+			"uk_clinical.zip":        inner,
+			"uk_clinical.zip.sha256": []byte(test.checksum),
+		})
+		if err := ioutil.WriteFile(filepath.Join(root, "uk_sct2cl.zip"), outer, 0644); err != nil {
+			t.Fatal(err)
+		}
+		channels := Import(context.Background(), root, 500)
+		var count int
+		var provenance Provenance
+		go func() {
+			for range channels.Descriptions {
+			}
+		}()
+		go func() {
+			for range channels.Relationships {
+			}
+		}()
+		go func() {
+			for range channels.Refsets {
+			}
+		}()
+		for batch := range channels.Concepts {
+			count += len(batch.Concepts)
+			provenance = batch.Provenance
+		}
+		err = channels.Err()
+		if test.ok {
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != 2 {
+				t.Fatalf("expected 2 concepts from nested archive, got %d", count)
+			}
+			if !strings.HasSuffix(provenance.Filename, "uk_sct2cl.zip!/uk_clinical.zip!/SnomedCT_UKClinicalRF2/Snapshot/Terminology/sct2_Concept_Snapshot_GB1000000_20190731.txt") {
+				t.Fatalf("unexpected provenance: %s", provenance.Filename)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+			t.Fatalf("expected checksum mismatch, got: %v", err)
+		}
+	}
+}
+
+func TestImportGenericRefset(t *testing.T) {
+	root, err := ioutil.TempDir("", "rf2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	data := "id\teffectiveTime\tactive\tmoduleId\trefsetId\treferencedComponentId\ttargetComponentId\tpriority\tnote\n" +
+		"8e2c3f1a-3f7c-4a7e-9a61-7f2b7d0c6a01\t20200101\t1\t999000011000000103\t991381000000107\t24700007\t6118003\t2\tdemyelinating\n"
+	if err := ioutil.WriteFile(filepath.Join(root, "der2_cisRefset_LocalSnapshot_GB1000000_20200101.txt"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	channels := Import(context.Background(), root, 500)
+	go func() {
+		for range channels.Concepts {
+		}
+	}()
+	go func() {
+		for range channels.Descriptions {
+		}
+	}()
+	go func() {
+		for range channels.Relationships {
+		}
+	}()
+	var items []*ReferenceSetItem
+	for batch := range channels.Refsets {
+		items = append(items, batch.Items...)
+	}
+	if err := channels.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("expected 1 reference set item, got %d", len(items))
+	}
+	generic := items[0].GetGeneric()
+	if len(generic.GetFields()) != 3 {
+		t.Fatalf("expected 3 additional fields, got %v", generic)
+	}
+	if f := generic.Field("targetComponentId"); f.GetComponentId() != 6118003 {
+		t.Errorf("incorrect component field: %v", f)
+	}
+	if f := generic.Field("priority"); f.GetIntegerValue() != 2 || f.Format() != "2" {
+		t.Errorf("incorrect integer field: %v", f)
+	}
+	if f := generic.Field("note"); f.GetStringValue() != "demyelinating" {
+		t.Errorf("incorrect string field: %v", f)
+	}
+}
+
+// drain reads all batches of concepts, discarding other components, and returns the concepts read
+func drain(channels *ImportChannels) []*Concept {
+	go func() {
+		for range channels.Descriptions {
+		}
+	}()
+	go func() {
+		for range channels.Relationships {
+		}
+	}()
+	go func() {
+		for range channels.Refsets {
+		}
+	}()
+	var concepts []*Concept
+	for batch := range channels.Concepts {
+		concepts = append(concepts, batch.Concepts...)
+	}
+	return concepts
+}
+
+func TestImportErrors(t *testing.T) {
+	root, err := ioutil.TempDir("", "rf2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	concepts := "id\teffectiveTime\tactive\tmoduleId\tdefinitionStatusId\n" +
+		"24700007\t20190731\t1\t900000000000207008\t900000000000074008\n" +
+		"6118003\t2019-07-31\t1\t900000000000207008\t900000000000074008\n" +
+		"64572001\t20190731\t1\n"
+	if err := ioutil.WriteFile(filepath.Join(root, "sct2_Concept_Snapshot_INT_20190731.txt"), []byte(concepts), 0644); err != nil {
+		t.Fatal(err)
+	}
+	refset := "id\teffectiveTime\tactive\tmoduleId\trefsetId\n"
+	if err := ioutil.WriteFile(filepath.Join(root, "der2_cRefset_LocalSnapshot_GB1000000_20190731.txt"), []byte(refset), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var mu sync.Mutex
+	var errs []*ParseError
+	opts := ImportOptions{BatchSize: 500, OnError: func(pe *ParseError) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, pe)
+	}}
+	channels := ImportWithOptions(context.Background(), root, opts)
+	imported := drain(channels)
+	if err := channels.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(imported) != 1 || imported[0].Id != 24700007 {
+		t.Fatalf("expected only valid concept to be imported, got %v", imported)
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Filename+strconv.Itoa(errs[i].Line) < errs[j].Filename+strconv.Itoa(errs[j].Line)
+	})
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %v", errs)
+	}
+	if errs[0].Line != 1 || !strings.HasSuffix(errs[0].Filename, "der2_cRefset_LocalSnapshot_GB1000000_20190731.txt") {
+		t.Errorf("incorrect error for unexpected columns: %v", errs[0])
+	}
+	if errs[1].Line != 3 || errs[1].Column != "effectiveTime" || errs[1].Value != "2019-07-31" {
+		t.Errorf("incorrect error for invalid value: %v", errs[1])
+	}
+	if errs[2].Line != 4 || errs[2].Column != "" {
+		t.Errorf("incorrect error for missing columns: %v", errs[2])
+	}
+	opts.Strict = true
+	channels = ImportWithOptions(context.Background(), root, opts)
+	drain(channels)
+	var pe *ParseError
+	if err := channels.Err(); !errors.As(err, &pe) {
+		t.Fatalf("expected parse error in strict mode, got: %v", err)
+	}
+}
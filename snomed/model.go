@@ -35,6 +35,8 @@
 package snomed
 
 import (
+	"strconv"
+	"strings"
 	"unicode"
 
 	"golang.org/x/text/language"
@@ -100,6 +102,19 @@ func (d *Description) IsDefinition() bool {
 	return d.TypeId == int64(Definition)
 }
 
+// SemanticTag returns the semantic tag of a fully specified name, such as "disorder", or an empty string if there is none
+func (d *Description) SemanticTag() string {
+	term := strings.TrimSpace(d.Term)
+	if !d.IsFullySpecifiedName() || !strings.HasSuffix(term, ")") {
+		return ""
+	}
+	i := strings.LastIndex(term, "(")
+	if i == -1 {
+		return ""
+	}
+	return term[i+1 : len(term)-1]
+}
+
 // CaseSignificanceID provides information about case significance for the description
 type CaseSignificanceID int64
 
@@ -193,6 +208,29 @@ func (lrs *LanguageReferenceSet) IsUnacceptable() bool {
 	return true
 }
 
+// Field returns the field with the name specified, or nil if there is no such field
+func (grs *GenericReferenceSet) Field(name string) *ReferenceSetField {
+	for _, f := range grs.GetFields() {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// Format returns the value of the field as it would appear in a distribution file
+func (f *ReferenceSetField) Format() string {
+	switch v := f.GetValue().(type) {
+	case *ReferenceSetField_ComponentId:
+		return strconv.FormatInt(v.ComponentId, 10)
+	case *ReferenceSetField_IntegerValue:
+		return strconv.FormatInt(v.IntegerValue, 10)
+	case *ReferenceSetField_StringValue:
+		return v.StringValue
+	}
+	return ""
+}
+
 // IsPrecoordinated returns whether this expression is a precoordinated term.
 // This means that it is a single concept identifier with no refinement.
 func (e *Expression) IsPrecoordinated() bool {
@@ -27,7 +27,7 @@ const (
 	BodyStructure                   = 123037004
 	ClinicalFinding                 = 404684003
 	EnvironmentGeographicLocation   = 308916002
-	Event                           = 308916002
+	Event                           = 272379006
 	ObservableEntity                = 363787002
 	Organism                        = 410607006
 	PharmaceuticalBiologicalProduct = 373873005
@@ -37,16 +37,17 @@ const (
 	QualifierValue                  = 362981000
 	RecordArtefact                  = 419891008
 	SituationWithExplicitContext    = 243796009
-	SocialContext                   = 243796009
+	SocialContext                   = 48176007
 	Specimen                        = 123038009
 	StagingAndScales                = 254291000
-	Substance                       = 254291000
+	Substance                       = 105590001
 	LinkageConcept                  = 106237007
 
 	// Special concepts
 	SpecialConcept      = 370115009
 	NavigationalConcept = 363743006
 	ReferenceSetConcept = 900000000000455006
+	ModelComponent      = 900000000000441003 // the metadata concepts of SNOMED CT itself
 
 	// RelationshipType concepts
 	Attribute                    = 246061005
@@ -117,6 +118,14 @@ const (
 	Side = 182353008
 )
 
+// TopLevel are the concepts at the top of each of the hierarchies of SNOMED CT, the children of the root concept
+var TopLevel = []int64{
+	BodyStructure, ClinicalFinding, EnvironmentGeographicLocation, Event, ObservableEntity, Organism,
+	PharmaceuticalBiologicalProduct, PhysicalForce, PhysicalObject, Procedure, QualifierValue, RecordArtefact,
+	SituationWithExplicitContext, SocialContext, Specimen, StagingAndScales, Substance, LinkageConcept,
+	SpecialConcept, ModelComponent,
+}
+
 // common known reference sets useful for semantic interpretation
 const (
 	LateralisableReferenceSet        = 723264001
@@ -125,4 +134,12 @@ const (
 	SimilarToReferenceSet            = 900000000000529008
 	SameAsReferenceSet               = 900000000000527005
 	WasAReferenceSet                 = 900000000000528000
+	AlternativeReferenceSet          = 900000000000530003
+
+	ConceptInactivationIndicatorReferenceSet = 900000000000489007
+	RefsetDescriptorReferenceSet             = 900000000000456007
+	ModuleDependencyRefset                   = 900000000000534007 // represented by ModuleDependencyReferenceSet
+
+	// AcceptabilityAttribute is the attribute of a language reference set, as defined in the reference set descriptor
+	AcceptabilityAttribute = 900000000000511003
 )
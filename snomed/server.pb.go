@@ -9,6 +9,7 @@ package snomed
 import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -124,1064 +125,3292 @@ func (x *ReferenceSetItemID) GetIdentifier() string {
 	return ""
 }
 
-var File_server_proto protoreflect.FileDescriptor
-
-var file_server_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
-	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x1a, 0x0c, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x27, 0x0a, 0x05, 0x53, 0x63, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x34, 0x0a, 0x12, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49,
-	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x32, 0x8f, 0x0b, 0x0a, 0x08, 0x53, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x43, 0x54, 0x12, 0x56,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x0d, 0x2e, 0x73,
-	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x63, 0x74, 0x49, 0x44, 0x1a, 0x0f, 0x2e, 0x73, 0x6e,
-	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x22, 0x28, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
-	0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x0d, 0x2e, 0x73,
-	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x63, 0x74, 0x49, 0x44, 0x1a, 0x17, 0x2e, 0x73, 0x6e,
-	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e,
-	0x63, 0x65, 0x70, 0x74, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76,
-	0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74,
-	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x65,
-	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x74, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x6e, 0x6f,
-	0x6d, 0x65, 0x64, 0x2e, 0x53, 0x63, 0x74, 0x49, 0x44, 0x1a, 0x1b, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
-	0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d,
-	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65,
-	0x70, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d,
-	0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6f, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74,
-	0x73, 0x12, 0x0d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x63, 0x74, 0x49, 0x44,
-	0x1a, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2a, 0x12, 0x28, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x63,
-	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x7d, 0x2f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x30, 0x01, 0x12, 0x71,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
-	0x12, 0x0d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x63, 0x74, 0x49, 0x44, 0x1a,
-	0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x63, 0x6f,
-	0x6e, 0x63, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x7d, 0x2f, 0x61, 0x6c, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x30,
-	0x01, 0x12, 0x62, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x63, 0x74,
-	0x49, 0x44, 0x1a, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12,
-	0x24, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x7d, 0x12, 0x79, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x2e, 0x73,
-	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53,
-	0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x1a, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
-	0x64, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d,
-	0x12, 0x72, 0x0a, 0x08, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x17, 0x2e, 0x73,
-	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x22,
-	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f,
-	0x6d, 0x65, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6f,
-	0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x6d,
-	0x61, 0x70, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x0c, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x72, 0x6f, 0x73,
-	0x73, 0x4d, 0x61, 0x70, 0x12, 0x1c, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x6d, 0x61, 0x70, 0x73, 0x2f,
-	0x7b, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x73, 0x7d, 0x12,
-	0x5c, 0x0a, 0x03, 0x4d, 0x61, 0x70, 0x12, 0x12, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
-	0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x73, 0x6e, 0x6f,
-	0x6d, 0x65, 0x64, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f,
-	0x6d, 0x65, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6f,
-	0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x61, 0x70, 0x12, 0x60, 0x0a,
-	0x08, 0x53, 0x75, 0x62, 0x73, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
-	0x65, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x75, 0x6d, 0x65, 0x73, 0x12,
-	0x56, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x73, 0x65, 0x12, 0x14, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
-	0x64, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
-	0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x2f, 0x70, 0x61, 0x72, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x69, 0x6e,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
-	0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x69, 0x6e,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
-	0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x63,
-	0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x32, 0x9b, 0x02, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x52,
-	0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
-	0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12,
-	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x12, 0x5d, 0x0a, 0x07, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x16, 0x2e,
-	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45,
-	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d,
-	0x65, 0x64, 0x2f, 0x6e, 0x6c, 0x70, 0x2f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x3a, 0x01,
-	0x73, 0x12, 0x5e, 0x0a, 0x08, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x12, 0x16, 0x2e,
-	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53,
-	0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x74,
-	0x65, 0x6d, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x30,
-	0x01, 0x42, 0x35, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c, 0x64, 0x72, 0x69, 0x78, 0x2e,
-	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
-	0x65, 0x64, 0x63, 0x74, 0x42, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x08,
-	0x2e, 0x3b, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type SearchFeedbackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-var (
-	file_server_proto_rawDescOnce sync.Once
-	file_server_proto_rawDescData = file_server_proto_rawDesc
-)
-
-func file_server_proto_rawDescGZIP() []byte {
-	file_server_proto_rawDescOnce.Do(func() {
-		file_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_server_proto_rawDescData)
-	})
-	return file_server_proto_rawDescData
+func (x *SearchFeedbackResponse) Reset() {
+	*x = SearchFeedbackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_server_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_server_proto_goTypes = []interface{}{
-	(*SctID)(nil),                 // 0: snomed.SctID
-	(*ReferenceSetItemID)(nil),    // 1: snomed.ReferenceSetItemID
-	(*CrossMapRequest)(nil),       // 2: snomed.CrossMapRequest
-	(*TranslateFromRequest)(nil),  // 3: snomed.TranslateFromRequest
-	(*MapRequest)(nil),            // 4: snomed.MapRequest
-	(*SubsumptionRequest)(nil),    // 5: snomed.SubsumptionRequest
-	(*ParseRequest)(nil),          // 6: snomed.ParseRequest
-	(*RefinementRequest)(nil),     // 7: snomed.RefinementRequest
-	(*SearchRequest)(nil),         // 8: snomed.SearchRequest
-	(*ExtractRequest)(nil),        // 9: snomed.ExtractRequest
-	(*SynonymRequest)(nil),        // 10: snomed.SynonymRequest
-	(*Concept)(nil),               // 11: snomed.Concept
-	(*ExtendedConcept)(nil),       // 12: snomed.ExtendedConcept
-	(*ConceptDescriptions)(nil),   // 13: snomed.ConceptDescriptions
-	(*ReferenceSetItem)(nil),      // 14: snomed.ReferenceSetItem
-	(*ConceptReference)(nil),      // 15: snomed.ConceptReference
-	(*Description)(nil),           // 16: snomed.Description
-	(*TranslateFromResponse)(nil), // 17: snomed.TranslateFromResponse
-	(*MapResponse)(nil),           // 18: snomed.MapResponse
-	(*SubsumptionResponse)(nil),   // 19: snomed.SubsumptionResponse
-	(*Expression)(nil),            // 20: snomed.Expression
-	(*RefinementResponse)(nil),    // 21: snomed.RefinementResponse
-	(*SearchResponse)(nil),        // 22: snomed.SearchResponse
-	(*ExtractResponse)(nil),       // 23: snomed.ExtractResponse
-	(*SynonymResponseItem)(nil),   // 24: snomed.SynonymResponseItem
-}
-var file_server_proto_depIdxs = []int32{
-	0,  // 0: snomed.SnomedCT.GetConcept:input_type -> snomed.SctID
-	0,  // 1: snomed.SnomedCT.GetExtendedConcept:input_type -> snomed.SctID
-	0,  // 2: snomed.SnomedCT.GetDescriptions:input_type -> snomed.SctID
-	0,  // 3: snomed.SnomedCT.GetReferenceSets:input_type -> snomed.SctID
-	0,  // 4: snomed.SnomedCT.GetAllChildren:input_type -> snomed.SctID
-	0,  // 5: snomed.SnomedCT.GetDescription:input_type -> snomed.SctID
-	1,  // 6: snomed.SnomedCT.GetReferenceSetItem:input_type -> snomed.ReferenceSetItemID
-	2,  // 7: snomed.SnomedCT.CrossMap:input_type -> snomed.CrossMapRequest
-	3,  // 8: snomed.SnomedCT.FromCrossMap:input_type -> snomed.TranslateFromRequest
-	4,  // 9: snomed.SnomedCT.Map:input_type -> snomed.MapRequest
-	5,  // 10: snomed.SnomedCT.Subsumes:input_type -> snomed.SubsumptionRequest
-	6,  // 11: snomed.SnomedCT.Parse:input_type -> snomed.ParseRequest
-	7,  // 12: snomed.SnomedCT.Refinements:input_type -> snomed.RefinementRequest
-	8,  // 13: snomed.Search.Search:input_type -> snomed.SearchRequest
-	9,  // 14: snomed.Search.Extract:input_type -> snomed.ExtractRequest
-	10, // 15: snomed.Search.Synonyms:input_type -> snomed.SynonymRequest
-	11, // 16: snomed.SnomedCT.GetConcept:output_type -> snomed.Concept
-	12, // 17: snomed.SnomedCT.GetExtendedConcept:output_type -> snomed.ExtendedConcept
-	13, // 18: snomed.SnomedCT.GetDescriptions:output_type -> snomed.ConceptDescriptions
-	14, // 19: snomed.SnomedCT.GetReferenceSets:output_type -> snomed.ReferenceSetItem
-	15, // 20: snomed.SnomedCT.GetAllChildren:output_type -> snomed.ConceptReference
-	16, // 21: snomed.SnomedCT.GetDescription:output_type -> snomed.Description
-	14, // 22: snomed.SnomedCT.GetReferenceSetItem:output_type -> snomed.ReferenceSetItem
-	14, // 23: snomed.SnomedCT.CrossMap:output_type -> snomed.ReferenceSetItem
-	17, // 24: snomed.SnomedCT.FromCrossMap:output_type -> snomed.TranslateFromResponse
-	18, // 25: snomed.SnomedCT.Map:output_type -> snomed.MapResponse
-	19, // 26: snomed.SnomedCT.Subsumes:output_type -> snomed.SubsumptionResponse
-	20, // 27: snomed.SnomedCT.Parse:output_type -> snomed.Expression
-	21, // 28: snomed.SnomedCT.Refinements:output_type -> snomed.RefinementResponse
-	22, // 29: snomed.Search.Search:output_type -> snomed.SearchResponse
-	23, // 30: snomed.Search.Extract:output_type -> snomed.ExtractResponse
-	24, // 31: snomed.Search.Synonyms:output_type -> snomed.SynonymResponseItem
-	16, // [16:32] is the sub-list for method output_type
-	0,  // [0:16] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+func (x *SearchFeedbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_server_proto_init() }
-func file_server_proto_init() {
-	if File_server_proto != nil {
-		return
-	}
-	file_snomed_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SctID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReferenceSetItemID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*SearchFeedbackResponse) ProtoMessage() {}
+
+func (x *SearchFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_server_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
-			NumExtensions: 0,
-			NumServices:   2,
-		},
-		GoTypes:           file_server_proto_goTypes,
-		DependencyIndexes: file_server_proto_depIdxs,
-		MessageInfos:      file_server_proto_msgTypes,
-	}.Build()
-	File_server_proto = out.File
-	file_server_proto_rawDesc = nil
-	file_server_proto_goTypes = nil
-	file_server_proto_depIdxs = nil
+	return mi.MessageOf(x)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+// Deprecated: Use SearchFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*SearchFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{2}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+type FindReferenceSetItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-// SnomedCTClient is the client API for SnomedCT service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SnomedCTClient interface {
-	GetConcept(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*Concept, error)
-	// GetExtendedConcept returns the concept with the specified identifier.
-	// The preferred description will be determined by language preferences
-	// defined at runtime.
-	// For example, the header accept-language may be used to define language preferences
-	// using tags as per format defined by IETF (http://www.ietf.org/rfc/rfc2616.txt)
-	// or by setting at a server-wide basis.
-	GetExtendedConcept(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*ExtendedConcept, error)
-	// GetDescriptions returns descriptions for a given concept.
-	GetDescriptions(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*ConceptDescriptions, error)
-	// GetReferenceSets returns the reference sets to which this concept is a member
-	GetReferenceSets(ctx context.Context, in *SctID, opts ...grpc.CallOption) (SnomedCT_GetReferenceSetsClient, error)
-	// GetAllChildren returns all children of the specified concept
-	GetAllChildren(ctx context.Context, in *SctID, opts ...grpc.CallOption) (SnomedCT_GetAllChildrenClient, error)
-	// GetDescription returns a single description, by identifier
-	GetDescription(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*Description, error)
-	// GetReferenceSetItem returns a single item from a reference set, by identifier
-	GetReferenceSetItem(ctx context.Context, in *ReferenceSetItemID, opts ...grpc.CallOption) (*ReferenceSetItem, error)
-	// CrossMap translates from SNOMED CT to an alternative coding system via a map reference set
-	CrossMap(ctx context.Context, in *CrossMapRequest, opts ...grpc.CallOption) (SnomedCT_CrossMapClient, error)
-	// FromCrossMap translates from an external coding system to SNOMED-CT.
-	FromCrossMap(ctx context.Context, in *TranslateFromRequest, opts ...grpc.CallOption) (*TranslateFromResponse, error)
-	// Map translates a SNOMED CT concept into the best match within the specified reference set
-	Map(ctx context.Context, in *MapRequest, opts ...grpc.CallOption) (*MapResponse, error)
-	// Subsumes determines whether one concept subsumes another
-	// This is an implementation of the HL7 FHIR terminology service subsumes method
-	// (https://www.hl7.org/fhir/terminology-service.html)
-	Subsumes(ctx context.Context, in *SubsumptionRequest, opts ...grpc.CallOption) (*SubsumptionResponse, error)
-	// Parse parses a SNOMED expression (compositional grammar)
-	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*Expression, error)
-	// Refinements returns the appropriate refinements for this specified concept
-	Refinements(ctx context.Context, in *RefinementRequest, opts ...grpc.CallOption) (*RefinementResponse, error)
+	RefsetId int64  `protobuf:"varint,1,opt,name=refset_id,json=refsetId,proto3" json:"refset_id,omitempty"`
+	Field    string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-type snomedCTClient struct {
-	cc grpc.ClientConnInterface
+func (x *FindReferenceSetItemsRequest) Reset() {
+	*x = FindReferenceSetItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func NewSnomedCTClient(cc grpc.ClientConnInterface) SnomedCTClient {
-	return &snomedCTClient{cc}
+func (x *FindReferenceSetItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (c *snomedCTClient) GetConcept(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*Concept, error) {
-	out := new(Concept)
-	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/GetConcept", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (*FindReferenceSetItemsRequest) ProtoMessage() {}
+
+func (x *FindReferenceSetItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return out, nil
+	return mi.MessageOf(x)
 }
 
-func (c *snomedCTClient) GetExtendedConcept(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*ExtendedConcept, error) {
-	out := new(ExtendedConcept)
-	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/GetExtendedConcept", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Deprecated: Use FindReferenceSetItemsRequest.ProtoReflect.Descriptor instead.
+func (*FindReferenceSetItemsRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{3}
 }
 
-func (c *snomedCTClient) GetDescriptions(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*ConceptDescriptions, error) {
-	out := new(ConceptDescriptions)
-	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/GetDescriptions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *FindReferenceSetItemsRequest) GetRefsetId() int64 {
+	if x != nil {
+		return x.RefsetId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *snomedCTClient) GetReferenceSets(ctx context.Context, in *SctID, opts ...grpc.CallOption) (SnomedCT_GetReferenceSetsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_SnomedCT_serviceDesc.Streams[0], "/snomed.SnomedCT/GetReferenceSets", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &snomedCTGetReferenceSetsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (x *FindReferenceSetItemsRequest) GetField() string {
+	if x != nil {
+		return x.Field
 	}
-	return x, nil
+	return ""
 }
 
-type SnomedCT_GetReferenceSetsClient interface {
-	Recv() (*ReferenceSetItem, error)
-	grpc.ClientStream
+func (x *FindReferenceSetItemsRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
 }
 
-type snomedCTGetReferenceSetsClient struct {
-	grpc.ClientStream
+type ModulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *snomedCTGetReferenceSetsClient) Recv() (*ReferenceSetItem, error) {
-	m := new(ReferenceSetItem)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (x *ModulesRequest) Reset() {
+	*x = ModulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return m, nil
 }
 
-func (c *snomedCTClient) GetAllChildren(ctx context.Context, in *SctID, opts ...grpc.CallOption) (SnomedCT_GetAllChildrenClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_SnomedCT_serviceDesc.Streams[1], "/snomed.SnomedCT/GetAllChildren", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &snomedCTGetAllChildrenClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (x *ModulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModulesRequest) ProtoMessage() {}
+
+func (x *ModulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return x, nil
+	return mi.MessageOf(x)
 }
 
-type SnomedCT_GetAllChildrenClient interface {
-	Recv() (*ConceptReference, error)
-	grpc.ClientStream
+// Deprecated: Use ModulesRequest.ProtoReflect.Descriptor instead.
+func (*ModulesRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{4}
 }
 
-type snomedCTGetAllChildrenClient struct {
-	grpc.ClientStream
+type ModulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Modules     []*Module                `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+	Unsatisfied []*UnsatisfiedDependency `protobuf:"bytes,2,rep,name=unsatisfied,proto3" json:"unsatisfied,omitempty"`
 }
 
-func (x *snomedCTGetAllChildrenClient) Recv() (*ConceptReference, error) {
-	m := new(ConceptReference)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (x *ModulesResponse) Reset() {
+	*x = ModulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return m, nil
 }
 
-func (c *snomedCTClient) GetDescription(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*Description, error) {
-	out := new(Description)
-	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/GetDescription", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *ModulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (c *snomedCTClient) GetReferenceSetItem(ctx context.Context, in *ReferenceSetItemID, opts ...grpc.CallOption) (*ReferenceSetItem, error) {
-	out := new(ReferenceSetItem)
-	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/GetReferenceSetItem", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (*ModulesResponse) ProtoMessage() {}
+
+func (x *ModulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return out, nil
+	return mi.MessageOf(x)
 }
 
-func (c *snomedCTClient) CrossMap(ctx context.Context, in *CrossMapRequest, opts ...grpc.CallOption) (SnomedCT_CrossMapClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_SnomedCT_serviceDesc.Streams[2], "/snomed.SnomedCT/CrossMap", opts...)
-	if err != nil {
-		return nil, err
+// Deprecated: Use ModulesResponse.ProtoReflect.Descriptor instead.
+func (*ModulesResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ModulesResponse) GetModules() []*Module {
+	if x != nil {
+		return x.Modules
 	}
-	x := &snomedCTCrossMapClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return nil
+}
+
+func (x *ModulesResponse) GetUnsatisfied() []*UnsatisfiedDependency {
+	if x != nil {
+		return x.Unsatisfied
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return nil
+}
+
+type Module struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Term         string              `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`       // preferred synonym of the module, if known
+	Version      string              `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"` // version (YYYYMMDD) declared in the module dependency reference set, if any
+	Latest       string              `protobuf:"bytes,4,opt,name=latest,proto3" json:"latest,omitempty"`   // latest effective time (YYYYMMDD) of any concept or description in the module
+	Dependencies []*ModuleDependency `protobuf:"bytes,5,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+}
+
+func (x *Module) Reset() {
+	*x = Module{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return x, nil
 }
 
-type SnomedCT_CrossMapClient interface {
-	Recv() (*ReferenceSetItem, error)
-	grpc.ClientStream
+func (x *Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type snomedCTCrossMapClient struct {
-	grpc.ClientStream
+func (*Module) ProtoMessage() {}
+
+func (x *Module) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *snomedCTCrossMapClient) Recv() (*ReferenceSetItem, error) {
-	m := new(ReferenceSetItem)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+// Deprecated: Use Module.ProtoReflect.Descriptor instead.
+func (*Module) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Module) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
-	return m, nil
+	return 0
 }
 
-func (c *snomedCTClient) FromCrossMap(ctx context.Context, in *TranslateFromRequest, opts ...grpc.CallOption) (*TranslateFromResponse, error) {
-	out := new(TranslateFromResponse)
-	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/FromCrossMap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Module) GetTerm() string {
+	if x != nil {
+		return x.Term
 	}
-	return out, nil
+	return ""
 }
 
-func (c *snomedCTClient) Map(ctx context.Context, in *MapRequest, opts ...grpc.CallOption) (*MapResponse, error) {
-	out := new(MapResponse)
-	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/Map", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Module) GetVersion() string {
+	if x != nil {
+		return x.Version
 	}
-	return out, nil
+	return ""
 }
 
-func (c *snomedCTClient) Subsumes(ctx context.Context, in *SubsumptionRequest, opts ...grpc.CallOption) (*SubsumptionResponse, error) {
-	out := new(SubsumptionResponse)
-	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/Subsumes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Module) GetLatest() string {
+	if x != nil {
+		return x.Latest
 	}
-	return out, nil
+	return ""
 }
 
-func (c *snomedCTClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*Expression, error) {
-	out := new(Expression)
-	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/Parse", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (x *Module) GetDependencies() []*ModuleDependency {
+	if x != nil {
+		return x.Dependencies
 	}
-	return out, nil
+	return nil
 }
 
-func (c *snomedCTClient) Refinements(ctx context.Context, in *RefinementRequest, opts ...grpc.CallOption) (*RefinementResponse, error) {
-	out := new(RefinementResponse)
-	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/Refinements", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ModuleDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleId int64  `protobuf:"varint,1,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	Version  string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // required version (YYYYMMDD)
+}
+
+func (x *ModuleDependency) Reset() {
+	*x = ModuleDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return out, nil
 }
 
-// SnomedCTServer is the server API for SnomedCT service.
-type SnomedCTServer interface {
-	GetConcept(context.Context, *SctID) (*Concept, error)
-	// GetExtendedConcept returns the concept with the specified identifier.
-	// The preferred description will be determined by language preferences
-	// defined at runtime.
-	// For example, the header accept-language may be used to define language preferences
-	// using tags as per format defined by IETF (http://www.ietf.org/rfc/rfc2616.txt)
-	// or by setting at a server-wide basis.
-	GetExtendedConcept(context.Context, *SctID) (*ExtendedConcept, error)
-	// GetDescriptions returns descriptions for a given concept.
-	GetDescriptions(context.Context, *SctID) (*ConceptDescriptions, error)
-	// GetReferenceSets returns the reference sets to which this concept is a member
-	GetReferenceSets(*SctID, SnomedCT_GetReferenceSetsServer) error
-	// GetAllChildren returns all children of the specified concept
-	GetAllChildren(*SctID, SnomedCT_GetAllChildrenServer) error
-	// GetDescription returns a single description, by identifier
-	GetDescription(context.Context, *SctID) (*Description, error)
-	// GetReferenceSetItem returns a single item from a reference set, by identifier
-	GetReferenceSetItem(context.Context, *ReferenceSetItemID) (*ReferenceSetItem, error)
-	// CrossMap translates from SNOMED CT to an alternative coding system via a map reference set
-	CrossMap(*CrossMapRequest, SnomedCT_CrossMapServer) error
-	// FromCrossMap translates from an external coding system to SNOMED-CT.
-	FromCrossMap(context.Context, *TranslateFromRequest) (*TranslateFromResponse, error)
-	// Map translates a SNOMED CT concept into the best match within the specified reference set
-	Map(context.Context, *MapRequest) (*MapResponse, error)
-	// Subsumes determines whether one concept subsumes another
-	// This is an implementation of the HL7 FHIR terminology service subsumes method
-	// (https://www.hl7.org/fhir/terminology-service.html)
-	Subsumes(context.Context, *SubsumptionRequest) (*SubsumptionResponse, error)
-	// Parse parses a SNOMED expression (compositional grammar)
-	Parse(context.Context, *ParseRequest) (*Expression, error)
-	// Refinements returns the appropriate refinements for this specified concept
-	Refinements(context.Context, *RefinementRequest) (*RefinementResponse, error)
+func (x *ModuleDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// UnimplementedSnomedCTServer can be embedded to have forward compatible implementations.
-type UnimplementedSnomedCTServer struct {
+func (*ModuleDependency) ProtoMessage() {}
+
+func (x *ModuleDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*UnimplementedSnomedCTServer) GetConcept(context.Context, *SctID) (*Concept, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConcept not implemented")
+// Deprecated: Use ModuleDependency.ProtoReflect.Descriptor instead.
+func (*ModuleDependency) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{7}
 }
-func (*UnimplementedSnomedCTServer) GetExtendedConcept(context.Context, *SctID) (*ExtendedConcept, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExtendedConcept not implemented")
+
+func (x *ModuleDependency) GetModuleId() int64 {
+	if x != nil {
+		return x.ModuleId
+	}
+	return 0
 }
-func (*UnimplementedSnomedCTServer) GetDescriptions(context.Context, *SctID) (*ConceptDescriptions, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDescriptions not implemented")
+
+func (x *ModuleDependency) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
 }
-func (*UnimplementedSnomedCTServer) GetReferenceSets(*SctID, SnomedCT_GetReferenceSetsServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetReferenceSets not implemented")
+
+type UnsatisfiedDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleId         int64  `protobuf:"varint,1,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"` // module with the dependency, or zero for a constraint of the edition
+	DependsOn        int64  `protobuf:"varint,2,opt,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	RequiredVersion  string `protobuf:"bytes,3,opt,name=required_version,json=requiredVersion,proto3" json:"required_version,omitempty"`
+	InstalledVersion string `protobuf:"bytes,4,opt,name=installed_version,json=installedVersion,proto3" json:"installed_version,omitempty"` // empty if the module is not installed
 }
-func (*UnimplementedSnomedCTServer) GetAllChildren(*SctID, SnomedCT_GetAllChildrenServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetAllChildren not implemented")
+
+func (x *UnsatisfiedDependency) Reset() {
+	*x = UnsatisfiedDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (*UnimplementedSnomedCTServer) GetDescription(context.Context, *SctID) (*Description, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDescription not implemented")
+
+func (x *UnsatisfiedDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (*UnimplementedSnomedCTServer) GetReferenceSetItem(context.Context, *ReferenceSetItemID) (*ReferenceSetItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReferenceSetItem not implemented")
+
+func (*UnsatisfiedDependency) ProtoMessage() {}
+
+func (x *UnsatisfiedDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-func (*UnimplementedSnomedCTServer) CrossMap(*CrossMapRequest, SnomedCT_CrossMapServer) error {
-	return status.Errorf(codes.Unimplemented, "method CrossMap not implemented")
+
+// Deprecated: Use UnsatisfiedDependency.ProtoReflect.Descriptor instead.
+func (*UnsatisfiedDependency) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{8}
 }
-func (*UnimplementedSnomedCTServer) FromCrossMap(context.Context, *TranslateFromRequest) (*TranslateFromResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FromCrossMap not implemented")
+
+func (x *UnsatisfiedDependency) GetModuleId() int64 {
+	if x != nil {
+		return x.ModuleId
+	}
+	return 0
 }
-func (*UnimplementedSnomedCTServer) Map(context.Context, *MapRequest) (*MapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Map not implemented")
+
+func (x *UnsatisfiedDependency) GetDependsOn() int64 {
+	if x != nil {
+		return x.DependsOn
+	}
+	return 0
 }
-func (*UnimplementedSnomedCTServer) Subsumes(context.Context, *SubsumptionRequest) (*SubsumptionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Subsumes not implemented")
+
+func (x *UnsatisfiedDependency) GetRequiredVersion() string {
+	if x != nil {
+		return x.RequiredVersion
+	}
+	return ""
 }
-func (*UnimplementedSnomedCTServer) Parse(context.Context, *ParseRequest) (*Expression, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
+
+func (x *UnsatisfiedDependency) GetInstalledVersion() string {
+	if x != nil {
+		return x.InstalledVersion
+	}
+	return ""
 }
-func (*UnimplementedSnomedCTServer) Refinements(context.Context, *RefinementRequest) (*RefinementResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Refinements not implemented")
+
+type SwapDatabaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // name of a precomputed database within the server's database directory, or empty to reopen the configured path
 }
 
-func RegisterSnomedCTServer(s *grpc.Server, srv SnomedCTServer) {
-	s.RegisterService(&_SnomedCT_serviceDesc, srv)
+func (x *SwapDatabaseRequest) Reset() {
+	*x = SwapDatabaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func _SnomedCT_GetConcept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SctID)
-	if err := dec(in); err != nil {
+func (x *SwapDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapDatabaseRequest) ProtoMessage() {}
+
+func (x *SwapDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*SwapDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SwapDatabaseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SwapDatabaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousRelease string `protobuf:"bytes,1,opt,name=previous_release,json=previousRelease,proto3" json:"previous_release,omitempty"`
+	Release         string `protobuf:"bytes,2,opt,name=release,proto3" json:"release,omitempty"`
+}
+
+func (x *SwapDatabaseResponse) Reset() {
+	*x = SwapDatabaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapDatabaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapDatabaseResponse) ProtoMessage() {}
+
+func (x *SwapDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*SwapDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SwapDatabaseResponse) GetPreviousRelease() string {
+	if x != nil {
+		return x.PreviousRelease
+	}
+	return ""
+}
+
+func (x *SwapDatabaseResponse) GetRelease() string {
+	if x != nil {
+		return x.Release
+	}
+	return ""
+}
+
+// DictionaryEntry is a local synonym or abbreviation, which is either a synonym of a concept,
+// or is expanded to an alternative search string when it forms part of a search string.
+type DictionaryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term         string `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`                                     // e.g. "NOF #" or "AKI"
+	ConceptId    int64  `protobuf:"varint,2,opt,name=concept_id,json=conceptId,proto3" json:"concept_id,omitempty"`         // the concept of which the term is a synonym, or zero for a query expansion
+	Expansion    string `protobuf:"bytes,3,opt,name=expansion,proto3" json:"expansion,omitempty"`                           // the expansion of the term, e.g. "acute kidney injury", or empty for a synonym of a concept
+	LanguageCode string `protobuf:"bytes,4,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"` // the language of the term, if known
+}
+
+func (x *DictionaryEntry) Reset() {
+	*x = DictionaryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionaryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryEntry) ProtoMessage() {}
+
+func (x *DictionaryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryEntry.ProtoReflect.Descriptor instead.
+func (*DictionaryEntry) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DictionaryEntry) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *DictionaryEntry) GetConceptId() int64 {
+	if x != nil {
+		return x.ConceptId
+	}
+	return 0
+}
+
+func (x *DictionaryEntry) GetExpansion() string {
+	if x != nil {
+		return x.Expansion
+	}
+	return ""
+}
+
+func (x *DictionaryEntry) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+type LoadDictionaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string             `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`  // the contents of a dictionary file, if any
+	Entries []*DictionaryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`  // entries to add, if any
+	Replace bool               `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"` // whether to replace all existing entries, which are kept if any entry is invalid
+}
+
+func (x *LoadDictionaryRequest) Reset() {
+	*x = LoadDictionaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadDictionaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadDictionaryRequest) ProtoMessage() {}
+
+func (x *LoadDictionaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadDictionaryRequest.ProtoReflect.Descriptor instead.
+func (*LoadDictionaryRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LoadDictionaryRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *LoadDictionaryRequest) GetEntries() []*DictionaryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *LoadDictionaryRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type LoadDictionaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loaded int32 `protobuf:"varint,1,opt,name=loaded,proto3" json:"loaded,omitempty"` // number of entries loaded
+	Total  int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`   // total number of entries in the dictionary
+}
+
+func (x *LoadDictionaryResponse) Reset() {
+	*x = LoadDictionaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadDictionaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadDictionaryResponse) ProtoMessage() {}
+
+func (x *LoadDictionaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadDictionaryResponse.ProtoReflect.Descriptor instead.
+func (*LoadDictionaryResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LoadDictionaryResponse) GetLoaded() int32 {
+	if x != nil {
+		return x.Loaded
+	}
+	return 0
+}
+
+func (x *LoadDictionaryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListDictionaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListDictionaryRequest) Reset() {
+	*x = ListDictionaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDictionaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDictionaryRequest) ProtoMessage() {}
+
+func (x *ListDictionaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDictionaryRequest.ProtoReflect.Descriptor instead.
+func (*ListDictionaryRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{14}
+}
+
+type RemoveDictionaryEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Terms []string `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+}
+
+func (x *RemoveDictionaryEntriesRequest) Reset() {
+	*x = RemoveDictionaryEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDictionaryEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDictionaryEntriesRequest) ProtoMessage() {}
+
+func (x *RemoveDictionaryEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDictionaryEntriesRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDictionaryEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoveDictionaryEntriesRequest) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type RemoveDictionaryEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Removed int32 `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"` // number of entries removed
+}
+
+func (x *RemoveDictionaryEntriesResponse) Reset() {
+	*x = RemoveDictionaryEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDictionaryEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDictionaryEntriesResponse) ProtoMessage() {}
+
+func (x *RemoveDictionaryEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDictionaryEntriesResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDictionaryEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RemoveDictionaryEntriesResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+// a change to a component, with the effective time of the new version, or today, if omitted
+type ConceptChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Concept       *Concept             `protobuf:"bytes,1,opt,name=concept,proto3" json:"concept,omitempty"`
+	EffectiveTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+}
+
+func (x *ConceptChange) Reset() {
+	*x = ConceptChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConceptChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConceptChange) ProtoMessage() {}
+
+func (x *ConceptChange) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConceptChange.ProtoReflect.Descriptor instead.
+func (*ConceptChange) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ConceptChange) GetConcept() *Concept {
+	if x != nil {
+		return x.Concept
+	}
+	return nil
+}
+
+func (x *ConceptChange) GetEffectiveTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EffectiveTime
+	}
+	return nil
+}
+
+type DescriptionChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description   *Description         `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	EffectiveTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+}
+
+func (x *DescriptionChange) Reset() {
+	*x = DescriptionChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptionChange) ProtoMessage() {}
+
+func (x *DescriptionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptionChange.ProtoReflect.Descriptor instead.
+func (*DescriptionChange) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DescriptionChange) GetDescription() *Description {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *DescriptionChange) GetEffectiveTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EffectiveTime
+	}
+	return nil
+}
+
+type RelationshipChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Relationship  *Relationship        `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	EffectiveTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+}
+
+func (x *RelationshipChange) Reset() {
+	*x = RelationshipChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationshipChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipChange) ProtoMessage() {}
+
+func (x *RelationshipChange) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipChange.ProtoReflect.Descriptor instead.
+func (*RelationshipChange) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RelationshipChange) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+func (x *RelationshipChange) GetEffectiveTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EffectiveTime
+	}
+	return nil
+}
+
+type ReferenceSetItemChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item          *ReferenceSetItem    `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	EffectiveTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+}
+
+func (x *ReferenceSetItemChange) Reset() {
+	*x = ReferenceSetItemChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferenceSetItemChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferenceSetItemChange) ProtoMessage() {}
+
+func (x *ReferenceSetItemChange) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferenceSetItemChange.ProtoReflect.Descriptor instead.
+func (*ReferenceSetItemChange) Descriptor() ([]byte, []int) {
+	return file_server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReferenceSetItemChange) GetItem() *ReferenceSetItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *ReferenceSetItemChange) GetEffectiveTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EffectiveTime
+	}
+	return nil
+}
+
+var File_server_proto protoreflect.FileDescriptor
+
+var file_server_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x1a, 0x0c, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x27, 0x0a, 0x05, 0x53, 0x63, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x34, 0x0a, 0x12,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x1c,
+	0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7c, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x6e,
+	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66,
+	0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
+	0x65, 0x64, 0x2e, 0x55, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x61, 0x74, 0x69,
+	0x73, 0x66, 0x69, 0x65, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
+	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xab, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x64, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a,
+	0x13, 0x53, 0x77, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x14, 0x53, 0x77, 0x61, 0x70,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x0f, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0x84, 0x01, 0x0a, 0x15, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x46, 0x0a, 0x16, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x17,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x72,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x22,
+	0x3b, 0x0a, 0x1f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x7d, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x29, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x11,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x35, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x12,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0c,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x41, 0x0a, 0x0e,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x89, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0xf4, 0x0c, 0x0a, 0x08,
+	0x53, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x43, 0x54, 0x12, 0x56, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x0d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
+	0x53, 0x63, 0x74, 0x49, 0x44, 0x1a, 0x0f, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43,
+	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65,
+	0x70, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d,
+	0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43,
+	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x0d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
+	0x53, 0x63, 0x74, 0x49, 0x44, 0x1a, 0x17, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x22, 0x31,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d,
+	0x65, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x74, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x63,
+	0x74, 0x49, 0x44, 0x1a, 0x1b, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e,
+	0x63, 0x65, 0x70, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e,
+	0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x73, 0x6e,
+	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x63, 0x74, 0x49, 0x44, 0x1a, 0x18, 0x2e, 0x73, 0x6e, 0x6f,
+	0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x72,
+	0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x0d, 0x2e, 0x73, 0x6e, 0x6f,
+	0x6d, 0x65, 0x64, 0x2e, 0x53, 0x63, 0x74, 0x49, 0x44, 0x1a, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
+	0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x2f, 0x61, 0x6c,
+	0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x30, 0x01, 0x12, 0x62, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x2e,
+	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x63, 0x74, 0x49, 0x44, 0x1a, 0x13, 0x2e, 0x73,
+	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x12,
+	0x79, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53,
+	0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x44, 0x1a, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x2c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
+	0x2f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x12, 0x87, 0x01, 0x0a, 0x15, 0x46,
+	0x69, 0x6e, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x6e, 0x6f,
+	0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73,
+	0x2f, 0x7b, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x30, 0x01, 0x12, 0x72, 0x0a, 0x08, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4d, 0x61, 0x70,
+	0x12, 0x17, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4d,
+	0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
+	0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73,
+	0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x72,
+	0x6f, 0x73, 0x73, 0x6d, 0x61, 0x70, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x0c, 0x46, 0x72, 0x6f, 0x6d,
+	0x43, 0x72, 0x6f, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x1c, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x6d,
+	0x61, 0x70, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x7b, 0x73, 0x7d, 0x12, 0x5c, 0x0a, 0x03, 0x4d, 0x61, 0x70, 0x12, 0x12, 0x2e, 0x73, 0x6e, 0x6f,
+	0x6d, 0x65, 0x64, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73,
+	0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x61,
+	0x70, 0x12, 0x60, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x73, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x1a, 0x2e,
+	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
+	0x65, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x75,
+	0x6d, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x05, 0x50, 0x61, 0x72, 0x73, 0x65, 0x12, 0x14, 0x2e, 0x73,
+	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x72, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
+	0x65, 0x64, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x7a, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
+	0x2f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x63, 0x65,
+	0x70, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x32, 0x8c, 0x03, 0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x52, 0x0a,
+	0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x12, 0x5d, 0x0a, 0x07, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x16, 0x2e, 0x73,
+	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2f, 0x6e, 0x6c, 0x70, 0x2f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x3a, 0x01, 0x73,
+	0x12, 0x5e, 0x0a, 0x08, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x12, 0x16, 0x2e, 0x73,
+	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x79,
+	0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x30, 0x01,
+	0x12, 0x6f, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x12, 0x16, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x1a, 0x1e, 0x2e, 0x73, 0x6e, 0x6f,
+	0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2f, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x3a, 0x01,
+	0x2a, 0x32, 0xdb, 0x02, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x49, 0x0a, 0x0c, 0x53,
+	0x77, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x6e,
+	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
+	0x65, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26,
+	0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xbf, 0x04, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x15,
+	0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x0f, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43,
+	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x43, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x73, 0x6e,
+	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x12, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x12, 0x1a, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x14, 0x2e,
+	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x12, 0x52, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1e, 0x2e,
+	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x18, 0x2e,
+	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x37, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x15, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a,
+	0x0f, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74,
+	0x12, 0x43, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x1a, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x1a, 0x2e, 0x73, 0x6e,
+	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
+	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x52, 0x0a,
+	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1e, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
+	0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
+	0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x42, 0x35, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c, 0x64, 0x72, 0x69, 0x78, 0x2e,
+	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
+	0x65, 0x64, 0x63, 0x74, 0x42, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x08,
+	0x2e, 0x3b, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_server_proto_rawDescOnce sync.Once
+	file_server_proto_rawDescData = file_server_proto_rawDesc
+)
+
+func file_server_proto_rawDescGZIP() []byte {
+	file_server_proto_rawDescOnce.Do(func() {
+		file_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_server_proto_rawDescData)
+	})
+	return file_server_proto_rawDescData
+}
+
+var file_server_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_server_proto_goTypes = []interface{}{
+	(*SctID)(nil),                           // 0: snomed.SctID
+	(*ReferenceSetItemID)(nil),              // 1: snomed.ReferenceSetItemID
+	(*SearchFeedbackResponse)(nil),          // 2: snomed.SearchFeedbackResponse
+	(*FindReferenceSetItemsRequest)(nil),    // 3: snomed.FindReferenceSetItemsRequest
+	(*ModulesRequest)(nil),                  // 4: snomed.ModulesRequest
+	(*ModulesResponse)(nil),                 // 5: snomed.ModulesResponse
+	(*Module)(nil),                          // 6: snomed.Module
+	(*ModuleDependency)(nil),                // 7: snomed.ModuleDependency
+	(*UnsatisfiedDependency)(nil),           // 8: snomed.UnsatisfiedDependency
+	(*SwapDatabaseRequest)(nil),             // 9: snomed.SwapDatabaseRequest
+	(*SwapDatabaseResponse)(nil),            // 10: snomed.SwapDatabaseResponse
+	(*DictionaryEntry)(nil),                 // 11: snomed.DictionaryEntry
+	(*LoadDictionaryRequest)(nil),           // 12: snomed.LoadDictionaryRequest
+	(*LoadDictionaryResponse)(nil),          // 13: snomed.LoadDictionaryResponse
+	(*ListDictionaryRequest)(nil),           // 14: snomed.ListDictionaryRequest
+	(*RemoveDictionaryEntriesRequest)(nil),  // 15: snomed.RemoveDictionaryEntriesRequest
+	(*RemoveDictionaryEntriesResponse)(nil), // 16: snomed.RemoveDictionaryEntriesResponse
+	(*ConceptChange)(nil),                   // 17: snomed.ConceptChange
+	(*DescriptionChange)(nil),               // 18: snomed.DescriptionChange
+	(*RelationshipChange)(nil),              // 19: snomed.RelationshipChange
+	(*ReferenceSetItemChange)(nil),          // 20: snomed.ReferenceSetItemChange
+	(*Concept)(nil),                         // 21: snomed.Concept
+	(*timestamp.Timestamp)(nil),             // 22: google.protobuf.Timestamp
+	(*Description)(nil),                     // 23: snomed.Description
+	(*Relationship)(nil),                    // 24: snomed.Relationship
+	(*ReferenceSetItem)(nil),                // 25: snomed.ReferenceSetItem
+	(*CrossMapRequest)(nil),                 // 26: snomed.CrossMapRequest
+	(*TranslateFromRequest)(nil),            // 27: snomed.TranslateFromRequest
+	(*MapRequest)(nil),                      // 28: snomed.MapRequest
+	(*SubsumptionRequest)(nil),              // 29: snomed.SubsumptionRequest
+	(*ParseRequest)(nil),                    // 30: snomed.ParseRequest
+	(*RefinementRequest)(nil),               // 31: snomed.RefinementRequest
+	(*SearchRequest)(nil),                   // 32: snomed.SearchRequest
+	(*ExtractRequest)(nil),                  // 33: snomed.ExtractRequest
+	(*SynonymRequest)(nil),                  // 34: snomed.SynonymRequest
+	(*SearchFeedback)(nil),                  // 35: snomed.SearchFeedback
+	(*ExtendedConcept)(nil),                 // 36: snomed.ExtendedConcept
+	(*ConceptDescriptions)(nil),             // 37: snomed.ConceptDescriptions
+	(*ConceptReference)(nil),                // 38: snomed.ConceptReference
+	(*TranslateFromResponse)(nil),           // 39: snomed.TranslateFromResponse
+	(*MapResponse)(nil),                     // 40: snomed.MapResponse
+	(*SubsumptionResponse)(nil),             // 41: snomed.SubsumptionResponse
+	(*Expression)(nil),                      // 42: snomed.Expression
+	(*RefinementResponse)(nil),              // 43: snomed.RefinementResponse
+	(*SearchResponse)(nil),                  // 44: snomed.SearchResponse
+	(*ExtractResponse)(nil),                 // 45: snomed.ExtractResponse
+	(*SynonymResponseItem)(nil),             // 46: snomed.SynonymResponseItem
+}
+var file_server_proto_depIdxs = []int32{
+	6,  // 0: snomed.ModulesResponse.modules:type_name -> snomed.Module
+	8,  // 1: snomed.ModulesResponse.unsatisfied:type_name -> snomed.UnsatisfiedDependency
+	7,  // 2: snomed.Module.dependencies:type_name -> snomed.ModuleDependency
+	11, // 3: snomed.LoadDictionaryRequest.entries:type_name -> snomed.DictionaryEntry
+	21, // 4: snomed.ConceptChange.concept:type_name -> snomed.Concept
+	22, // 5: snomed.ConceptChange.effective_time:type_name -> google.protobuf.Timestamp
+	23, // 6: snomed.DescriptionChange.description:type_name -> snomed.Description
+	22, // 7: snomed.DescriptionChange.effective_time:type_name -> google.protobuf.Timestamp
+	24, // 8: snomed.RelationshipChange.relationship:type_name -> snomed.Relationship
+	22, // 9: snomed.RelationshipChange.effective_time:type_name -> google.protobuf.Timestamp
+	25, // 10: snomed.ReferenceSetItemChange.item:type_name -> snomed.ReferenceSetItem
+	22, // 11: snomed.ReferenceSetItemChange.effective_time:type_name -> google.protobuf.Timestamp
+	0,  // 12: snomed.SnomedCT.GetConcept:input_type -> snomed.SctID
+	0,  // 13: snomed.SnomedCT.GetExtendedConcept:input_type -> snomed.SctID
+	0,  // 14: snomed.SnomedCT.GetDescriptions:input_type -> snomed.SctID
+	0,  // 15: snomed.SnomedCT.GetReferenceSets:input_type -> snomed.SctID
+	0,  // 16: snomed.SnomedCT.GetAllChildren:input_type -> snomed.SctID
+	0,  // 17: snomed.SnomedCT.GetDescription:input_type -> snomed.SctID
+	1,  // 18: snomed.SnomedCT.GetReferenceSetItem:input_type -> snomed.ReferenceSetItemID
+	3,  // 19: snomed.SnomedCT.FindReferenceSetItems:input_type -> snomed.FindReferenceSetItemsRequest
+	26, // 20: snomed.SnomedCT.CrossMap:input_type -> snomed.CrossMapRequest
+	27, // 21: snomed.SnomedCT.FromCrossMap:input_type -> snomed.TranslateFromRequest
+	28, // 22: snomed.SnomedCT.Map:input_type -> snomed.MapRequest
+	29, // 23: snomed.SnomedCT.Subsumes:input_type -> snomed.SubsumptionRequest
+	30, // 24: snomed.SnomedCT.Parse:input_type -> snomed.ParseRequest
+	4,  // 25: snomed.SnomedCT.GetModules:input_type -> snomed.ModulesRequest
+	31, // 26: snomed.SnomedCT.Refinements:input_type -> snomed.RefinementRequest
+	32, // 27: snomed.Search.Search:input_type -> snomed.SearchRequest
+	33, // 28: snomed.Search.Extract:input_type -> snomed.ExtractRequest
+	34, // 29: snomed.Search.Synonyms:input_type -> snomed.SynonymRequest
+	35, // 30: snomed.Search.SearchFeedback:input_type -> snomed.SearchFeedback
+	9,  // 31: snomed.Admin.SwapDatabase:input_type -> snomed.SwapDatabaseRequest
+	12, // 32: snomed.Admin.LoadDictionary:input_type -> snomed.LoadDictionaryRequest
+	14, // 33: snomed.Admin.ListDictionary:input_type -> snomed.ListDictionaryRequest
+	15, // 34: snomed.Admin.RemoveDictionaryEntries:input_type -> snomed.RemoveDictionaryEntriesRequest
+	17, // 35: snomed.Authoring.CreateConcept:input_type -> snomed.ConceptChange
+	18, // 36: snomed.Authoring.CreateDescription:input_type -> snomed.DescriptionChange
+	19, // 37: snomed.Authoring.CreateRelationship:input_type -> snomed.RelationshipChange
+	20, // 38: snomed.Authoring.CreateReferenceSetItem:input_type -> snomed.ReferenceSetItemChange
+	17, // 39: snomed.Authoring.UpdateConcept:input_type -> snomed.ConceptChange
+	18, // 40: snomed.Authoring.UpdateDescription:input_type -> snomed.DescriptionChange
+	19, // 41: snomed.Authoring.UpdateRelationship:input_type -> snomed.RelationshipChange
+	20, // 42: snomed.Authoring.UpdateReferenceSetItem:input_type -> snomed.ReferenceSetItemChange
+	21, // 43: snomed.SnomedCT.GetConcept:output_type -> snomed.Concept
+	36, // 44: snomed.SnomedCT.GetExtendedConcept:output_type -> snomed.ExtendedConcept
+	37, // 45: snomed.SnomedCT.GetDescriptions:output_type -> snomed.ConceptDescriptions
+	25, // 46: snomed.SnomedCT.GetReferenceSets:output_type -> snomed.ReferenceSetItem
+	38, // 47: snomed.SnomedCT.GetAllChildren:output_type -> snomed.ConceptReference
+	23, // 48: snomed.SnomedCT.GetDescription:output_type -> snomed.Description
+	25, // 49: snomed.SnomedCT.GetReferenceSetItem:output_type -> snomed.ReferenceSetItem
+	25, // 50: snomed.SnomedCT.FindReferenceSetItems:output_type -> snomed.ReferenceSetItem
+	25, // 51: snomed.SnomedCT.CrossMap:output_type -> snomed.ReferenceSetItem
+	39, // 52: snomed.SnomedCT.FromCrossMap:output_type -> snomed.TranslateFromResponse
+	40, // 53: snomed.SnomedCT.Map:output_type -> snomed.MapResponse
+	41, // 54: snomed.SnomedCT.Subsumes:output_type -> snomed.SubsumptionResponse
+	42, // 55: snomed.SnomedCT.Parse:output_type -> snomed.Expression
+	5,  // 56: snomed.SnomedCT.GetModules:output_type -> snomed.ModulesResponse
+	43, // 57: snomed.SnomedCT.Refinements:output_type -> snomed.RefinementResponse
+	44, // 58: snomed.Search.Search:output_type -> snomed.SearchResponse
+	45, // 59: snomed.Search.Extract:output_type -> snomed.ExtractResponse
+	46, // 60: snomed.Search.Synonyms:output_type -> snomed.SynonymResponseItem
+	2,  // 61: snomed.Search.SearchFeedback:output_type -> snomed.SearchFeedbackResponse
+	10, // 62: snomed.Admin.SwapDatabase:output_type -> snomed.SwapDatabaseResponse
+	13, // 63: snomed.Admin.LoadDictionary:output_type -> snomed.LoadDictionaryResponse
+	11, // 64: snomed.Admin.ListDictionary:output_type -> snomed.DictionaryEntry
+	16, // 65: snomed.Admin.RemoveDictionaryEntries:output_type -> snomed.RemoveDictionaryEntriesResponse
+	21, // 66: snomed.Authoring.CreateConcept:output_type -> snomed.Concept
+	23, // 67: snomed.Authoring.CreateDescription:output_type -> snomed.Description
+	24, // 68: snomed.Authoring.CreateRelationship:output_type -> snomed.Relationship
+	25, // 69: snomed.Authoring.CreateReferenceSetItem:output_type -> snomed.ReferenceSetItem
+	21, // 70: snomed.Authoring.UpdateConcept:output_type -> snomed.Concept
+	23, // 71: snomed.Authoring.UpdateDescription:output_type -> snomed.Description
+	24, // 72: snomed.Authoring.UpdateRelationship:output_type -> snomed.Relationship
+	25, // 73: snomed.Authoring.UpdateReferenceSetItem:output_type -> snomed.ReferenceSetItem
+	43, // [43:74] is the sub-list for method output_type
+	12, // [12:43] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_server_proto_init() }
+func file_server_proto_init() {
+	if File_server_proto != nil {
+		return
+	}
+	file_snomed_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SctID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReferenceSetItemID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchFeedbackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindReferenceSetItemsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Module); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleDependency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsatisfiedDependency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapDatabaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapDatabaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DictionaryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadDictionaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadDictionaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDictionaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveDictionaryEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveDictionaryEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConceptChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptionChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationshipChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReferenceSetItemChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_server_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   4,
+		},
+		GoTypes:           file_server_proto_goTypes,
+		DependencyIndexes: file_server_proto_depIdxs,
+		MessageInfos:      file_server_proto_msgTypes,
+	}.Build()
+	File_server_proto = out.File
+	file_server_proto_rawDesc = nil
+	file_server_proto_goTypes = nil
+	file_server_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// SnomedCTClient is the client API for SnomedCT service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SnomedCTClient interface {
+	GetConcept(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*Concept, error)
+	// GetExtendedConcept returns the concept with the specified identifier.
+	// The preferred description will be determined by language preferences
+	// defined at runtime.
+	// For example, the header accept-language may be used to define language preferences
+	// using tags as per format defined by IETF (http://www.ietf.org/rfc/rfc2616.txt)
+	// or by setting at a server-wide basis.
+	GetExtendedConcept(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*ExtendedConcept, error)
+	// GetDescriptions returns descriptions for a given concept.
+	GetDescriptions(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*ConceptDescriptions, error)
+	// GetReferenceSets returns the reference sets to which this concept is a member
+	GetReferenceSets(ctx context.Context, in *SctID, opts ...grpc.CallOption) (SnomedCT_GetReferenceSetsClient, error)
+	// GetAllChildren returns all children of the specified concept
+	GetAllChildren(ctx context.Context, in *SctID, opts ...grpc.CallOption) (SnomedCT_GetAllChildrenClient, error)
+	// GetDescription returns a single description, by identifier
+	GetDescription(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*Description, error)
+	// GetReferenceSetItem returns a single item from a reference set, by identifier
+	GetReferenceSetItem(ctx context.Context, in *ReferenceSetItemID, opts ...grpc.CallOption) (*ReferenceSetItem, error)
+	// FindReferenceSetItems returns the items of a reference set with an additional field of the name specified
+	// having the value specified, as it would appear in a distribution file.
+	FindReferenceSetItems(ctx context.Context, in *FindReferenceSetItemsRequest, opts ...grpc.CallOption) (SnomedCT_FindReferenceSetItemsClient, error)
+	// CrossMap translates from SNOMED CT to an alternative coding system via a map reference set
+	CrossMap(ctx context.Context, in *CrossMapRequest, opts ...grpc.CallOption) (SnomedCT_CrossMapClient, error)
+	// FromCrossMap translates from an external coding system to SNOMED-CT.
+	FromCrossMap(ctx context.Context, in *TranslateFromRequest, opts ...grpc.CallOption) (*TranslateFromResponse, error)
+	// Map translates a SNOMED CT concept into the best match within the specified reference set
+	Map(ctx context.Context, in *MapRequest, opts ...grpc.CallOption) (*MapResponse, error)
+	// Subsumes determines whether one concept subsumes another
+	// This is an implementation of the HL7 FHIR terminology service subsumes method
+	// (https://www.hl7.org/fhir/terminology-service.html)
+	Subsumes(ctx context.Context, in *SubsumptionRequest, opts ...grpc.CallOption) (*SubsumptionResponse, error)
+	// Parse parses a SNOMED expression (compositional grammar)
+	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*Expression, error)
+	// GetModules returns the installed modules, their versions and dependencies, together with any
+	// dependencies, or constraints of the declared edition, that are not satisfied.
+	GetModules(ctx context.Context, in *ModulesRequest, opts ...grpc.CallOption) (*ModulesResponse, error)
+	// Refinements returns the appropriate refinements for this specified concept
+	Refinements(ctx context.Context, in *RefinementRequest, opts ...grpc.CallOption) (*RefinementResponse, error)
+}
+
+type snomedCTClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSnomedCTClient(cc grpc.ClientConnInterface) SnomedCTClient {
+	return &snomedCTClient{cc}
+}
+
+func (c *snomedCTClient) GetConcept(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*Concept, error) {
+	out := new(Concept)
+	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/GetConcept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snomedCTClient) GetExtendedConcept(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*ExtendedConcept, error) {
+	out := new(ExtendedConcept)
+	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/GetExtendedConcept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snomedCTClient) GetDescriptions(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*ConceptDescriptions, error) {
+	out := new(ConceptDescriptions)
+	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/GetDescriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snomedCTClient) GetReferenceSets(ctx context.Context, in *SctID, opts ...grpc.CallOption) (SnomedCT_GetReferenceSetsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SnomedCT_serviceDesc.Streams[0], "/snomed.SnomedCT/GetReferenceSets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &snomedCTGetReferenceSetsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SnomedCT_GetReferenceSetsClient interface {
+	Recv() (*ReferenceSetItem, error)
+	grpc.ClientStream
+}
+
+type snomedCTGetReferenceSetsClient struct {
+	grpc.ClientStream
+}
+
+func (x *snomedCTGetReferenceSetsClient) Recv() (*ReferenceSetItem, error) {
+	m := new(ReferenceSetItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *snomedCTClient) GetAllChildren(ctx context.Context, in *SctID, opts ...grpc.CallOption) (SnomedCT_GetAllChildrenClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SnomedCT_serviceDesc.Streams[1], "/snomed.SnomedCT/GetAllChildren", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &snomedCTGetAllChildrenClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SnomedCT_GetAllChildrenClient interface {
+	Recv() (*ConceptReference, error)
+	grpc.ClientStream
+}
+
+type snomedCTGetAllChildrenClient struct {
+	grpc.ClientStream
+}
+
+func (x *snomedCTGetAllChildrenClient) Recv() (*ConceptReference, error) {
+	m := new(ConceptReference)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *snomedCTClient) GetDescription(ctx context.Context, in *SctID, opts ...grpc.CallOption) (*Description, error) {
+	out := new(Description)
+	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/GetDescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snomedCTClient) GetReferenceSetItem(ctx context.Context, in *ReferenceSetItemID, opts ...grpc.CallOption) (*ReferenceSetItem, error) {
+	out := new(ReferenceSetItem)
+	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/GetReferenceSetItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snomedCTClient) FindReferenceSetItems(ctx context.Context, in *FindReferenceSetItemsRequest, opts ...grpc.CallOption) (SnomedCT_FindReferenceSetItemsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SnomedCT_serviceDesc.Streams[2], "/snomed.SnomedCT/FindReferenceSetItems", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &snomedCTFindReferenceSetItemsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SnomedCT_FindReferenceSetItemsClient interface {
+	Recv() (*ReferenceSetItem, error)
+	grpc.ClientStream
+}
+
+type snomedCTFindReferenceSetItemsClient struct {
+	grpc.ClientStream
+}
+
+func (x *snomedCTFindReferenceSetItemsClient) Recv() (*ReferenceSetItem, error) {
+	m := new(ReferenceSetItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *snomedCTClient) CrossMap(ctx context.Context, in *CrossMapRequest, opts ...grpc.CallOption) (SnomedCT_CrossMapClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SnomedCT_serviceDesc.Streams[3], "/snomed.SnomedCT/CrossMap", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &snomedCTCrossMapClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SnomedCT_CrossMapClient interface {
+	Recv() (*ReferenceSetItem, error)
+	grpc.ClientStream
+}
+
+type snomedCTCrossMapClient struct {
+	grpc.ClientStream
+}
+
+func (x *snomedCTCrossMapClient) Recv() (*ReferenceSetItem, error) {
+	m := new(ReferenceSetItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *snomedCTClient) FromCrossMap(ctx context.Context, in *TranslateFromRequest, opts ...grpc.CallOption) (*TranslateFromResponse, error) {
+	out := new(TranslateFromResponse)
+	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/FromCrossMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snomedCTClient) Map(ctx context.Context, in *MapRequest, opts ...grpc.CallOption) (*MapResponse, error) {
+	out := new(MapResponse)
+	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/Map", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snomedCTClient) Subsumes(ctx context.Context, in *SubsumptionRequest, opts ...grpc.CallOption) (*SubsumptionResponse, error) {
+	out := new(SubsumptionResponse)
+	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/Subsumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snomedCTClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*Expression, error) {
+	out := new(Expression)
+	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/Parse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snomedCTClient) GetModules(ctx context.Context, in *ModulesRequest, opts ...grpc.CallOption) (*ModulesResponse, error) {
+	out := new(ModulesResponse)
+	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/GetModules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snomedCTClient) Refinements(ctx context.Context, in *RefinementRequest, opts ...grpc.CallOption) (*RefinementResponse, error) {
+	out := new(RefinementResponse)
+	err := c.cc.Invoke(ctx, "/snomed.SnomedCT/Refinements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SnomedCTServer is the server API for SnomedCT service.
+type SnomedCTServer interface {
+	GetConcept(context.Context, *SctID) (*Concept, error)
+	// GetExtendedConcept returns the concept with the specified identifier.
+	// The preferred description will be determined by language preferences
+	// defined at runtime.
+	// For example, the header accept-language may be used to define language preferences
+	// using tags as per format defined by IETF (http://www.ietf.org/rfc/rfc2616.txt)
+	// or by setting at a server-wide basis.
+	GetExtendedConcept(context.Context, *SctID) (*ExtendedConcept, error)
+	// GetDescriptions returns descriptions for a given concept.
+	GetDescriptions(context.Context, *SctID) (*ConceptDescriptions, error)
+	// GetReferenceSets returns the reference sets to which this concept is a member
+	GetReferenceSets(*SctID, SnomedCT_GetReferenceSetsServer) error
+	// GetAllChildren returns all children of the specified concept
+	GetAllChildren(*SctID, SnomedCT_GetAllChildrenServer) error
+	// GetDescription returns a single description, by identifier
+	GetDescription(context.Context, *SctID) (*Description, error)
+	// GetReferenceSetItem returns a single item from a reference set, by identifier
+	GetReferenceSetItem(context.Context, *ReferenceSetItemID) (*ReferenceSetItem, error)
+	// FindReferenceSetItems returns the items of a reference set with an additional field of the name specified
+	// having the value specified, as it would appear in a distribution file.
+	FindReferenceSetItems(*FindReferenceSetItemsRequest, SnomedCT_FindReferenceSetItemsServer) error
+	// CrossMap translates from SNOMED CT to an alternative coding system via a map reference set
+	CrossMap(*CrossMapRequest, SnomedCT_CrossMapServer) error
+	// FromCrossMap translates from an external coding system to SNOMED-CT.
+	FromCrossMap(context.Context, *TranslateFromRequest) (*TranslateFromResponse, error)
+	// Map translates a SNOMED CT concept into the best match within the specified reference set
+	Map(context.Context, *MapRequest) (*MapResponse, error)
+	// Subsumes determines whether one concept subsumes another
+	// This is an implementation of the HL7 FHIR terminology service subsumes method
+	// (https://www.hl7.org/fhir/terminology-service.html)
+	Subsumes(context.Context, *SubsumptionRequest) (*SubsumptionResponse, error)
+	// Parse parses a SNOMED expression (compositional grammar)
+	Parse(context.Context, *ParseRequest) (*Expression, error)
+	// GetModules returns the installed modules, their versions and dependencies, together with any
+	// dependencies, or constraints of the declared edition, that are not satisfied.
+	GetModules(context.Context, *ModulesRequest) (*ModulesResponse, error)
+	// Refinements returns the appropriate refinements for this specified concept
+	Refinements(context.Context, *RefinementRequest) (*RefinementResponse, error)
+}
+
+// UnimplementedSnomedCTServer can be embedded to have forward compatible implementations.
+type UnimplementedSnomedCTServer struct {
+}
+
+func (*UnimplementedSnomedCTServer) GetConcept(context.Context, *SctID) (*Concept, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConcept not implemented")
+}
+func (*UnimplementedSnomedCTServer) GetExtendedConcept(context.Context, *SctID) (*ExtendedConcept, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExtendedConcept not implemented")
+}
+func (*UnimplementedSnomedCTServer) GetDescriptions(context.Context, *SctID) (*ConceptDescriptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDescriptions not implemented")
+}
+func (*UnimplementedSnomedCTServer) GetReferenceSets(*SctID, SnomedCT_GetReferenceSetsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetReferenceSets not implemented")
+}
+func (*UnimplementedSnomedCTServer) GetAllChildren(*SctID, SnomedCT_GetAllChildrenServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAllChildren not implemented")
+}
+func (*UnimplementedSnomedCTServer) GetDescription(context.Context, *SctID) (*Description, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDescription not implemented")
+}
+func (*UnimplementedSnomedCTServer) GetReferenceSetItem(context.Context, *ReferenceSetItemID) (*ReferenceSetItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReferenceSetItem not implemented")
+}
+func (*UnimplementedSnomedCTServer) FindReferenceSetItems(*FindReferenceSetItemsRequest, SnomedCT_FindReferenceSetItemsServer) error {
+	return status.Errorf(codes.Unimplemented, "method FindReferenceSetItems not implemented")
+}
+func (*UnimplementedSnomedCTServer) CrossMap(*CrossMapRequest, SnomedCT_CrossMapServer) error {
+	return status.Errorf(codes.Unimplemented, "method CrossMap not implemented")
+}
+func (*UnimplementedSnomedCTServer) FromCrossMap(context.Context, *TranslateFromRequest) (*TranslateFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FromCrossMap not implemented")
+}
+func (*UnimplementedSnomedCTServer) Map(context.Context, *MapRequest) (*MapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Map not implemented")
+}
+func (*UnimplementedSnomedCTServer) Subsumes(context.Context, *SubsumptionRequest) (*SubsumptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subsumes not implemented")
+}
+func (*UnimplementedSnomedCTServer) Parse(context.Context, *ParseRequest) (*Expression, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
+}
+func (*UnimplementedSnomedCTServer) GetModules(context.Context, *ModulesRequest) (*ModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModules not implemented")
+}
+func (*UnimplementedSnomedCTServer) Refinements(context.Context, *RefinementRequest) (*RefinementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refinements not implemented")
+}
+
+func RegisterSnomedCTServer(s *grpc.Server, srv SnomedCTServer) {
+	s.RegisterService(&_SnomedCT_serviceDesc, srv)
+}
+
+func _SnomedCT_GetConcept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SctID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnomedCTServer).GetConcept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.SnomedCT/GetConcept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnomedCTServer).GetConcept(ctx, req.(*SctID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnomedCT_GetExtendedConcept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SctID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnomedCTServer).GetExtendedConcept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.SnomedCT/GetExtendedConcept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnomedCTServer).GetExtendedConcept(ctx, req.(*SctID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnomedCT_GetDescriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SctID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnomedCTServer).GetDescriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.SnomedCT/GetDescriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnomedCTServer).GetDescriptions(ctx, req.(*SctID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnomedCT_GetReferenceSets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SctID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SnomedCTServer).GetReferenceSets(m, &snomedCTGetReferenceSetsServer{stream})
+}
+
+type SnomedCT_GetReferenceSetsServer interface {
+	Send(*ReferenceSetItem) error
+	grpc.ServerStream
+}
+
+type snomedCTGetReferenceSetsServer struct {
+	grpc.ServerStream
+}
+
+func (x *snomedCTGetReferenceSetsServer) Send(m *ReferenceSetItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SnomedCT_GetAllChildren_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SctID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SnomedCTServer).GetAllChildren(m, &snomedCTGetAllChildrenServer{stream})
+}
+
+type SnomedCT_GetAllChildrenServer interface {
+	Send(*ConceptReference) error
+	grpc.ServerStream
+}
+
+type snomedCTGetAllChildrenServer struct {
+	grpc.ServerStream
+}
+
+func (x *snomedCTGetAllChildrenServer) Send(m *ConceptReference) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SnomedCT_GetDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SctID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnomedCTServer).GetDescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.SnomedCT/GetDescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnomedCTServer).GetDescription(ctx, req.(*SctID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnomedCT_GetReferenceSetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceSetItemID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnomedCTServer).GetReferenceSetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.SnomedCT/GetReferenceSetItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnomedCTServer).GetReferenceSetItem(ctx, req.(*ReferenceSetItemID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnomedCT_FindReferenceSetItems_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindReferenceSetItemsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SnomedCTServer).FindReferenceSetItems(m, &snomedCTFindReferenceSetItemsServer{stream})
+}
+
+type SnomedCT_FindReferenceSetItemsServer interface {
+	Send(*ReferenceSetItem) error
+	grpc.ServerStream
+}
+
+type snomedCTFindReferenceSetItemsServer struct {
+	grpc.ServerStream
+}
+
+func (x *snomedCTFindReferenceSetItemsServer) Send(m *ReferenceSetItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SnomedCT_CrossMap_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CrossMapRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SnomedCTServer).CrossMap(m, &snomedCTCrossMapServer{stream})
+}
+
+type SnomedCT_CrossMapServer interface {
+	Send(*ReferenceSetItem) error
+	grpc.ServerStream
+}
+
+type snomedCTCrossMapServer struct {
+	grpc.ServerStream
+}
+
+func (x *snomedCTCrossMapServer) Send(m *ReferenceSetItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SnomedCT_FromCrossMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranslateFromRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnomedCTServer).FromCrossMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.SnomedCT/FromCrossMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnomedCTServer).FromCrossMap(ctx, req.(*TranslateFromRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnomedCT_Map_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnomedCTServer).Map(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.SnomedCT/Map",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnomedCTServer).Map(ctx, req.(*MapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnomedCT_Subsumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubsumptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnomedCTServer).Subsumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.SnomedCT/Subsumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnomedCTServer).Subsumes(ctx, req.(*SubsumptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnomedCT_Parse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnomedCTServer).Parse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.SnomedCT/Parse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnomedCTServer).Parse(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnomedCT_GetModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnomedCTServer).GetModules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.SnomedCT/GetModules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnomedCTServer).GetModules(ctx, req.(*ModulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnomedCT_Refinements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefinementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnomedCTServer).Refinements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.SnomedCT/Refinements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnomedCTServer).Refinements(ctx, req.(*RefinementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SnomedCT_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "snomed.SnomedCT",
+	HandlerType: (*SnomedCTServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConcept",
+			Handler:    _SnomedCT_GetConcept_Handler,
+		},
+		{
+			MethodName: "GetExtendedConcept",
+			Handler:    _SnomedCT_GetExtendedConcept_Handler,
+		},
+		{
+			MethodName: "GetDescriptions",
+			Handler:    _SnomedCT_GetDescriptions_Handler,
+		},
+		{
+			MethodName: "GetDescription",
+			Handler:    _SnomedCT_GetDescription_Handler,
+		},
+		{
+			MethodName: "GetReferenceSetItem",
+			Handler:    _SnomedCT_GetReferenceSetItem_Handler,
+		},
+		{
+			MethodName: "FromCrossMap",
+			Handler:    _SnomedCT_FromCrossMap_Handler,
+		},
+		{
+			MethodName: "Map",
+			Handler:    _SnomedCT_Map_Handler,
+		},
+		{
+			MethodName: "Subsumes",
+			Handler:    _SnomedCT_Subsumes_Handler,
+		},
+		{
+			MethodName: "Parse",
+			Handler:    _SnomedCT_Parse_Handler,
+		},
+		{
+			MethodName: "GetModules",
+			Handler:    _SnomedCT_GetModules_Handler,
+		},
+		{
+			MethodName: "Refinements",
+			Handler:    _SnomedCT_Refinements_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetReferenceSets",
+			Handler:       _SnomedCT_GetReferenceSets_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetAllChildren",
+			Handler:       _SnomedCT_GetAllChildren_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FindReferenceSetItems",
+			Handler:       _SnomedCT_FindReferenceSetItems_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CrossMap",
+			Handler:       _SnomedCT_CrossMap_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "server.proto",
+}
+
+// SearchClient is the client API for Search service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SearchClient interface {
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (*ExtractResponse, error)
+	Synonyms(ctx context.Context, in *SynonymRequest, opts ...grpc.CallOption) (Search_SynonymsClient, error)
+	// SearchFeedback records the concept selected from the results of a search, so that concepts commonly selected
+	// for similar searches, or in similar contexts, can be ranked more highly in future.
+	SearchFeedback(ctx context.Context, in *SearchFeedback, opts ...grpc.CallOption) (*SearchFeedbackResponse, error)
+}
+
+type searchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSearchClient(cc grpc.ClientConnInterface) SearchClient {
+	return &searchClient{cc}
+}
+
+func (c *searchClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/snomed.Search/Search", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SnomedCTServer).GetConcept(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/snomed.SnomedCT/GetConcept",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnomedCTServer).GetConcept(ctx, req.(*SctID))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _SnomedCT_GetExtendedConcept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SctID)
-	if err := dec(in); err != nil {
+func (c *searchClient) Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (*ExtractResponse, error) {
+	out := new(ExtractResponse)
+	err := c.cc.Invoke(ctx, "/snomed.Search/Extract", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SnomedCTServer).GetExtendedConcept(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/snomed.SnomedCT/GetExtendedConcept",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnomedCTServer).GetExtendedConcept(ctx, req.(*SctID))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _SnomedCT_GetDescriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SctID)
-	if err := dec(in); err != nil {
+func (c *searchClient) Synonyms(ctx context.Context, in *SynonymRequest, opts ...grpc.CallOption) (Search_SynonymsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Search_serviceDesc.Streams[0], "/snomed.Search/Synonyms", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SnomedCTServer).GetDescriptions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/snomed.SnomedCT/GetDescriptions",
+	x := &searchSynonymsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnomedCTServer).GetDescriptions(ctx, req.(*SctID))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _SnomedCT_GetReferenceSets_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SctID)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(SnomedCTServer).GetReferenceSets(m, &snomedCTGetReferenceSetsServer{stream})
+type Search_SynonymsClient interface {
+	Recv() (*SynonymResponseItem, error)
+	grpc.ClientStream
 }
 
-type SnomedCT_GetReferenceSetsServer interface {
-	Send(*ReferenceSetItem) error
-	grpc.ServerStream
+type searchSynonymsClient struct {
+	grpc.ClientStream
 }
 
-type snomedCTGetReferenceSetsServer struct {
-	grpc.ServerStream
+func (x *searchSynonymsClient) Recv() (*SynonymResponseItem, error) {
+	m := new(SynonymResponseItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *snomedCTGetReferenceSetsServer) Send(m *ReferenceSetItem) error {
-	return x.ServerStream.SendMsg(m)
+func (c *searchClient) SearchFeedback(ctx context.Context, in *SearchFeedback, opts ...grpc.CallOption) (*SearchFeedbackResponse, error) {
+	out := new(SearchFeedbackResponse)
+	err := c.cc.Invoke(ctx, "/snomed.Search/SearchFeedback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _SnomedCT_GetAllChildren_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SctID)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(SnomedCTServer).GetAllChildren(m, &snomedCTGetAllChildrenServer{stream})
+// SearchServer is the server API for Search service.
+type SearchServer interface {
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	Extract(context.Context, *ExtractRequest) (*ExtractResponse, error)
+	Synonyms(*SynonymRequest, Search_SynonymsServer) error
+	// SearchFeedback records the concept selected from the results of a search, so that concepts commonly selected
+	// for similar searches, or in similar contexts, can be ranked more highly in future.
+	SearchFeedback(context.Context, *SearchFeedback) (*SearchFeedbackResponse, error)
 }
 
-type SnomedCT_GetAllChildrenServer interface {
-	Send(*ConceptReference) error
-	grpc.ServerStream
+// UnimplementedSearchServer can be embedded to have forward compatible implementations.
+type UnimplementedSearchServer struct {
 }
 
-type snomedCTGetAllChildrenServer struct {
-	grpc.ServerStream
+func (*UnimplementedSearchServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (*UnimplementedSearchServer) Extract(context.Context, *ExtractRequest) (*ExtractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Extract not implemented")
+}
+func (*UnimplementedSearchServer) Synonyms(*SynonymRequest, Search_SynonymsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Synonyms not implemented")
+}
+func (*UnimplementedSearchServer) SearchFeedback(context.Context, *SearchFeedback) (*SearchFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFeedback not implemented")
 }
 
-func (x *snomedCTGetAllChildrenServer) Send(m *ConceptReference) error {
-	return x.ServerStream.SendMsg(m)
+func RegisterSearchServer(s *grpc.Server, srv SearchServer) {
+	s.RegisterService(&_Search_serviceDesc, srv)
 }
 
-func _SnomedCT_GetDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SctID)
+func _Search_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SnomedCTServer).GetDescription(ctx, in)
+		return srv.(SearchServer).Search(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/snomed.SnomedCT/GetDescription",
+		FullMethod: "/snomed.Search/Search",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnomedCTServer).GetDescription(ctx, req.(*SctID))
+		return srv.(SearchServer).Search(ctx, req.(*SearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SnomedCT_GetReferenceSetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReferenceSetItemID)
+func _Search_Extract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtractRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SnomedCTServer).GetReferenceSetItem(ctx, in)
+		return srv.(SearchServer).Extract(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/snomed.SnomedCT/GetReferenceSetItem",
+		FullMethod: "/snomed.Search/Extract",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnomedCTServer).GetReferenceSetItem(ctx, req.(*ReferenceSetItemID))
+		return srv.(SearchServer).Extract(ctx, req.(*ExtractRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SnomedCT_CrossMap_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(CrossMapRequest)
+func _Search_Synonyms_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SynonymRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(SnomedCTServer).CrossMap(m, &snomedCTCrossMapServer{stream})
+	return srv.(SearchServer).Synonyms(m, &searchSynonymsServer{stream})
 }
 
-type SnomedCT_CrossMapServer interface {
-	Send(*ReferenceSetItem) error
+type Search_SynonymsServer interface {
+	Send(*SynonymResponseItem) error
 	grpc.ServerStream
 }
 
-type snomedCTCrossMapServer struct {
+type searchSynonymsServer struct {
 	grpc.ServerStream
 }
 
-func (x *snomedCTCrossMapServer) Send(m *ReferenceSetItem) error {
+func (x *searchSynonymsServer) Send(m *SynonymResponseItem) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _SnomedCT_FromCrossMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TranslateFromRequest)
+func _Search_SearchFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchFeedback)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SnomedCTServer).FromCrossMap(ctx, in)
+		return srv.(SearchServer).SearchFeedback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/snomed.SnomedCT/FromCrossMap",
+		FullMethod: "/snomed.Search/SearchFeedback",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnomedCTServer).FromCrossMap(ctx, req.(*TranslateFromRequest))
+		return srv.(SearchServer).SearchFeedback(ctx, req.(*SearchFeedback))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SnomedCT_Map_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MapRequest)
-	if err := dec(in); err != nil {
+var _Search_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "snomed.Search",
+	HandlerType: (*SearchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _Search_Search_Handler,
+		},
+		{
+			MethodName: "Extract",
+			Handler:    _Search_Extract_Handler,
+		},
+		{
+			MethodName: "SearchFeedback",
+			Handler:    _Search_SearchFeedback_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Synonyms",
+			Handler:       _Search_Synonyms_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "server.proto",
+}
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	// SwapDatabase atomically replaces the database in use by the server, without downtime.
+	// In-flight requests complete using the previous database before it is closed.
+	SwapDatabase(ctx context.Context, in *SwapDatabaseRequest, opts ...grpc.CallOption) (*SwapDatabaseResponse, error)
+	// LoadDictionary adds entries to the local dictionary of synonyms and abbreviations merged into search results,
+	// from the contents of a dictionary file and/or from the entries given, optionally replacing all existing entries.
+	LoadDictionary(ctx context.Context, in *LoadDictionaryRequest, opts ...grpc.CallOption) (*LoadDictionaryResponse, error)
+	// ListDictionary returns all of the entries in the local dictionary.
+	ListDictionary(ctx context.Context, in *ListDictionaryRequest, opts ...grpc.CallOption) (Admin_ListDictionaryClient, error)
+	// RemoveDictionaryEntries removes the entries for the terms specified from the local dictionary.
+	RemoveDictionaryEntries(ctx context.Context, in *RemoveDictionaryEntriesRequest, opts ...grpc.CallOption) (*RemoveDictionaryEntriesResponse, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) SwapDatabase(ctx context.Context, in *SwapDatabaseRequest, opts ...grpc.CallOption) (*SwapDatabaseResponse, error) {
+	out := new(SwapDatabaseResponse)
+	err := c.cc.Invoke(ctx, "/snomed.Admin/SwapDatabase", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(SnomedCTServer).Map(ctx, in)
+	return out, nil
+}
+
+func (c *adminClient) LoadDictionary(ctx context.Context, in *LoadDictionaryRequest, opts ...grpc.CallOption) (*LoadDictionaryResponse, error) {
+	out := new(LoadDictionaryResponse)
+	err := c.cc.Invoke(ctx, "/snomed.Admin/LoadDictionary", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/snomed.SnomedCT/Map",
+	return out, nil
+}
+
+func (c *adminClient) ListDictionary(ctx context.Context, in *ListDictionaryRequest, opts ...grpc.CallOption) (Admin_ListDictionaryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Admin_serviceDesc.Streams[0], "/snomed.Admin/ListDictionary", opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnomedCTServer).Map(ctx, req.(*MapRequest))
+	x := &adminListDictionaryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func _SnomedCT_Subsumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubsumptionRequest)
+type Admin_ListDictionaryClient interface {
+	Recv() (*DictionaryEntry, error)
+	grpc.ClientStream
+}
+
+type adminListDictionaryClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminListDictionaryClient) Recv() (*DictionaryEntry, error) {
+	m := new(DictionaryEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminClient) RemoveDictionaryEntries(ctx context.Context, in *RemoveDictionaryEntriesRequest, opts ...grpc.CallOption) (*RemoveDictionaryEntriesResponse, error) {
+	out := new(RemoveDictionaryEntriesResponse)
+	err := c.cc.Invoke(ctx, "/snomed.Admin/RemoveDictionaryEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	// SwapDatabase atomically replaces the database in use by the server, without downtime.
+	// In-flight requests complete using the previous database before it is closed.
+	SwapDatabase(context.Context, *SwapDatabaseRequest) (*SwapDatabaseResponse, error)
+	// LoadDictionary adds entries to the local dictionary of synonyms and abbreviations merged into search results,
+	// from the contents of a dictionary file and/or from the entries given, optionally replacing all existing entries.
+	LoadDictionary(context.Context, *LoadDictionaryRequest) (*LoadDictionaryResponse, error)
+	// ListDictionary returns all of the entries in the local dictionary.
+	ListDictionary(*ListDictionaryRequest, Admin_ListDictionaryServer) error
+	// RemoveDictionaryEntries removes the entries for the terms specified from the local dictionary.
+	RemoveDictionaryEntries(context.Context, *RemoveDictionaryEntriesRequest) (*RemoveDictionaryEntriesResponse, error)
+}
+
+// UnimplementedAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (*UnimplementedAdminServer) SwapDatabase(context.Context, *SwapDatabaseRequest) (*SwapDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapDatabase not implemented")
+}
+func (*UnimplementedAdminServer) LoadDictionary(context.Context, *LoadDictionaryRequest) (*LoadDictionaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadDictionary not implemented")
+}
+func (*UnimplementedAdminServer) ListDictionary(*ListDictionaryRequest, Admin_ListDictionaryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDictionary not implemented")
+}
+func (*UnimplementedAdminServer) RemoveDictionaryEntries(context.Context, *RemoveDictionaryEntriesRequest) (*RemoveDictionaryEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDictionaryEntries not implemented")
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_SwapDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapDatabaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SnomedCTServer).Subsumes(ctx, in)
+		return srv.(AdminServer).SwapDatabase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/snomed.SnomedCT/Subsumes",
+		FullMethod: "/snomed.Admin/SwapDatabase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnomedCTServer).Subsumes(ctx, req.(*SubsumptionRequest))
+		return srv.(AdminServer).SwapDatabase(ctx, req.(*SwapDatabaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SnomedCT_Parse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParseRequest)
+func _Admin_LoadDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadDictionaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SnomedCTServer).Parse(ctx, in)
+		return srv.(AdminServer).LoadDictionary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/snomed.SnomedCT/Parse",
+		FullMethod: "/snomed.Admin/LoadDictionary",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnomedCTServer).Parse(ctx, req.(*ParseRequest))
+		return srv.(AdminServer).LoadDictionary(ctx, req.(*LoadDictionaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListDictionary_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDictionaryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(AdminServer).ListDictionary(m, &adminListDictionaryServer{stream})
 }
 
-func _SnomedCT_Refinements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefinementRequest)
+type Admin_ListDictionaryServer interface {
+	Send(*DictionaryEntry) error
+	grpc.ServerStream
+}
+
+type adminListDictionaryServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminListDictionaryServer) Send(m *DictionaryEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Admin_RemoveDictionaryEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDictionaryEntriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SnomedCTServer).Refinements(ctx, in)
+		return srv.(AdminServer).RemoveDictionaryEntries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/snomed.SnomedCT/Refinements",
+		FullMethod: "/snomed.Admin/RemoveDictionaryEntries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SnomedCTServer).Refinements(ctx, req.(*RefinementRequest))
+		return srv.(AdminServer).RemoveDictionaryEntries(ctx, req.(*RemoveDictionaryEntriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _SnomedCT_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "snomed.SnomedCT",
-	HandlerType: (*SnomedCTServer)(nil),
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "snomed.Admin",
+	HandlerType: (*AdminServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetConcept",
-			Handler:    _SnomedCT_GetConcept_Handler,
-		},
-		{
-			MethodName: "GetExtendedConcept",
-			Handler:    _SnomedCT_GetExtendedConcept_Handler,
-		},
-		{
-			MethodName: "GetDescriptions",
-			Handler:    _SnomedCT_GetDescriptions_Handler,
-		},
-		{
-			MethodName: "GetDescription",
-			Handler:    _SnomedCT_GetDescription_Handler,
-		},
-		{
-			MethodName: "GetReferenceSetItem",
-			Handler:    _SnomedCT_GetReferenceSetItem_Handler,
-		},
-		{
-			MethodName: "FromCrossMap",
-			Handler:    _SnomedCT_FromCrossMap_Handler,
-		},
-		{
-			MethodName: "Map",
-			Handler:    _SnomedCT_Map_Handler,
-		},
-		{
-			MethodName: "Subsumes",
-			Handler:    _SnomedCT_Subsumes_Handler,
+			MethodName: "SwapDatabase",
+			Handler:    _Admin_SwapDatabase_Handler,
 		},
 		{
-			MethodName: "Parse",
-			Handler:    _SnomedCT_Parse_Handler,
+			MethodName: "LoadDictionary",
+			Handler:    _Admin_LoadDictionary_Handler,
 		},
 		{
-			MethodName: "Refinements",
-			Handler:    _SnomedCT_Refinements_Handler,
+			MethodName: "RemoveDictionaryEntries",
+			Handler:    _Admin_RemoveDictionaryEntries_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "GetReferenceSets",
-			Handler:       _SnomedCT_GetReferenceSets_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GetAllChildren",
-			Handler:       _SnomedCT_GetAllChildren_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "CrossMap",
-			Handler:       _SnomedCT_CrossMap_Handler,
+			StreamName:    "ListDictionary",
+			Handler:       _Admin_ListDictionary_Handler,
 			ServerStreams: true,
 		},
 	},
 	Metadata: "server.proto",
 }
 
-// SearchClient is the client API for Search service.
+// AuthoringClient is the client API for Authoring service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SearchClient interface {
-	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
-	Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (*ExtractResponse, error)
-	Synonyms(ctx context.Context, in *SynonymRequest, opts ...grpc.CallOption) (Search_SynonymsClient, error)
-}
-
-type searchClient struct {
+type AuthoringClient interface {
+	CreateConcept(ctx context.Context, in *ConceptChange, opts ...grpc.CallOption) (*Concept, error)
+	CreateDescription(ctx context.Context, in *DescriptionChange, opts ...grpc.CallOption) (*Description, error)
+	CreateRelationship(ctx context.Context, in *RelationshipChange, opts ...grpc.CallOption) (*Relationship, error)
+	CreateReferenceSetItem(ctx context.Context, in *ReferenceSetItemChange, opts ...grpc.CallOption) (*ReferenceSetItem, error)
+	// Update records a new version of a component previously authored, such as to inactivate it.
+	UpdateConcept(ctx context.Context, in *ConceptChange, opts ...grpc.CallOption) (*Concept, error)
+	UpdateDescription(ctx context.Context, in *DescriptionChange, opts ...grpc.CallOption) (*Description, error)
+	UpdateRelationship(ctx context.Context, in *RelationshipChange, opts ...grpc.CallOption) (*Relationship, error)
+	UpdateReferenceSetItem(ctx context.Context, in *ReferenceSetItemChange, opts ...grpc.CallOption) (*ReferenceSetItem, error)
+}
+
+type authoringClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewSearchClient(cc grpc.ClientConnInterface) SearchClient {
-	return &searchClient{cc}
+func NewAuthoringClient(cc grpc.ClientConnInterface) AuthoringClient {
+	return &authoringClient{cc}
 }
 
-func (c *searchClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
-	out := new(SearchResponse)
-	err := c.cc.Invoke(ctx, "/snomed.Search/Search", in, out, opts...)
+func (c *authoringClient) CreateConcept(ctx context.Context, in *ConceptChange, opts ...grpc.CallOption) (*Concept, error) {
+	out := new(Concept)
+	err := c.cc.Invoke(ctx, "/snomed.Authoring/CreateConcept", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *searchClient) Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (*ExtractResponse, error) {
-	out := new(ExtractResponse)
-	err := c.cc.Invoke(ctx, "/snomed.Search/Extract", in, out, opts...)
+func (c *authoringClient) CreateDescription(ctx context.Context, in *DescriptionChange, opts ...grpc.CallOption) (*Description, error) {
+	out := new(Description)
+	err := c.cc.Invoke(ctx, "/snomed.Authoring/CreateDescription", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *searchClient) Synonyms(ctx context.Context, in *SynonymRequest, opts ...grpc.CallOption) (Search_SynonymsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Search_serviceDesc.Streams[0], "/snomed.Search/Synonyms", opts...)
+func (c *authoringClient) CreateRelationship(ctx context.Context, in *RelationshipChange, opts ...grpc.CallOption) (*Relationship, error) {
+	out := new(Relationship)
+	err := c.cc.Invoke(ctx, "/snomed.Authoring/CreateRelationship", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &searchSynonymsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *authoringClient) CreateReferenceSetItem(ctx context.Context, in *ReferenceSetItemChange, opts ...grpc.CallOption) (*ReferenceSetItem, error) {
+	out := new(ReferenceSetItem)
+	err := c.cc.Invoke(ctx, "/snomed.Authoring/CreateReferenceSetItem", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *authoringClient) UpdateConcept(ctx context.Context, in *ConceptChange, opts ...grpc.CallOption) (*Concept, error) {
+	out := new(Concept)
+	err := c.cc.Invoke(ctx, "/snomed.Authoring/UpdateConcept", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type Search_SynonymsClient interface {
-	Recv() (*SynonymResponseItem, error)
-	grpc.ClientStream
+func (c *authoringClient) UpdateDescription(ctx context.Context, in *DescriptionChange, opts ...grpc.CallOption) (*Description, error) {
+	out := new(Description)
+	err := c.cc.Invoke(ctx, "/snomed.Authoring/UpdateDescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type searchSynonymsClient struct {
-	grpc.ClientStream
+func (c *authoringClient) UpdateRelationship(ctx context.Context, in *RelationshipChange, opts ...grpc.CallOption) (*Relationship, error) {
+	out := new(Relationship)
+	err := c.cc.Invoke(ctx, "/snomed.Authoring/UpdateRelationship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *searchSynonymsClient) Recv() (*SynonymResponseItem, error) {
-	m := new(SynonymResponseItem)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *authoringClient) UpdateReferenceSetItem(ctx context.Context, in *ReferenceSetItemChange, opts ...grpc.CallOption) (*ReferenceSetItem, error) {
+	out := new(ReferenceSetItem)
+	err := c.cc.Invoke(ctx, "/snomed.Authoring/UpdateReferenceSetItem", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-// SearchServer is the server API for Search service.
-type SearchServer interface {
-	Search(context.Context, *SearchRequest) (*SearchResponse, error)
-	Extract(context.Context, *ExtractRequest) (*ExtractResponse, error)
-	Synonyms(*SynonymRequest, Search_SynonymsServer) error
+// AuthoringServer is the server API for Authoring service.
+type AuthoringServer interface {
+	CreateConcept(context.Context, *ConceptChange) (*Concept, error)
+	CreateDescription(context.Context, *DescriptionChange) (*Description, error)
+	CreateRelationship(context.Context, *RelationshipChange) (*Relationship, error)
+	CreateReferenceSetItem(context.Context, *ReferenceSetItemChange) (*ReferenceSetItem, error)
+	// Update records a new version of a component previously authored, such as to inactivate it.
+	UpdateConcept(context.Context, *ConceptChange) (*Concept, error)
+	UpdateDescription(context.Context, *DescriptionChange) (*Description, error)
+	UpdateRelationship(context.Context, *RelationshipChange) (*Relationship, error)
+	UpdateReferenceSetItem(context.Context, *ReferenceSetItemChange) (*ReferenceSetItem, error)
 }
 
-// UnimplementedSearchServer can be embedded to have forward compatible implementations.
-type UnimplementedSearchServer struct {
+// UnimplementedAuthoringServer can be embedded to have forward compatible implementations.
+type UnimplementedAuthoringServer struct {
 }
 
-func (*UnimplementedSearchServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+func (*UnimplementedAuthoringServer) CreateConcept(context.Context, *ConceptChange) (*Concept, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConcept not implemented")
 }
-func (*UnimplementedSearchServer) Extract(context.Context, *ExtractRequest) (*ExtractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Extract not implemented")
+func (*UnimplementedAuthoringServer) CreateDescription(context.Context, *DescriptionChange) (*Description, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDescription not implemented")
 }
-func (*UnimplementedSearchServer) Synonyms(*SynonymRequest, Search_SynonymsServer) error {
-	return status.Errorf(codes.Unimplemented, "method Synonyms not implemented")
+func (*UnimplementedAuthoringServer) CreateRelationship(context.Context, *RelationshipChange) (*Relationship, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRelationship not implemented")
+}
+func (*UnimplementedAuthoringServer) CreateReferenceSetItem(context.Context, *ReferenceSetItemChange) (*ReferenceSetItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReferenceSetItem not implemented")
+}
+func (*UnimplementedAuthoringServer) UpdateConcept(context.Context, *ConceptChange) (*Concept, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConcept not implemented")
+}
+func (*UnimplementedAuthoringServer) UpdateDescription(context.Context, *DescriptionChange) (*Description, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDescription not implemented")
+}
+func (*UnimplementedAuthoringServer) UpdateRelationship(context.Context, *RelationshipChange) (*Relationship, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRelationship not implemented")
+}
+func (*UnimplementedAuthoringServer) UpdateReferenceSetItem(context.Context, *ReferenceSetItemChange) (*ReferenceSetItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReferenceSetItem not implemented")
 }
 
-func RegisterSearchServer(s *grpc.Server, srv SearchServer) {
-	s.RegisterService(&_Search_serviceDesc, srv)
+func RegisterAuthoringServer(s *grpc.Server, srv AuthoringServer) {
+	s.RegisterService(&_Authoring_serviceDesc, srv)
 }
 
-func _Search_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchRequest)
+func _Authoring_CreateConcept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConceptChange)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SearchServer).Search(ctx, in)
+		return srv.(AuthoringServer).CreateConcept(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/snomed.Search/Search",
+		FullMethod: "/snomed.Authoring/CreateConcept",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SearchServer).Search(ctx, req.(*SearchRequest))
+		return srv.(AuthoringServer).CreateConcept(ctx, req.(*ConceptChange))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Search_Extract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExtractRequest)
+func _Authoring_CreateDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescriptionChange)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SearchServer).Extract(ctx, in)
+		return srv.(AuthoringServer).CreateDescription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/snomed.Search/Extract",
+		FullMethod: "/snomed.Authoring/CreateDescription",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SearchServer).Extract(ctx, req.(*ExtractRequest))
+		return srv.(AuthoringServer).CreateDescription(ctx, req.(*DescriptionChange))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Search_Synonyms_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SynonymRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Authoring_CreateRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipChange)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(SearchServer).Synonyms(m, &searchSynonymsServer{stream})
+	if interceptor == nil {
+		return srv.(AuthoringServer).CreateRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.Authoring/CreateRelationship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthoringServer).CreateRelationship(ctx, req.(*RelationshipChange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Search_SynonymsServer interface {
-	Send(*SynonymResponseItem) error
-	grpc.ServerStream
+func _Authoring_CreateReferenceSetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceSetItemChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthoringServer).CreateReferenceSetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.Authoring/CreateReferenceSetItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthoringServer).CreateReferenceSetItem(ctx, req.(*ReferenceSetItemChange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type searchSynonymsServer struct {
-	grpc.ServerStream
+func _Authoring_UpdateConcept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConceptChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthoringServer).UpdateConcept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.Authoring/UpdateConcept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthoringServer).UpdateConcept(ctx, req.(*ConceptChange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *searchSynonymsServer) Send(m *SynonymResponseItem) error {
-	return x.ServerStream.SendMsg(m)
+func _Authoring_UpdateDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescriptionChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthoringServer).UpdateDescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.Authoring/UpdateDescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthoringServer).UpdateDescription(ctx, req.(*DescriptionChange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-var _Search_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "snomed.Search",
-	HandlerType: (*SearchServer)(nil),
+func _Authoring_UpdateRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationshipChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthoringServer).UpdateRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.Authoring/UpdateRelationship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthoringServer).UpdateRelationship(ctx, req.(*RelationshipChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authoring_UpdateReferenceSetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceSetItemChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthoringServer).UpdateReferenceSetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/snomed.Authoring/UpdateReferenceSetItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthoringServer).UpdateReferenceSetItem(ctx, req.(*ReferenceSetItemChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Authoring_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "snomed.Authoring",
+	HandlerType: (*AuthoringServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Search",
-			Handler:    _Search_Search_Handler,
+			MethodName: "CreateConcept",
+			Handler:    _Authoring_CreateConcept_Handler,
 		},
 		{
-			MethodName: "Extract",
-			Handler:    _Search_Extract_Handler,
+			MethodName: "CreateDescription",
+			Handler:    _Authoring_CreateDescription_Handler,
 		},
-	},
-	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "Synonyms",
-			Handler:       _Search_Synonyms_Handler,
-			ServerStreams: true,
+			MethodName: "CreateRelationship",
+			Handler:    _Authoring_CreateRelationship_Handler,
+		},
+		{
+			MethodName: "CreateReferenceSetItem",
+			Handler:    _Authoring_CreateReferenceSetItem_Handler,
+		},
+		{
+			MethodName: "UpdateConcept",
+			Handler:    _Authoring_UpdateConcept_Handler,
+		},
+		{
+			MethodName: "UpdateDescription",
+			Handler:    _Authoring_UpdateDescription_Handler,
+		},
+		{
+			MethodName: "UpdateRelationship",
+			Handler:    _Authoring_UpdateRelationship_Handler,
+		},
+		{
+			MethodName: "UpdateReferenceSetItem",
+			Handler:    _Authoring_UpdateReferenceSetItem_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "server.proto",
 }
@@ -69,7 +69,7 @@ func (x Expression_DefinitionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Expression_DefinitionStatus.Descriptor instead.
 func (Expression_DefinitionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{15, 0}
+	return file_snomed_proto_rawDescGZIP(), []int{18, 0}
 }
 
 type SubsumptionResponse_Result int32
@@ -121,7 +121,7 @@ func (x SubsumptionResponse_Result) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SubsumptionResponse_Result.Descriptor instead.
 func (SubsumptionResponse_Result) EnumDescriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{17, 0}
+	return file_snomed_proto_rawDescGZIP(), []int{20, 0}
 }
 
 type MapRequest_Parents int32
@@ -170,7 +170,7 @@ func (x MapRequest_Parents) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MapRequest_Parents.Descriptor instead.
 func (MapRequest_Parents) EnumDescriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{23, 0}
+	return file_snomed_proto_rawDescGZIP(), []int{26, 0}
 }
 
 type SearchRequest_Fuzzy int32
@@ -219,7 +219,53 @@ func (x SearchRequest_Fuzzy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SearchRequest_Fuzzy.Descriptor instead.
 func (SearchRequest_Fuzzy) EnumDescriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{28, 0}
+	return file_snomed_proto_rawDescGZIP(), []int{31, 0}
+}
+
+type SearchRequest_Order int32
+
+const (
+	SearchRequest_ALPHABETICAL SearchRequest_Order = 0 // alphabetically by preferred term
+	SearchRequest_FREQUENCY    SearchRequest_Order = 1 // most frequently selected first, and then alphabetically
+)
+
+// Enum value maps for SearchRequest_Order.
+var (
+	SearchRequest_Order_name = map[int32]string{
+		0: "ALPHABETICAL",
+		1: "FREQUENCY",
+	}
+	SearchRequest_Order_value = map[string]int32{
+		"ALPHABETICAL": 0,
+		"FREQUENCY":    1,
+	}
+)
+
+func (x SearchRequest_Order) Enum() *SearchRequest_Order {
+	p := new(SearchRequest_Order)
+	*p = x
+	return p
+}
+
+func (x SearchRequest_Order) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchRequest_Order) Descriptor() protoreflect.EnumDescriptor {
+	return file_snomed_proto_enumTypes[4].Descriptor()
+}
+
+func (SearchRequest_Order) Type() protoreflect.EnumType {
+	return &file_snomed_proto_enumTypes[4]
+}
+
+func (x SearchRequest_Order) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchRequest_Order.Descriptor instead.
+func (SearchRequest_Order) EnumDescriptor() ([]byte, []int) {
+	return file_snomed_proto_rawDescGZIP(), []int{31, 1}
 }
 
 // A Concept represents a SNOMED-CT concept.
@@ -565,6 +611,8 @@ type ReferenceSetItem struct {
 	//	*ReferenceSetItem_ComplexMap
 	//	*ReferenceSetItem_AttributeValue
 	//	*ReferenceSetItem_Association
+	//	*ReferenceSetItem_ModuleDependency
+	//	*ReferenceSetItem_Generic
 	Body isReferenceSetItem_Body `protobuf_oneof:"body"`
 }
 
@@ -698,6 +746,20 @@ func (x *ReferenceSetItem) GetAssociation() *AssociationReferenceSet {
 	return nil
 }
 
+func (x *ReferenceSetItem) GetModuleDependency() *ModuleDependencyReferenceSet {
+	if x, ok := x.GetBody().(*ReferenceSetItem_ModuleDependency); ok {
+		return x.ModuleDependency
+	}
+	return nil
+}
+
+func (x *ReferenceSetItem) GetGeneric() *GenericReferenceSet {
+	if x, ok := x.GetBody().(*ReferenceSetItem_Generic); ok {
+		return x.Generic
+	}
+	return nil
+}
+
 type isReferenceSetItem_Body interface {
 	isReferenceSetItem_Body()
 }
@@ -730,6 +792,14 @@ type ReferenceSetItem_Association struct {
 	Association *AssociationReferenceSet `protobuf:"bytes,13,opt,name=association,proto3,oneof"`
 }
 
+type ReferenceSetItem_ModuleDependency struct {
+	ModuleDependency *ModuleDependencyReferenceSet `protobuf:"bytes,14,opt,name=module_dependency,json=moduleDependency,proto3,oneof"`
+}
+
+type ReferenceSetItem_Generic struct {
+	Generic *GenericReferenceSet `protobuf:"bytes,15,opt,name=generic,proto3,oneof"`
+}
+
 func (*ReferenceSetItem_RefsetDescriptor) isReferenceSetItem_Body() {}
 
 func (*ReferenceSetItem_Simple) isReferenceSetItem_Body() {}
@@ -744,6 +814,10 @@ func (*ReferenceSetItem_AttributeValue) isReferenceSetItem_Body() {}
 
 func (*ReferenceSetItem_Association) isReferenceSetItem_Body() {}
 
+func (*ReferenceSetItem_ModuleDependency) isReferenceSetItem_Body() {}
+
+func (*ReferenceSetItem_Generic) isReferenceSetItem_Body() {}
+
 // RefSetDescriptorReferenceSet is a type of reference set that provides information about a different reference set
 // See https://confluence.ihtsdotools.org/display/DOCRELFMT/4.2.11.+Reference+Set+Descriptor
 // It provides the additional structure for a given reference set.
@@ -1177,6 +1251,220 @@ func (x *AssociationReferenceSet) GetTargetComponentId() int64 {
 	return 0
 }
 
+// ModuleDependencyReferenceSet records the dependency of a version of one module (the module of the item)
+// on a specific version of another (the referenced component).
+// See https://confluence.ihtsdotools.org/display/DOCRELFMT/5.2.4.2+Module+Dependency+Reference+Set
+type ModuleDependencyReferenceSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceEffectiveTime *timestamp.Timestamp `protobuf:"bytes,1,opt,name=source_effective_time,json=sourceEffectiveTime,proto3" json:"source_effective_time,omitempty"` // The version of the source module that has the dependency.
+	TargetEffectiveTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=target_effective_time,json=targetEffectiveTime,proto3" json:"target_effective_time,omitempty"` // The version of the target module that is required.
+}
+
+func (x *ModuleDependencyReferenceSet) Reset() {
+	*x = ModuleDependencyReferenceSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_snomed_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleDependencyReferenceSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleDependencyReferenceSet) ProtoMessage() {}
+
+func (x *ModuleDependencyReferenceSet) ProtoReflect() protoreflect.Message {
+	mi := &file_snomed_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleDependencyReferenceSet.ProtoReflect.Descriptor instead.
+func (*ModuleDependencyReferenceSet) Descriptor() ([]byte, []int) {
+	return file_snomed_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ModuleDependencyReferenceSet) GetSourceEffectiveTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.SourceEffectiveTime
+	}
+	return nil
+}
+
+func (x *ModuleDependencyReferenceSet) GetTargetEffectiveTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.TargetEffectiveTime
+	}
+	return nil
+}
+
+// GenericReferenceSet holds the additional fields of a reference set of a type without specific support,
+// such as ordered, description format, MRCM, OWL expression and locally defined reference sets.
+// The fields are interpreted using the pattern in the name of the distribution file, and are described,
+// in order, by the reference set descriptor.
+// See https://confluence.ihtsdotools.org/display/DOCRELFMT/5.2.1+Reference+Set+Descriptor
+type GenericReferenceSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*ReferenceSetField `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *GenericReferenceSet) Reset() {
+	*x = GenericReferenceSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_snomed_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenericReferenceSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenericReferenceSet) ProtoMessage() {}
+
+func (x *GenericReferenceSet) ProtoReflect() protoreflect.Message {
+	mi := &file_snomed_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenericReferenceSet.ProtoReflect.Descriptor instead.
+func (*GenericReferenceSet) Descriptor() ([]byte, []int) {
+	return file_snomed_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GenericReferenceSet) GetFields() []*ReferenceSetField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// ReferenceSetField is an additional field of a reference set item, the type of which is determined by the pattern
+// of the reference set: 'c' for a component, 'i' for an integer and 's' for a string.
+type ReferenceSetField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // The name of the column within the distribution file.
+	// Types that are assignable to Value:
+	//	*ReferenceSetField_ComponentId
+	//	*ReferenceSetField_IntegerValue
+	//	*ReferenceSetField_StringValue
+	Value isReferenceSetField_Value `protobuf_oneof:"value"`
+}
+
+func (x *ReferenceSetField) Reset() {
+	*x = ReferenceSetField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_snomed_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferenceSetField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferenceSetField) ProtoMessage() {}
+
+func (x *ReferenceSetField) ProtoReflect() protoreflect.Message {
+	mi := &file_snomed_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferenceSetField.ProtoReflect.Descriptor instead.
+func (*ReferenceSetField) Descriptor() ([]byte, []int) {
+	return file_snomed_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReferenceSetField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *ReferenceSetField) GetValue() isReferenceSetField_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *ReferenceSetField) GetComponentId() int64 {
+	if x, ok := x.GetValue().(*ReferenceSetField_ComponentId); ok {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *ReferenceSetField) GetIntegerValue() int64 {
+	if x, ok := x.GetValue().(*ReferenceSetField_IntegerValue); ok {
+		return x.IntegerValue
+	}
+	return 0
+}
+
+func (x *ReferenceSetField) GetStringValue() string {
+	if x, ok := x.GetValue().(*ReferenceSetField_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+type isReferenceSetField_Value interface {
+	isReferenceSetField_Value()
+}
+
+type ReferenceSetField_ComponentId struct {
+	ComponentId int64 `protobuf:"varint,2,opt,name=component_id,json=componentId,proto3,oneof"`
+}
+
+type ReferenceSetField_IntegerValue struct {
+	IntegerValue int64 `protobuf:"varint,3,opt,name=integer_value,json=integerValue,proto3,oneof"`
+}
+
+type ReferenceSetField_StringValue struct {
+	StringValue string `protobuf:"bytes,4,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+func (*ReferenceSetField_ComponentId) isReferenceSetField_Value() {}
+
+func (*ReferenceSetField_IntegerValue) isReferenceSetField_Value() {}
+
+func (*ReferenceSetField_StringValue) isReferenceSetField_Value() {}
+
 // ExtendedConcept represents a concept together with
 // sufficient additional contextual information relating to the
 // concept, including reference set membership as well as
@@ -1202,7 +1490,7 @@ type ExtendedConcept struct {
 func (x *ExtendedConcept) Reset() {
 	*x = ExtendedConcept{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[11]
+		mi := &file_snomed_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1215,7 +1503,7 @@ func (x *ExtendedConcept) String() string {
 func (*ExtendedConcept) ProtoMessage() {}
 
 func (x *ExtendedConcept) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[11]
+	mi := &file_snomed_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1228,7 +1516,7 @@ func (x *ExtendedConcept) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtendedConcept.ProtoReflect.Descriptor instead.
 func (*ExtendedConcept) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{11}
+	return file_snomed_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ExtendedConcept) GetConcept() *Concept {
@@ -1297,7 +1585,7 @@ type ConceptDescriptions struct {
 func (x *ConceptDescriptions) Reset() {
 	*x = ConceptDescriptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[12]
+		mi := &file_snomed_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1310,7 +1598,7 @@ func (x *ConceptDescriptions) String() string {
 func (*ConceptDescriptions) ProtoMessage() {}
 
 func (x *ConceptDescriptions) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[12]
+	mi := &file_snomed_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1323,7 +1611,7 @@ func (x *ConceptDescriptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConceptDescriptions.ProtoReflect.Descriptor instead.
 func (*ConceptDescriptions) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{12}
+	return file_snomed_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ConceptDescriptions) GetConcept() *Concept {
@@ -1385,7 +1673,7 @@ type ExtendedDescription struct {
 func (x *ExtendedDescription) Reset() {
 	*x = ExtendedDescription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[13]
+		mi := &file_snomed_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1398,7 +1686,7 @@ func (x *ExtendedDescription) String() string {
 func (*ExtendedDescription) ProtoMessage() {}
 
 func (x *ExtendedDescription) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[13]
+	mi := &file_snomed_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1411,7 +1699,7 @@ func (x *ExtendedDescription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtendedDescription.ProtoReflect.Descriptor instead.
 func (*ExtendedDescription) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{13}
+	return file_snomed_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ExtendedDescription) GetDescription() *Description {
@@ -1476,7 +1764,7 @@ type ConceptReference struct {
 func (x *ConceptReference) Reset() {
 	*x = ConceptReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[14]
+		mi := &file_snomed_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1489,7 +1777,7 @@ func (x *ConceptReference) String() string {
 func (*ConceptReference) ProtoMessage() {}
 
 func (x *ConceptReference) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[14]
+	mi := &file_snomed_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1502,7 +1790,7 @@ func (x *ConceptReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConceptReference.ProtoReflect.Descriptor instead.
 func (*ConceptReference) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{14}
+	return file_snomed_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ConceptReference) GetConceptId() int64 {
@@ -1536,7 +1824,7 @@ type Expression struct {
 func (x *Expression) Reset() {
 	*x = Expression{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[15]
+		mi := &file_snomed_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1549,7 +1837,7 @@ func (x *Expression) String() string {
 func (*Expression) ProtoMessage() {}
 
 func (x *Expression) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[15]
+	mi := &file_snomed_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1562,7 +1850,7 @@ func (x *Expression) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Expression.ProtoReflect.Descriptor instead.
 func (*Expression) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{15}
+	return file_snomed_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Expression) GetDefinitionStatus() Expression_DefinitionStatus {
@@ -1597,7 +1885,7 @@ type SubsumptionRequest struct {
 func (x *SubsumptionRequest) Reset() {
 	*x = SubsumptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[16]
+		mi := &file_snomed_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1610,7 +1898,7 @@ func (x *SubsumptionRequest) String() string {
 func (*SubsumptionRequest) ProtoMessage() {}
 
 func (x *SubsumptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[16]
+	mi := &file_snomed_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1623,7 +1911,7 @@ func (x *SubsumptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubsumptionRequest.ProtoReflect.Descriptor instead.
 func (*SubsumptionRequest) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{16}
+	return file_snomed_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SubsumptionRequest) GetSystem() string {
@@ -1659,7 +1947,7 @@ type SubsumptionResponse struct {
 func (x *SubsumptionResponse) Reset() {
 	*x = SubsumptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[17]
+		mi := &file_snomed_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1672,7 +1960,7 @@ func (x *SubsumptionResponse) String() string {
 func (*SubsumptionResponse) ProtoMessage() {}
 
 func (x *SubsumptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[17]
+	mi := &file_snomed_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1685,7 +1973,7 @@ func (x *SubsumptionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubsumptionResponse.ProtoReflect.Descriptor instead.
 func (*SubsumptionResponse) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{17}
+	return file_snomed_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SubsumptionResponse) GetResult() SubsumptionResponse_Result {
@@ -1709,7 +1997,7 @@ type RefinementRequest struct {
 func (x *RefinementRequest) Reset() {
 	*x = RefinementRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[18]
+		mi := &file_snomed_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1722,7 +2010,7 @@ func (x *RefinementRequest) String() string {
 func (*RefinementRequest) ProtoMessage() {}
 
 func (x *RefinementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[18]
+	mi := &file_snomed_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,7 +2023,7 @@ func (x *RefinementRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefinementRequest.ProtoReflect.Descriptor instead.
 func (*RefinementRequest) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{18}
+	return file_snomed_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RefinementRequest) GetConceptId() int64 {
@@ -1764,7 +2052,7 @@ type RefinementResponse struct {
 func (x *RefinementResponse) Reset() {
 	*x = RefinementResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[19]
+		mi := &file_snomed_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1777,7 +2065,7 @@ func (x *RefinementResponse) String() string {
 func (*RefinementResponse) ProtoMessage() {}
 
 func (x *RefinementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[19]
+	mi := &file_snomed_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1790,7 +2078,7 @@ func (x *RefinementResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefinementResponse.ProtoReflect.Descriptor instead.
 func (*RefinementResponse) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{19}
+	return file_snomed_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RefinementResponse) GetConcept() *Concept {
@@ -1820,7 +2108,7 @@ type TranslateFromRequest struct {
 func (x *TranslateFromRequest) Reset() {
 	*x = TranslateFromRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[20]
+		mi := &file_snomed_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1833,7 +2121,7 @@ func (x *TranslateFromRequest) String() string {
 func (*TranslateFromRequest) ProtoMessage() {}
 
 func (x *TranslateFromRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[20]
+	mi := &file_snomed_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1846,7 +2134,7 @@ func (x *TranslateFromRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TranslateFromRequest.ProtoReflect.Descriptor instead.
 func (*TranslateFromRequest) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{20}
+	return file_snomed_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *TranslateFromRequest) GetRefsetId() int64 {
@@ -1881,7 +2169,7 @@ type TranslateFromResponse struct {
 func (x *TranslateFromResponse) Reset() {
 	*x = TranslateFromResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[21]
+		mi := &file_snomed_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1894,7 +2182,7 @@ func (x *TranslateFromResponse) String() string {
 func (*TranslateFromResponse) ProtoMessage() {}
 
 func (x *TranslateFromResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[21]
+	mi := &file_snomed_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1907,7 +2195,7 @@ func (x *TranslateFromResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TranslateFromResponse.ProtoReflect.Descriptor instead.
 func (*TranslateFromResponse) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{21}
+	return file_snomed_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *TranslateFromResponse) GetTranslations() []*TranslateFromResponse_Item {
@@ -1929,7 +2217,7 @@ type CrossMapRequest struct {
 func (x *CrossMapRequest) Reset() {
 	*x = CrossMapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[22]
+		mi := &file_snomed_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1942,7 +2230,7 @@ func (x *CrossMapRequest) String() string {
 func (*CrossMapRequest) ProtoMessage() {}
 
 func (x *CrossMapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[22]
+	mi := &file_snomed_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1955,7 +2243,7 @@ func (x *CrossMapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CrossMapRequest.ProtoReflect.Descriptor instead.
 func (*CrossMapRequest) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{22}
+	return file_snomed_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CrossMapRequest) GetConceptId() int64 {
@@ -1986,7 +2274,7 @@ type MapRequest struct {
 func (x *MapRequest) Reset() {
 	*x = MapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[23]
+		mi := &file_snomed_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1999,7 +2287,7 @@ func (x *MapRequest) String() string {
 func (*MapRequest) ProtoMessage() {}
 
 func (x *MapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[23]
+	mi := &file_snomed_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2012,7 +2300,7 @@ func (x *MapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapRequest.ProtoReflect.Descriptor instead.
 func (*MapRequest) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{23}
+	return file_snomed_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *MapRequest) GetConceptId() int64 {
@@ -2054,7 +2342,7 @@ type MapResponse struct {
 func (x *MapResponse) Reset() {
 	*x = MapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[24]
+		mi := &file_snomed_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2067,7 +2355,7 @@ func (x *MapResponse) String() string {
 func (*MapResponse) ProtoMessage() {}
 
 func (x *MapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[24]
+	mi := &file_snomed_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2080,7 +2368,7 @@ func (x *MapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapResponse.ProtoReflect.Descriptor instead.
 func (*MapResponse) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{24}
+	return file_snomed_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *MapResponse) GetTranslations() []*ConceptReference {
@@ -2101,7 +2389,7 @@ type ParseRequest struct {
 func (x *ParseRequest) Reset() {
 	*x = ParseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[25]
+		mi := &file_snomed_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2114,7 +2402,7 @@ func (x *ParseRequest) String() string {
 func (*ParseRequest) ProtoMessage() {}
 
 func (x *ParseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[25]
+	mi := &file_snomed_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2127,7 +2415,7 @@ func (x *ParseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParseRequest.ProtoReflect.Descriptor instead.
 func (*ParseRequest) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{25}
+	return file_snomed_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ParseRequest) GetS() string {
@@ -2152,7 +2440,7 @@ type ExtractRequest struct {
 func (x *ExtractRequest) Reset() {
 	*x = ExtractRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[26]
+		mi := &file_snomed_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2165,7 +2453,7 @@ func (x *ExtractRequest) String() string {
 func (*ExtractRequest) ProtoMessage() {}
 
 func (x *ExtractRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[26]
+	mi := &file_snomed_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2178,7 +2466,7 @@ func (x *ExtractRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtractRequest.ProtoReflect.Descriptor instead.
 func (*ExtractRequest) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{26}
+	return file_snomed_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ExtractRequest) GetS() string {
@@ -2214,7 +2502,7 @@ type ExtractResponse struct {
 func (x *ExtractResponse) Reset() {
 	*x = ExtractResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[27]
+		mi := &file_snomed_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2227,7 +2515,7 @@ func (x *ExtractResponse) String() string {
 func (*ExtractResponse) ProtoMessage() {}
 
 func (x *ExtractResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[27]
+	mi := &file_snomed_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2240,7 +2528,7 @@ func (x *ExtractResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtractResponse.ProtoReflect.Descriptor instead.
 func (*ExtractResponse) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{27}
+	return file_snomed_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ExtractResponse) GetEntities() []*ExtractResponse_Entity {
@@ -2256,7 +2544,7 @@ type SearchRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	S                  string              `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`                                                                     // the search string, mandatory
+	S                  string              `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`                                                                     // the search string, or empty to list the concepts satisfying the filters of the request
 	IsA                []int64             `protobuf:"varint,2,rep,packed,name=is_a,json=isA,proto3" json:"is_a,omitempty"`                                              // limit search to descendents of these parents, default:root
 	DirectParents      []int64             `protobuf:"varint,3,rep,packed,name=direct_parents,json=directParents,proto3" json:"direct_parents,omitempty"`                // limit search to direct descendents of these parents, default:none
 	ConceptRefsets     []int64             `protobuf:"varint,4,rep,packed,name=concept_refsets,json=conceptRefsets,proto3" json:"concept_refsets,omitempty"`             // limit search to concepts in the specified reference sets, default: none
@@ -2265,12 +2553,19 @@ type SearchRequest struct {
 	IncludeInactive    bool                `protobuf:"varint,7,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`                 // search descriptions for inactive concepts, default false
 	Fuzzy              SearchRequest_Fuzzy `protobuf:"varint,8,opt,name=fuzzy,proto3,enum=snomed.SearchRequest_Fuzzy" json:"fuzzy,omitempty"`                            // fuzziness preference, default fallback fuzzy
 	Hints              []int64             `protobuf:"varint,9,rep,packed,name=hints,proto3" json:"hints,omitempty"`                                                     // hints to help search (e.g. context like specialty, location, etc), list of concept identifiers
+	Constraint         string              `protobuf:"bytes,10,opt,name=constraint,proto3" json:"constraint,omitempty"`                                                  // limit search to concepts satisfying this expression constraint (ECL), default: none
+	BoostRefsets       []int64             `protobuf:"varint,11,rep,packed,name=boost_refsets,json=boostRefsets,proto3" json:"boost_refsets,omitempty"`                  // rank concepts in these reference sets more highly, without limiting results to their members
+	Offset             int32               `protobuf:"varint,12,opt,name=offset,proto3" json:"offset,omitempty"`                                                         // number of results to skip, for paging through results
+	Order              SearchRequest_Order `protobuf:"varint,13,opt,name=order,proto3,enum=snomed.SearchRequest_Order" json:"order,omitempty"`                           // order of concepts listed without a search string, default: alphabetical
+	SemanticTags       []string            `protobuf:"bytes,14,rep,name=semantic_tags,json=semanticTags,proto3" json:"semantic_tags,omitempty"`                          // limit search to concepts with these semantic tags, such as "disorder", default: none
+	Facets             bool                `protobuf:"varint,15,opt,name=facets,proto3" json:"facets,omitempty"`                                                         // whether to count the concepts in the results by top-level hierarchy, semantic tag and reference set
+	Highlight          bool                `protobuf:"varint,16,opt,name=highlight,proto3" json:"highlight,omitempty"`                                                   // whether to return the parts of the matched and preferred terms of each result matching the search string
 }
 
 func (x *SearchRequest) Reset() {
 	*x = SearchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[28]
+		mi := &file_snomed_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2283,7 +2578,7 @@ func (x *SearchRequest) String() string {
 func (*SearchRequest) ProtoMessage() {}
 
 func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[28]
+	mi := &file_snomed_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2296,7 +2591,7 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{28}
+	return file_snomed_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SearchRequest) GetS() string {
@@ -2362,6 +2657,55 @@ func (x *SearchRequest) GetHints() []int64 {
 	return nil
 }
 
+func (x *SearchRequest) GetConstraint() string {
+	if x != nil {
+		return x.Constraint
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetBoostRefsets() []int64 {
+	if x != nil {
+		return x.BoostRefsets
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetOrder() SearchRequest_Order {
+	if x != nil {
+		return x.Order
+	}
+	return SearchRequest_ALPHABETICAL
+}
+
+func (x *SearchRequest) GetSemanticTags() []string {
+	if x != nil {
+		return x.SemanticTags
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetFacets() bool {
+	if x != nil {
+		return x.Facets
+	}
+	return false
+}
+
+func (x *SearchRequest) GetHighlight() bool {
+	if x != nil {
+		return x.Highlight
+	}
+	return false
+}
+
 // SearchResponse provides an optimised search response, sufficient for display purposes.
 type SearchResponse struct {
 	state         protoimpl.MessageState
@@ -2369,12 +2713,23 @@ type SearchResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Items []*SearchResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // total number of concepts, when listed without a search string
+	// counts of concepts, if requested, by top-level hierarchy, semantic tag and reference set, most frequent first.
+	// Hierarchies and reference sets can then be used to limit a search using is_a and concept_refsets respectively.
+	Hierarchies  []*SearchResponse_Facet `protobuf:"bytes,3,rep,name=hierarchies,proto3" json:"hierarchies,omitempty"`
+	SemanticTags []*SearchResponse_Facet `protobuf:"bytes,4,rep,name=semantic_tags,json=semanticTags,proto3" json:"semantic_tags,omitempty"`
+	Refsets      []*SearchResponse_Facet `protobuf:"bytes,5,rep,name=refsets,proto3" json:"refsets,omitempty"`
+	// if there are few or no results, or results were found only by a fallback to fuzzy matching, spelling suggestions
+	// for the tokens of the search string not found in any term, best first, and the search string with each of those
+	// tokens replaced by its best suggestion, which can be offered as "did you mean...?"
+	Suggestions []*SearchResponse_Suggestion `protobuf:"bytes,6,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	CorrectedS  string                       `protobuf:"bytes,7,opt,name=corrected_s,json=correctedS,proto3" json:"corrected_s,omitempty"`
 }
 
 func (x *SearchResponse) Reset() {
 	*x = SearchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[29]
+		mi := &file_snomed_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2387,7 +2742,7 @@ func (x *SearchResponse) String() string {
 func (*SearchResponse) ProtoMessage() {}
 
 func (x *SearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[29]
+	mi := &file_snomed_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2400,7 +2755,7 @@ func (x *SearchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
 func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{29}
+	return file_snomed_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SearchResponse) GetItems() []*SearchResponse_Item {
@@ -2410,6 +2765,48 @@ func (x *SearchResponse) GetItems() []*SearchResponse_Item {
 	return nil
 }
 
+func (x *SearchResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchResponse) GetHierarchies() []*SearchResponse_Facet {
+	if x != nil {
+		return x.Hierarchies
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetSemanticTags() []*SearchResponse_Facet {
+	if x != nil {
+		return x.SemanticTags
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetRefsets() []*SearchResponse_Facet {
+	if x != nil {
+		return x.Refsets
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetSuggestions() []*SearchResponse_Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *SearchResponse) GetCorrectedS() string {
+	if x != nil {
+		return x.CorrectedS
+	}
+	return ""
+}
+
 // SearchFeedback provides feedback on a search.
 type SearchFeedback struct {
 	state         protoimpl.MessageState
@@ -2424,7 +2821,7 @@ type SearchFeedback struct {
 func (x *SearchFeedback) Reset() {
 	*x = SearchFeedback{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[30]
+		mi := &file_snomed_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2437,7 +2834,7 @@ func (x *SearchFeedback) String() string {
 func (*SearchFeedback) ProtoMessage() {}
 
 func (x *SearchFeedback) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[30]
+	mi := &file_snomed_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2450,7 +2847,7 @@ func (x *SearchFeedback) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchFeedback.ProtoReflect.Descriptor instead.
 func (*SearchFeedback) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{30}
+	return file_snomed_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SearchFeedback) GetRequest() *SearchRequest {
@@ -2490,7 +2887,7 @@ type SynonymRequest struct {
 func (x *SynonymRequest) Reset() {
 	*x = SynonymRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[31]
+		mi := &file_snomed_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2503,7 +2900,7 @@ func (x *SynonymRequest) String() string {
 func (*SynonymRequest) ProtoMessage() {}
 
 func (x *SynonymRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[31]
+	mi := &file_snomed_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2516,7 +2913,7 @@ func (x *SynonymRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SynonymRequest.ProtoReflect.Descriptor instead.
 func (*SynonymRequest) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{31}
+	return file_snomed_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *SynonymRequest) GetS() string {
@@ -2572,7 +2969,7 @@ type SynonymResponseItem struct {
 func (x *SynonymResponseItem) Reset() {
 	*x = SynonymResponseItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[32]
+		mi := &file_snomed_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2585,7 +2982,7 @@ func (x *SynonymResponseItem) String() string {
 func (*SynonymResponseItem) ProtoMessage() {}
 
 func (x *SynonymResponseItem) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[32]
+	mi := &file_snomed_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2598,7 +2995,7 @@ func (x *SynonymResponseItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SynonymResponseItem.ProtoReflect.Descriptor instead.
 func (*SynonymResponseItem) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{32}
+	return file_snomed_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *SynonymResponseItem) GetS() string {
@@ -2623,7 +3020,7 @@ type Expression_Clause struct {
 func (x *Expression_Clause) Reset() {
 	*x = Expression_Clause{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[33]
+		mi := &file_snomed_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2636,7 +3033,7 @@ func (x *Expression_Clause) String() string {
 func (*Expression_Clause) ProtoMessage() {}
 
 func (x *Expression_Clause) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[33]
+	mi := &file_snomed_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2649,7 +3046,7 @@ func (x *Expression_Clause) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Expression_Clause.ProtoReflect.Descriptor instead.
 func (*Expression_Clause) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{15, 0}
+	return file_snomed_proto_rawDescGZIP(), []int{18, 0}
 }
 
 func (x *Expression_Clause) GetFocusConcepts() []*ConceptReference {
@@ -2684,7 +3081,7 @@ type Expression_RefinementGroup struct {
 func (x *Expression_RefinementGroup) Reset() {
 	*x = Expression_RefinementGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[34]
+		mi := &file_snomed_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2697,7 +3094,7 @@ func (x *Expression_RefinementGroup) String() string {
 func (*Expression_RefinementGroup) ProtoMessage() {}
 
 func (x *Expression_RefinementGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[34]
+	mi := &file_snomed_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2710,7 +3107,7 @@ func (x *Expression_RefinementGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Expression_RefinementGroup.ProtoReflect.Descriptor instead.
 func (*Expression_RefinementGroup) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{15, 1}
+	return file_snomed_proto_rawDescGZIP(), []int{18, 1}
 }
 
 func (x *Expression_RefinementGroup) GetRefinements() []*Expression_Refinement {
@@ -2740,7 +3137,7 @@ type Expression_Refinement struct {
 func (x *Expression_Refinement) Reset() {
 	*x = Expression_Refinement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[35]
+		mi := &file_snomed_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2753,7 +3150,7 @@ func (x *Expression_Refinement) String() string {
 func (*Expression_Refinement) ProtoMessage() {}
 
 func (x *Expression_Refinement) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[35]
+	mi := &file_snomed_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2766,7 +3163,7 @@ func (x *Expression_Refinement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Expression_Refinement.ProtoReflect.Descriptor instead.
 func (*Expression_Refinement) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{15, 2}
+	return file_snomed_proto_rawDescGZIP(), []int{18, 2}
 }
 
 func (x *Expression_Refinement) GetRefinementConcept() *ConceptReference {
@@ -2865,7 +3262,7 @@ type RefinementResponse_Refinement struct {
 func (x *RefinementResponse_Refinement) Reset() {
 	*x = RefinementResponse_Refinement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[36]
+		mi := &file_snomed_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2878,7 +3275,7 @@ func (x *RefinementResponse_Refinement) String() string {
 func (*RefinementResponse_Refinement) ProtoMessage() {}
 
 func (x *RefinementResponse_Refinement) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[36]
+	mi := &file_snomed_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2891,7 +3288,7 @@ func (x *RefinementResponse_Refinement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefinementResponse_Refinement.ProtoReflect.Descriptor instead.
 func (*RefinementResponse_Refinement) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{19, 0}
+	return file_snomed_proto_rawDescGZIP(), []int{22, 0}
 }
 
 func (x *RefinementResponse_Refinement) GetAttribute() *ConceptReference {
@@ -2931,7 +3328,7 @@ type TranslateFromResponse_Item struct {
 func (x *TranslateFromResponse_Item) Reset() {
 	*x = TranslateFromResponse_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[37]
+		mi := &file_snomed_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2944,7 +3341,7 @@ func (x *TranslateFromResponse_Item) String() string {
 func (*TranslateFromResponse_Item) ProtoMessage() {}
 
 func (x *TranslateFromResponse_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[37]
+	mi := &file_snomed_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2957,7 +3354,7 @@ func (x *TranslateFromResponse_Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TranslateFromResponse_Item.ProtoReflect.Descriptor instead.
 func (*TranslateFromResponse_Item) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{21, 0}
+	return file_snomed_proto_rawDescGZIP(), []int{24, 0}
 }
 
 func (x *TranslateFromResponse_Item) GetReferenceSetItem() *ReferenceSetItem {
@@ -3019,7 +3416,7 @@ type ExtractResponse_Entity struct {
 func (x *ExtractResponse_Entity) Reset() {
 	*x = ExtractResponse_Entity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[38]
+		mi := &file_snomed_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3032,7 +3429,7 @@ func (x *ExtractResponse_Entity) String() string {
 func (*ExtractResponse_Entity) ProtoMessage() {}
 
 func (x *ExtractResponse_Entity) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[38]
+	mi := &file_snomed_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3045,7 +3442,7 @@ func (x *ExtractResponse_Entity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtractResponse_Entity.ProtoReflect.Descriptor instead.
 func (*ExtractResponse_Entity) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{27, 0}
+	return file_snomed_proto_rawDescGZIP(), []int{30, 0}
 }
 
 func (x *ExtractResponse_Entity) GetText() string {
@@ -3097,6 +3494,69 @@ func (x *ExtractResponse_Entity) GetGenericMatch() int64 {
 	return 0
 }
 
+type SearchResponse_Facet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`       // the top-level concept or reference set
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`  // the preferred term of the concept or reference set, or the semantic tag
+	Count int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"` // the number of concepts
+}
+
+func (x *SearchResponse_Facet) Reset() {
+	*x = SearchResponse_Facet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_snomed_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse_Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse_Facet) ProtoMessage() {}
+
+func (x *SearchResponse_Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_snomed_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse_Facet.ProtoReflect.Descriptor instead.
+func (*SearchResponse_Facet) Descriptor() ([]byte, []int) {
+	return file_snomed_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *SearchResponse_Facet) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SearchResponse_Facet) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SearchResponse_Facet) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type SearchResponse_Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3106,12 +3566,19 @@ type SearchResponse_Item struct {
 	Term          string `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`                                         // matched term
 	ConceptId     int64  `protobuf:"varint,3,opt,name=concept_id,json=conceptId,proto3" json:"concept_id,omitempty"`             // concept identifier
 	PreferredTerm string `protobuf:"bytes,4,opt,name=preferred_term,json=preferredTerm,proto3" json:"preferred_term,omitempty"`  // cached preferred term for this concept
+	// if requested, the parts of the matched and preferred terms matching the search string, including prefix and fuzzy matches,
+	// as offsets and as HTML in which those parts are marked using <mark> elements
+	TermMatches              []*SearchResponse_Match `protobuf:"bytes,5,rep,name=term_matches,json=termMatches,proto3" json:"term_matches,omitempty"`
+	PreferredTermMatches     []*SearchResponse_Match `protobuf:"bytes,6,rep,name=preferred_term_matches,json=preferredTermMatches,proto3" json:"preferred_term_matches,omitempty"`
+	HighlightedTerm          string                  `protobuf:"bytes,7,opt,name=highlighted_term,json=highlightedTerm,proto3" json:"highlighted_term,omitempty"`
+	HighlightedPreferredTerm string                  `protobuf:"bytes,8,opt,name=highlighted_preferred_term,json=highlightedPreferredTerm,proto3" json:"highlighted_preferred_term,omitempty"`
+	Local                    bool                    `protobuf:"varint,9,opt,name=local,proto3" json:"local,omitempty"` // whether the term matched is from a local dictionary rather than a description, and so has no identifier
 }
 
 func (x *SearchResponse_Item) Reset() {
 	*x = SearchResponse_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_snomed_proto_msgTypes[39]
+		mi := &file_snomed_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3124,7 +3591,7 @@ func (x *SearchResponse_Item) String() string {
 func (*SearchResponse_Item) ProtoMessage() {}
 
 func (x *SearchResponse_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_snomed_proto_msgTypes[39]
+	mi := &file_snomed_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3137,7 +3604,7 @@ func (x *SearchResponse_Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResponse_Item.ProtoReflect.Descriptor instead.
 func (*SearchResponse_Item) Descriptor() ([]byte, []int) {
-	return file_snomed_proto_rawDescGZIP(), []int{29, 0}
+	return file_snomed_proto_rawDescGZIP(), []int{32, 1}
 }
 
 func (x *SearchResponse_Item) GetDescriptionId() int64 {
@@ -3168,6 +3635,169 @@ func (x *SearchResponse_Item) GetPreferredTerm() string {
 	return ""
 }
 
+func (x *SearchResponse_Item) GetTermMatches() []*SearchResponse_Match {
+	if x != nil {
+		return x.TermMatches
+	}
+	return nil
+}
+
+func (x *SearchResponse_Item) GetPreferredTermMatches() []*SearchResponse_Match {
+	if x != nil {
+		return x.PreferredTermMatches
+	}
+	return nil
+}
+
+func (x *SearchResponse_Item) GetHighlightedTerm() string {
+	if x != nil {
+		return x.HighlightedTerm
+	}
+	return ""
+}
+
+func (x *SearchResponse_Item) GetHighlightedPreferredTerm() string {
+	if x != nil {
+		return x.HighlightedPreferredTerm
+	}
+	return ""
+}
+
+func (x *SearchResponse_Item) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+// Suggestion is a correction for a token of the search string that is not found in any term
+type SearchResponse_Suggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token      string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`           // the token of the search string, as given
+	Suggestion string `protobuf:"bytes,2,opt,name=suggestion,proto3" json:"suggestion,omitempty"` // a word found in terms, in lowercase without diacritics
+	Edits      int32  `protobuf:"varint,3,opt,name=edits,proto3" json:"edits,omitempty"`          // the number of edits from the token to the suggestion
+	Frequency  int64  `protobuf:"varint,4,opt,name=frequency,proto3" json:"frequency,omitempty"`  // the number of terms containing the suggestion
+}
+
+func (x *SearchResponse_Suggestion) Reset() {
+	*x = SearchResponse_Suggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_snomed_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse_Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse_Suggestion) ProtoMessage() {}
+
+func (x *SearchResponse_Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_snomed_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse_Suggestion.ProtoReflect.Descriptor instead.
+func (*SearchResponse_Suggestion) Descriptor() ([]byte, []int) {
+	return file_snomed_proto_rawDescGZIP(), []int{32, 2}
+}
+
+func (x *SearchResponse_Suggestion) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SearchResponse_Suggestion) GetSuggestion() string {
+	if x != nil {
+		return x.Suggestion
+	}
+	return ""
+}
+
+func (x *SearchResponse_Suggestion) GetEdits() int32 {
+	if x != nil {
+		return x.Edits
+	}
+	return 0
+}
+
+func (x *SearchResponse_Suggestion) GetFrequency() int64 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+// Match is a part of a term matching a token of the search string, as offsets in characters (Unicode code points)
+type SearchResponse_Match struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // the offset of the first character matched
+	End   int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`     // the offset after the last character matched
+}
+
+func (x *SearchResponse_Match) Reset() {
+	*x = SearchResponse_Match{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_snomed_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResponse_Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse_Match) ProtoMessage() {}
+
+func (x *SearchResponse_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_snomed_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse_Match.ProtoReflect.Descriptor instead.
+func (*SearchResponse_Match) Descriptor() ([]byte, []int) {
+	return file_snomed_proto_rawDescGZIP(), []int{32, 3}
+}
+
+func (x *SearchResponse_Match) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SearchResponse_Match) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
 var File_snomed_proto protoreflect.FileDescriptor
 
 var file_snomed_proto_rawDesc = []byte{
@@ -3230,7 +3860,7 @@ var file_snomed_proto_rawDesc = []byte{
 	0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1f,
 	0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0xd5, 0x05, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0xe3, 0x06, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74,
 	0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x41, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
 	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
@@ -3274,363 +3904,468 @@ var file_snomed_proto_rawDesc = []byte{
 	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1f, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74,
-	0x48, 0x00, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xad, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x66, 0x53,
-	0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x27,
-	0x0a, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x22, 0x41, 0x0a,
-	0x14, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x64,
-	0x22, 0x36, 0x0a, 0x15, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x70,
-	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
-	0x61, 0x70, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x93, 0x02, 0x0a, 0x16, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x78, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x53, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x70, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x50, 0x72, 0x69, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6d, 0x61, 0x70, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x70, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6d, 0x61, 0x70, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6d, 0x61, 0x70, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
-	0x0a, 0x09, 0x6d, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
-	0x61, 0x70, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x37,
-	0x0a, 0x1a, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x17, 0x41, 0x73, 0x73, 0x6f, 0x63,
-	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53,
-	0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x22, 0xf6, 0x02, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43,
-	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
-	0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70,
-	0x74, 0x12, 0x3a, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
-	0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
-	0x64, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0d,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x48, 0x0a,
-	0x15, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
-	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x14, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x5f, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52,
-	0x0c, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a,
-	0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e,
-	0x63, 0x65, 0x70, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x73, 0x65,
-	0x74, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
-	0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb9, 0x02, 0x0a, 0x13,
-	0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18, 0x01,
+	0x48, 0x00, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x53, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x6e, 0x6f,
+	0x6d, 0x65, 0x64, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x48, 0x00, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53,
+	0x65, 0x74, 0x48, 0x00, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x42, 0x06, 0x0a,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xad, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x66, 0x53, 0x65, 0x74,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x2a, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x22, 0x41, 0x0a, 0x14, 0x4c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0x36,
+	0x0a, 0x15, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x70, 0x5f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x70,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x93, 0x02, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x78, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x61, 0x70, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x61, 0x70, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6d, 0x61, 0x70, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x61, 0x70, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x61, 0x70, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6d, 0x61, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x70,
+	0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x6d, 0x61, 0x70, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x37, 0x0a, 0x1a,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x17, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74,
+	0x12, 0x2e, 0x0a, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0xbe, 0x01, 0x0a, 0x1c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65,
+	0x74, 0x12, 0x4e, 0x0a, 0x15, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x4e, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x48, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x11,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0d, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xf6, 0x02, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63,
+	0x65, 0x70, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f,
+	0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x3a,
+	0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x48, 0x0a, 0x15, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
+	0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6c,
+	0x6c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70,
+	0x74, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x0e, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x12,
+	0x37, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb9, 0x02, 0x0a, 0x13, 0x43, 0x6f, 0x6e,
+	0x63, 0x65, 0x70, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65,
+	0x70, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x48, 0x0a, 0x15, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f,
+	0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x14, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x14, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x5f, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x53,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x08,
+	0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x12, 0x35, 0x0a,
+	0x0b, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf3, 0x02, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f,
 	0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x48,
 	0x0a, 0x15, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
 	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
 	0x6f, 0x6e, 0x52, 0x14, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x14, 0x66, 0x75, 0x6c, 0x6c,
-	0x79, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x66, 0x75, 0x6c,
-	0x6c, 0x79, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2f, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73,
-	0x12, 0x35, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf3, 0x02, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x64, 0x65, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x35, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
-	0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70,
-	0x74, 0x12, 0x48, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x61,
-	0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x27, 0x0a,
-	0x0f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52,
-	0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x03, 0x52, 0x12, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x45, 0x0a,
-	0x10, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x65, 0x72, 0x6d, 0x22, 0xc5, 0x06, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x11, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
-	0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x10, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45,
-	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65,
-	0x52, 0x06, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x1a, 0xdb, 0x01, 0x0a, 0x06, 0x43, 0x6c, 0x61,
-	0x75, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x6e,
-	0x63, 0x65, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e,
-	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0d, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x63,
-	0x65, 0x70, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
-	0x65, 0x64, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
-	0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x10, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x52, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x65, 0x66,
-	0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x72,
-	0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0xc8, 0x02, 0x0a, 0x0a, 0x52,
-	0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x12, 0x72, 0x65, 0x66,
-	0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43,
-	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x11, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x63, 0x65,
-	0x70, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
-	0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
-	0x65, 0x64, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6c,
-	0x61, 0x75, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69,
-	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
-	0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x35, 0x0a, 0x10, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x51, 0x55,
-	0x49, 0x56, 0x41, 0x4c, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
-	0x53, 0x55, 0x42, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x46, 0x10, 0x01, 0x22, 0x5a, 0x0a, 0x12,
-	0x53, 0x75, 0x62, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x6f,
-	0x64, 0x65, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65,
-	0x41, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x22, 0x9c, 0x01, 0x0a, 0x13, 0x53, 0x75, 0x62,
-	0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x22, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x75, 0x6d,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x49, 0x0a, 0x06,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x51, 0x55, 0x49, 0x56, 0x41,
-	0x4c, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x55, 0x42, 0x53, 0x55, 0x4d,
-	0x45, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x55, 0x42, 0x53, 0x55, 0x4d, 0x45, 0x44,
-	0x5f, 0x42, 0x59, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x42,
-	0x53, 0x55, 0x4d, 0x45, 0x44, 0x10, 0x03, 0x22, 0x55, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x69, 0x6e,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63,
-	0x68, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xbc,
-	0x02, 0x0a, 0x12, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
-	0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74,
-	0x12, 0x47, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52,
-	0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x72, 0x65,
-	0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0xb1, 0x01, 0x0a, 0x0a, 0x52, 0x65,
-	0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e,
-	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x12, 0x37, 0x0a, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f,
-	0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09,
-	0x72, 0x6f, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x68, 0x6f,
-	0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f,
-	0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22, 0x6c, 0x0a,
-	0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74,
-	0x49, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73,
-	0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xea, 0x02, 0x0a, 0x15,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6e,
-	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x72,
-	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x88, 0x02,
-	0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x46, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x10, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x29,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x6d,
-	0x65, 0x5f, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x65,
-	0x41, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x79, 0x5f, 0x65,
-	0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x03, 0x52, 0x14, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x79, 0x45, 0x71, 0x75, 0x69,
-	0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69,
-	0x6c, 0x61, 0x72, 0x5f, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69,
-	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x54, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x42, 0x79, 0x22, 0x4d, 0x0a, 0x0f, 0x43, 0x72, 0x6f, 0x73,
-	0x73, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
-	0x66, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
-	0x65, 0x66, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x63,
-	0x65, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12,
-	0x34, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1a, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x07, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2e, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x0c, 0x0a, 0x08, 0x46, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x00, 0x12, 0x0a,
-	0x0a, 0x06, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45,
-	0x56, 0x45, 0x52, 0x10, 0x02, 0x22, 0x4b, 0x0a, 0x0b, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f,
-	0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x1c, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73,
-	0x22, 0x34, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52,
-	0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xb8, 0x02, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
-	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xe8, 0x01, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x65,
-	0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x5f,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x73,
+	0x65, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x12, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
+	0x73, 0x65, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x45, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72,
+	0x6d, 0x22, 0xc5, 0x06, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x50, 0x0a, 0x11, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x73, 0x6e,
+	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x10, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x52, 0x06, 0x63,
+	0x6c, 0x61, 0x75, 0x73, 0x65, 0x1a, 0xdb, 0x01, 0x0a, 0x06, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x0e, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x0d, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74,
+	0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
+	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x10, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x1a, 0x52, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6e,
+	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x69,
+	0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0xc8, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x66, 0x69,
+	0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63,
+	0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x72, 0x65,
+	0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12,
+	0x3f, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
+	0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x3e, 0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e,
+	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6c, 0x61, 0x75, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x35, 0x0a, 0x10, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x51, 0x55, 0x49, 0x56, 0x41,
+	0x4c, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x42,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x46, 0x10, 0x01, 0x22, 0x5a, 0x0a, 0x12, 0x53, 0x75, 0x62,
+	0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x6f, 0x64, 0x65, 0x5f,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x12, 0x15,
+	0x0a, 0x06, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x64, 0x65, 0x42, 0x22, 0x9c, 0x01, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x75, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x49, 0x0a, 0x06, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x51, 0x55, 0x49, 0x56, 0x41, 0x4c, 0x45, 0x4e,
+	0x54, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x55, 0x42, 0x53, 0x55, 0x4d, 0x45, 0x53, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x55, 0x42, 0x53, 0x55, 0x4d, 0x45, 0x44, 0x5f, 0x42, 0x59,
+	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x55, 0x4d,
+	0x45, 0x44, 0x10, 0x03, 0x22, 0x55, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x63, 0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xbc, 0x02, 0x0a, 0x12,
+	0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e,
+	0x63, 0x65, 0x70, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x47, 0x0a,
+	0x0b, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x69,
+	0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0xb1, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x37, 0x0a,
+	0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65,
+	0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x6f, 0x6f,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
 	0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65,
-	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x23, 0x0a, 0x0d,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x22, 0x85, 0x03, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01,
-	0x73, 0x12, 0x11, 0x0a, 0x04, 0x69, 0x73, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52,
-	0x03, 0x69, 0x73, 0x41, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63,
-	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66,
-	0x73, 0x65, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x03, 0x52, 0x12, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x66, 0x73, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
-	0x5f, 0x68, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78,
-	0x69, 0x6d, 0x75, 0x6d, 0x48, 0x69, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x52,
-	0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x05,
-	0x46, 0x75, 0x7a, 0x7a, 0x79, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43,
-	0x4b, 0x5f, 0x46, 0x55, 0x5a, 0x5a, 0x59, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x4c, 0x57,
-	0x41, 0x59, 0x53, 0x5f, 0x46, 0x55, 0x5a, 0x5a, 0x59, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4e,
-	0x4f, 0x5f, 0x46, 0x55, 0x5a, 0x5a, 0x59, 0x10, 0x02, 0x22, 0xcd, 0x01, 0x0a, 0x0e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6e,
+	0x65, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x14, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x0c, 0x0a, 0x01, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xea, 0x02, 0x0a, 0x15, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x88, 0x02, 0x0a, 0x04, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x46, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x29, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
+	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x65, 0x5f, 0x61,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x65, 0x41, 0x73, 0x12,
+	0x34, 0x0a, 0x16, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x79, 0x5f, 0x65, 0x71, 0x75, 0x69,
+	0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x14, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x79, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c,
+	0x65, 0x6e, 0x74, 0x54, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
+	0x5f, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x6d, 0x69, 0x6c,
+	0x61, 0x72, 0x54, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x64, 0x42, 0x79, 0x22, 0x4d, 0x0a, 0x0f, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x4d, 0x61,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63,
+	0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x63, 0x65, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x66, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x07, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x2e, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x0c, 0x0a,
+	0x08, 0x46, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41,
+	0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45, 0x56, 0x45, 0x52,
+	0x10, 0x02, 0x22, 0x4b, 0x0a, 0x0b, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
+	0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x1c, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73, 0x22, 0x34, 0x0a,
+	0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x68, 0x69,
+	0x6e, 0x74, 0x73, 0x22, 0xb8, 0x02, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
+	0x65, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x1a, 0xe8, 0x01, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x43, 0x6f,
+	0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65,
+	0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x62, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x69, 0x63, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x9a,
+	0x05, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73, 0x12, 0x11,
+	0x0a, 0x04, 0x69, 0x73, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x73,
+	0x41, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x63,
+	0x65, 0x70, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x66, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x12,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x73, 0x65,
+	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x68, 0x69,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x48, 0x69, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x31, 0x0a, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x52, 0x05, 0x66, 0x75,
+	0x7a, 0x7a, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x05, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x6f,
+	0x73, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x0c, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x65, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x6d,
+	0x61, 0x6e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x61, 0x67, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x3b, 0x0a, 0x05, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x12, 0x12, 0x0a,
+	0x0e, 0x46, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x55, 0x5a, 0x5a, 0x59, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x5f, 0x46, 0x55, 0x5a, 0x5a,
+	0x59, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x46, 0x55, 0x5a, 0x5a, 0x59, 0x10,
+	0x02, 0x22, 0x28, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x4c,
+	0x50, 0x48, 0x41, 0x42, 0x45, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x01, 0x22, 0x86, 0x08, 0x0a, 0x0e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3e, 0x0a, 0x0b, 0x68, 0x69, 0x65, 0x72, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73,
+	0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x52, 0x0b, 0x68, 0x69, 0x65, 0x72,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x73, 0x65, 0x6d, 0x61, 0x6e,
+	0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x52, 0x0c, 0x73, 0x65,
+	0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x61, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65,
+	0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6e,
 	0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a,
-	0x87, 0x01, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0d, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x65, 0x72, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74,
-	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f,
-	0x74, 0x65, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x66,
-	0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x22, 0xa0, 0x01, 0x0a, 0x0e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2f, 0x0a, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f,
-	0x6e, 0x63, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x22, 0xdd, 0x01, 0x0a,
-	0x0e, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73, 0x12, 0x11, 0x0a,
-	0x04, 0x69, 0x73, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x73, 0x41,
-	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x68, 0x69, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x48,
-	0x69, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69,
-	0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69,
-	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x31,
-	0x0a, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
-	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x79, 0x52, 0x05, 0x66, 0x75, 0x7a, 0x7a,
-	0x79, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x23, 0x0a, 0x13,
-	0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01,
-	0x73, 0x42, 0x35, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c, 0x64, 0x72, 0x69, 0x78, 0x2e,
-	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x73, 0x6e, 0x6f, 0x6d,
-	0x65, 0x64, 0x63, 0x74, 0x42, 0x06, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x50, 0x01, 0x5a, 0x08,
-	0x2e, 0x3b, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x52, 0x07, 0x72, 0x65, 0x66, 0x73, 0x65,
+	0x74, 0x73, 0x12, 0x43, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x1a, 0x43, 0x0a, 0x05, 0x46, 0x61, 0x63, 0x65,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x9b, 0x03,
+	0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72,
+	0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x65,
+	0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x3f, 0x0a, 0x0c, 0x74, 0x65, 0x72, 0x6d, 0x5f,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0b, 0x74, 0x65, 0x72,
+	0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x16, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x14, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x54, 0x65, 0x72, 0x6d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x72, 0x6d,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x65, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x3c, 0x0a, 0x1a, 0x68, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x68, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x1a, 0x76, 0x0a, 0x0a, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x1a, 0x2f, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x6e, 0x6f,
+	0x6d, 0x65, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x0e, 0x53, 0x79, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73, 0x12, 0x11, 0x0a, 0x04, 0x69, 0x73, 0x5f, 0x61,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x73, 0x41, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x68, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x48, 0x69, 0x74, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x66, 0x75, 0x7a,
+	0x7a, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65,
+	0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x46, 0x75, 0x7a, 0x7a, 0x79, 0x52, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x23, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x6f, 0x6e,
+	0x79, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0c,
+	0x0a, 0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x73, 0x42, 0x35, 0x0a, 0x1f,
+	0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x6c, 0x64, 0x72, 0x69, 0x78, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x73, 0x6e, 0x6f, 0x6d, 0x65, 0x64, 0x63, 0x74, 0x42,
+	0x06, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x50, 0x01, 0x5a, 0x08, 0x2e, 0x3b, 0x73, 0x6e, 0x6f,
+	0x6d, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3645,111 +4380,130 @@ func file_snomed_proto_rawDescGZIP() []byte {
 	return file_snomed_proto_rawDescData
 }
 
-var file_snomed_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_snomed_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_snomed_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_snomed_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_snomed_proto_goTypes = []interface{}{
 	(Expression_DefinitionStatus)(0),      // 0: snomed.Expression.DefinitionStatus
 	(SubsumptionResponse_Result)(0),       // 1: snomed.SubsumptionResponse.Result
 	(MapRequest_Parents)(0),               // 2: snomed.MapRequest.Parents
 	(SearchRequest_Fuzzy)(0),              // 3: snomed.SearchRequest.Fuzzy
-	(*Concept)(nil),                       // 4: snomed.Concept
-	(*Description)(nil),                   // 5: snomed.Description
-	(*Relationship)(nil),                  // 6: snomed.Relationship
-	(*ReferenceSetItem)(nil),              // 7: snomed.ReferenceSetItem
-	(*RefSetDescriptorReferenceSet)(nil),  // 8: snomed.RefSetDescriptorReferenceSet
-	(*SimpleReferenceSet)(nil),            // 9: snomed.SimpleReferenceSet
-	(*LanguageReferenceSet)(nil),          // 10: snomed.LanguageReferenceSet
-	(*SimpleMapReferenceSet)(nil),         // 11: snomed.SimpleMapReferenceSet
-	(*ComplexMapReferenceSet)(nil),        // 12: snomed.ComplexMapReferenceSet
-	(*AttributeValueReferenceSet)(nil),    // 13: snomed.AttributeValueReferenceSet
-	(*AssociationReferenceSet)(nil),       // 14: snomed.AssociationReferenceSet
-	(*ExtendedConcept)(nil),               // 15: snomed.ExtendedConcept
-	(*ConceptDescriptions)(nil),           // 16: snomed.ConceptDescriptions
-	(*ExtendedDescription)(nil),           // 17: snomed.ExtendedDescription
-	(*ConceptReference)(nil),              // 18: snomed.ConceptReference
-	(*Expression)(nil),                    // 19: snomed.Expression
-	(*SubsumptionRequest)(nil),            // 20: snomed.SubsumptionRequest
-	(*SubsumptionResponse)(nil),           // 21: snomed.SubsumptionResponse
-	(*RefinementRequest)(nil),             // 22: snomed.RefinementRequest
-	(*RefinementResponse)(nil),            // 23: snomed.RefinementResponse
-	(*TranslateFromRequest)(nil),          // 24: snomed.TranslateFromRequest
-	(*TranslateFromResponse)(nil),         // 25: snomed.TranslateFromResponse
-	(*CrossMapRequest)(nil),               // 26: snomed.CrossMapRequest
-	(*MapRequest)(nil),                    // 27: snomed.MapRequest
-	(*MapResponse)(nil),                   // 28: snomed.MapResponse
-	(*ParseRequest)(nil),                  // 29: snomed.ParseRequest
-	(*ExtractRequest)(nil),                // 30: snomed.ExtractRequest
-	(*ExtractResponse)(nil),               // 31: snomed.ExtractResponse
-	(*SearchRequest)(nil),                 // 32: snomed.SearchRequest
-	(*SearchResponse)(nil),                // 33: snomed.SearchResponse
-	(*SearchFeedback)(nil),                // 34: snomed.SearchFeedback
-	(*SynonymRequest)(nil),                // 35: snomed.SynonymRequest
-	(*SynonymResponseItem)(nil),           // 36: snomed.SynonymResponseItem
-	(*Expression_Clause)(nil),             // 37: snomed.Expression.Clause
-	(*Expression_RefinementGroup)(nil),    // 38: snomed.Expression.RefinementGroup
-	(*Expression_Refinement)(nil),         // 39: snomed.Expression.Refinement
-	(*RefinementResponse_Refinement)(nil), // 40: snomed.RefinementResponse.Refinement
-	(*TranslateFromResponse_Item)(nil),    // 41: snomed.TranslateFromResponse.Item
-	(*ExtractResponse_Entity)(nil),        // 42: snomed.ExtractResponse.Entity
-	(*SearchResponse_Item)(nil),           // 43: snomed.SearchResponse.Item
-	(*timestamp.Timestamp)(nil),           // 44: google.protobuf.Timestamp
+	(SearchRequest_Order)(0),              // 4: snomed.SearchRequest.Order
+	(*Concept)(nil),                       // 5: snomed.Concept
+	(*Description)(nil),                   // 6: snomed.Description
+	(*Relationship)(nil),                  // 7: snomed.Relationship
+	(*ReferenceSetItem)(nil),              // 8: snomed.ReferenceSetItem
+	(*RefSetDescriptorReferenceSet)(nil),  // 9: snomed.RefSetDescriptorReferenceSet
+	(*SimpleReferenceSet)(nil),            // 10: snomed.SimpleReferenceSet
+	(*LanguageReferenceSet)(nil),          // 11: snomed.LanguageReferenceSet
+	(*SimpleMapReferenceSet)(nil),         // 12: snomed.SimpleMapReferenceSet
+	(*ComplexMapReferenceSet)(nil),        // 13: snomed.ComplexMapReferenceSet
+	(*AttributeValueReferenceSet)(nil),    // 14: snomed.AttributeValueReferenceSet
+	(*AssociationReferenceSet)(nil),       // 15: snomed.AssociationReferenceSet
+	(*ModuleDependencyReferenceSet)(nil),  // 16: snomed.ModuleDependencyReferenceSet
+	(*GenericReferenceSet)(nil),           // 17: snomed.GenericReferenceSet
+	(*ReferenceSetField)(nil),             // 18: snomed.ReferenceSetField
+	(*ExtendedConcept)(nil),               // 19: snomed.ExtendedConcept
+	(*ConceptDescriptions)(nil),           // 20: snomed.ConceptDescriptions
+	(*ExtendedDescription)(nil),           // 21: snomed.ExtendedDescription
+	(*ConceptReference)(nil),              // 22: snomed.ConceptReference
+	(*Expression)(nil),                    // 23: snomed.Expression
+	(*SubsumptionRequest)(nil),            // 24: snomed.SubsumptionRequest
+	(*SubsumptionResponse)(nil),           // 25: snomed.SubsumptionResponse
+	(*RefinementRequest)(nil),             // 26: snomed.RefinementRequest
+	(*RefinementResponse)(nil),            // 27: snomed.RefinementResponse
+	(*TranslateFromRequest)(nil),          // 28: snomed.TranslateFromRequest
+	(*TranslateFromResponse)(nil),         // 29: snomed.TranslateFromResponse
+	(*CrossMapRequest)(nil),               // 30: snomed.CrossMapRequest
+	(*MapRequest)(nil),                    // 31: snomed.MapRequest
+	(*MapResponse)(nil),                   // 32: snomed.MapResponse
+	(*ParseRequest)(nil),                  // 33: snomed.ParseRequest
+	(*ExtractRequest)(nil),                // 34: snomed.ExtractRequest
+	(*ExtractResponse)(nil),               // 35: snomed.ExtractResponse
+	(*SearchRequest)(nil),                 // 36: snomed.SearchRequest
+	(*SearchResponse)(nil),                // 37: snomed.SearchResponse
+	(*SearchFeedback)(nil),                // 38: snomed.SearchFeedback
+	(*SynonymRequest)(nil),                // 39: snomed.SynonymRequest
+	(*SynonymResponseItem)(nil),           // 40: snomed.SynonymResponseItem
+	(*Expression_Clause)(nil),             // 41: snomed.Expression.Clause
+	(*Expression_RefinementGroup)(nil),    // 42: snomed.Expression.RefinementGroup
+	(*Expression_Refinement)(nil),         // 43: snomed.Expression.Refinement
+	(*RefinementResponse_Refinement)(nil), // 44: snomed.RefinementResponse.Refinement
+	(*TranslateFromResponse_Item)(nil),    // 45: snomed.TranslateFromResponse.Item
+	(*ExtractResponse_Entity)(nil),        // 46: snomed.ExtractResponse.Entity
+	(*SearchResponse_Facet)(nil),          // 47: snomed.SearchResponse.Facet
+	(*SearchResponse_Item)(nil),           // 48: snomed.SearchResponse.Item
+	(*SearchResponse_Suggestion)(nil),     // 49: snomed.SearchResponse.Suggestion
+	(*SearchResponse_Match)(nil),          // 50: snomed.SearchResponse.Match
+	(*timestamp.Timestamp)(nil),           // 51: google.protobuf.Timestamp
 }
 var file_snomed_proto_depIdxs = []int32{
-	44, // 0: snomed.Concept.effective_time:type_name -> google.protobuf.Timestamp
-	44, // 1: snomed.Description.effective_time:type_name -> google.protobuf.Timestamp
-	44, // 2: snomed.Relationship.effective_time:type_name -> google.protobuf.Timestamp
-	44, // 3: snomed.ReferenceSetItem.effective_time:type_name -> google.protobuf.Timestamp
-	8,  // 4: snomed.ReferenceSetItem.refset_descriptor:type_name -> snomed.RefSetDescriptorReferenceSet
-	9,  // 5: snomed.ReferenceSetItem.simple:type_name -> snomed.SimpleReferenceSet
-	10, // 6: snomed.ReferenceSetItem.language:type_name -> snomed.LanguageReferenceSet
-	11, // 7: snomed.ReferenceSetItem.simple_map:type_name -> snomed.SimpleMapReferenceSet
-	12, // 8: snomed.ReferenceSetItem.complex_map:type_name -> snomed.ComplexMapReferenceSet
-	13, // 9: snomed.ReferenceSetItem.attribute_value:type_name -> snomed.AttributeValueReferenceSet
-	14, // 10: snomed.ReferenceSetItem.association:type_name -> snomed.AssociationReferenceSet
-	4,  // 11: snomed.ExtendedConcept.concept:type_name -> snomed.Concept
-	6,  // 12: snomed.ExtendedConcept.relationships:type_name -> snomed.Relationship
-	5,  // 13: snomed.ExtendedConcept.preferred_description:type_name -> snomed.Description
-	5,  // 14: snomed.ExtendedConcept.descriptions:type_name -> snomed.Description
-	4,  // 15: snomed.ConceptDescriptions.concept:type_name -> snomed.Concept
-	5,  // 16: snomed.ConceptDescriptions.preferred_description:type_name -> snomed.Description
-	5,  // 17: snomed.ConceptDescriptions.fully_specified_name:type_name -> snomed.Description
-	5,  // 18: snomed.ConceptDescriptions.synonyms:type_name -> snomed.Description
-	5,  // 19: snomed.ConceptDescriptions.definitions:type_name -> snomed.Description
-	5,  // 20: snomed.ExtendedDescription.description:type_name -> snomed.Description
-	4,  // 21: snomed.ExtendedDescription.concept:type_name -> snomed.Concept
-	5,  // 22: snomed.ExtendedDescription.preferred_description:type_name -> snomed.Description
-	0,  // 23: snomed.Expression.definition_status:type_name -> snomed.Expression.DefinitionStatus
-	37, // 24: snomed.Expression.clause:type_name -> snomed.Expression.Clause
-	1,  // 25: snomed.SubsumptionResponse.result:type_name -> snomed.SubsumptionResponse.Result
-	4,  // 26: snomed.RefinementResponse.concept:type_name -> snomed.Concept
-	40, // 27: snomed.RefinementResponse.refinements:type_name -> snomed.RefinementResponse.Refinement
-	41, // 28: snomed.TranslateFromResponse.translations:type_name -> snomed.TranslateFromResponse.Item
-	2,  // 29: snomed.MapRequest.parents:type_name -> snomed.MapRequest.Parents
-	18, // 30: snomed.MapResponse.translations:type_name -> snomed.ConceptReference
-	42, // 31: snomed.ExtractResponse.entities:type_name -> snomed.ExtractResponse.Entity
-	3,  // 32: snomed.SearchRequest.fuzzy:type_name -> snomed.SearchRequest.Fuzzy
-	43, // 33: snomed.SearchResponse.items:type_name -> snomed.SearchResponse.Item
-	32, // 34: snomed.SearchFeedback.request:type_name -> snomed.SearchRequest
-	33, // 35: snomed.SearchFeedback.response:type_name -> snomed.SearchResponse
-	3,  // 36: snomed.SynonymRequest.fuzzy:type_name -> snomed.SearchRequest.Fuzzy
-	18, // 37: snomed.Expression.Clause.focus_concepts:type_name -> snomed.ConceptReference
-	39, // 38: snomed.Expression.Clause.refinements:type_name -> snomed.Expression.Refinement
-	38, // 39: snomed.Expression.Clause.refinement_groups:type_name -> snomed.Expression.RefinementGroup
-	39, // 40: snomed.Expression.RefinementGroup.refinements:type_name -> snomed.Expression.Refinement
-	18, // 41: snomed.Expression.Refinement.refinement_concept:type_name -> snomed.ConceptReference
-	18, // 42: snomed.Expression.Refinement.concept_value:type_name -> snomed.ConceptReference
-	37, // 43: snomed.Expression.Refinement.clause_value:type_name -> snomed.Expression.Clause
-	18, // 44: snomed.RefinementResponse.Refinement.attribute:type_name -> snomed.ConceptReference
-	18, // 45: snomed.RefinementResponse.Refinement.root_value:type_name -> snomed.ConceptReference
-	18, // 46: snomed.RefinementResponse.Refinement.choices:type_name -> snomed.ConceptReference
-	7,  // 47: snomed.TranslateFromResponse.Item.reference_set_item:type_name -> snomed.ReferenceSetItem
-	4,  // 48: snomed.TranslateFromResponse.Item.concept:type_name -> snomed.Concept
-	18, // 49: snomed.ExtractResponse.Entity.concepts:type_name -> snomed.ConceptReference
-	50, // [50:50] is the sub-list for method output_type
-	50, // [50:50] is the sub-list for method input_type
-	50, // [50:50] is the sub-list for extension type_name
-	50, // [50:50] is the sub-list for extension extendee
-	0,  // [0:50] is the sub-list for field type_name
+	51, // 0: snomed.Concept.effective_time:type_name -> google.protobuf.Timestamp
+	51, // 1: snomed.Description.effective_time:type_name -> google.protobuf.Timestamp
+	51, // 2: snomed.Relationship.effective_time:type_name -> google.protobuf.Timestamp
+	51, // 3: snomed.ReferenceSetItem.effective_time:type_name -> google.protobuf.Timestamp
+	9,  // 4: snomed.ReferenceSetItem.refset_descriptor:type_name -> snomed.RefSetDescriptorReferenceSet
+	10, // 5: snomed.ReferenceSetItem.simple:type_name -> snomed.SimpleReferenceSet
+	11, // 6: snomed.ReferenceSetItem.language:type_name -> snomed.LanguageReferenceSet
+	12, // 7: snomed.ReferenceSetItem.simple_map:type_name -> snomed.SimpleMapReferenceSet
+	13, // 8: snomed.ReferenceSetItem.complex_map:type_name -> snomed.ComplexMapReferenceSet
+	14, // 9: snomed.ReferenceSetItem.attribute_value:type_name -> snomed.AttributeValueReferenceSet
+	15, // 10: snomed.ReferenceSetItem.association:type_name -> snomed.AssociationReferenceSet
+	16, // 11: snomed.ReferenceSetItem.module_dependency:type_name -> snomed.ModuleDependencyReferenceSet
+	17, // 12: snomed.ReferenceSetItem.generic:type_name -> snomed.GenericReferenceSet
+	51, // 13: snomed.ModuleDependencyReferenceSet.source_effective_time:type_name -> google.protobuf.Timestamp
+	51, // 14: snomed.ModuleDependencyReferenceSet.target_effective_time:type_name -> google.protobuf.Timestamp
+	18, // 15: snomed.GenericReferenceSet.fields:type_name -> snomed.ReferenceSetField
+	5,  // 16: snomed.ExtendedConcept.concept:type_name -> snomed.Concept
+	7,  // 17: snomed.ExtendedConcept.relationships:type_name -> snomed.Relationship
+	6,  // 18: snomed.ExtendedConcept.preferred_description:type_name -> snomed.Description
+	6,  // 19: snomed.ExtendedConcept.descriptions:type_name -> snomed.Description
+	5,  // 20: snomed.ConceptDescriptions.concept:type_name -> snomed.Concept
+	6,  // 21: snomed.ConceptDescriptions.preferred_description:type_name -> snomed.Description
+	6,  // 22: snomed.ConceptDescriptions.fully_specified_name:type_name -> snomed.Description
+	6,  // 23: snomed.ConceptDescriptions.synonyms:type_name -> snomed.Description
+	6,  // 24: snomed.ConceptDescriptions.definitions:type_name -> snomed.Description
+	6,  // 25: snomed.ExtendedDescription.description:type_name -> snomed.Description
+	5,  // 26: snomed.ExtendedDescription.concept:type_name -> snomed.Concept
+	6,  // 27: snomed.ExtendedDescription.preferred_description:type_name -> snomed.Description
+	0,  // 28: snomed.Expression.definition_status:type_name -> snomed.Expression.DefinitionStatus
+	41, // 29: snomed.Expression.clause:type_name -> snomed.Expression.Clause
+	1,  // 30: snomed.SubsumptionResponse.result:type_name -> snomed.SubsumptionResponse.Result
+	5,  // 31: snomed.RefinementResponse.concept:type_name -> snomed.Concept
+	44, // 32: snomed.RefinementResponse.refinements:type_name -> snomed.RefinementResponse.Refinement
+	45, // 33: snomed.TranslateFromResponse.translations:type_name -> snomed.TranslateFromResponse.Item
+	2,  // 34: snomed.MapRequest.parents:type_name -> snomed.MapRequest.Parents
+	22, // 35: snomed.MapResponse.translations:type_name -> snomed.ConceptReference
+	46, // 36: snomed.ExtractResponse.entities:type_name -> snomed.ExtractResponse.Entity
+	3,  // 37: snomed.SearchRequest.fuzzy:type_name -> snomed.SearchRequest.Fuzzy
+	4,  // 38: snomed.SearchRequest.order:type_name -> snomed.SearchRequest.Order
+	48, // 39: snomed.SearchResponse.items:type_name -> snomed.SearchResponse.Item
+	47, // 40: snomed.SearchResponse.hierarchies:type_name -> snomed.SearchResponse.Facet
+	47, // 41: snomed.SearchResponse.semantic_tags:type_name -> snomed.SearchResponse.Facet
+	47, // 42: snomed.SearchResponse.refsets:type_name -> snomed.SearchResponse.Facet
+	49, // 43: snomed.SearchResponse.suggestions:type_name -> snomed.SearchResponse.Suggestion
+	36, // 44: snomed.SearchFeedback.request:type_name -> snomed.SearchRequest
+	37, // 45: snomed.SearchFeedback.response:type_name -> snomed.SearchResponse
+	3,  // 46: snomed.SynonymRequest.fuzzy:type_name -> snomed.SearchRequest.Fuzzy
+	22, // 47: snomed.Expression.Clause.focus_concepts:type_name -> snomed.ConceptReference
+	43, // 48: snomed.Expression.Clause.refinements:type_name -> snomed.Expression.Refinement
+	42, // 49: snomed.Expression.Clause.refinement_groups:type_name -> snomed.Expression.RefinementGroup
+	43, // 50: snomed.Expression.RefinementGroup.refinements:type_name -> snomed.Expression.Refinement
+	22, // 51: snomed.Expression.Refinement.refinement_concept:type_name -> snomed.ConceptReference
+	22, // 52: snomed.Expression.Refinement.concept_value:type_name -> snomed.ConceptReference
+	41, // 53: snomed.Expression.Refinement.clause_value:type_name -> snomed.Expression.Clause
+	22, // 54: snomed.RefinementResponse.Refinement.attribute:type_name -> snomed.ConceptReference
+	22, // 55: snomed.RefinementResponse.Refinement.root_value:type_name -> snomed.ConceptReference
+	22, // 56: snomed.RefinementResponse.Refinement.choices:type_name -> snomed.ConceptReference
+	8,  // 57: snomed.TranslateFromResponse.Item.reference_set_item:type_name -> snomed.ReferenceSetItem
+	5,  // 58: snomed.TranslateFromResponse.Item.concept:type_name -> snomed.Concept
+	22, // 59: snomed.ExtractResponse.Entity.concepts:type_name -> snomed.ConceptReference
+	50, // 60: snomed.SearchResponse.Item.term_matches:type_name -> snomed.SearchResponse.Match
+	50, // 61: snomed.SearchResponse.Item.preferred_term_matches:type_name -> snomed.SearchResponse.Match
+	62, // [62:62] is the sub-list for method output_type
+	62, // [62:62] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_snomed_proto_init() }
@@ -3891,7 +4645,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtendedConcept); i {
+			switch v := v.(*ModuleDependencyReferenceSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3903,7 +4657,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConceptDescriptions); i {
+			switch v := v.(*GenericReferenceSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3915,7 +4669,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtendedDescription); i {
+			switch v := v.(*ReferenceSetField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3927,7 +4681,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConceptReference); i {
+			switch v := v.(*ExtendedConcept); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3939,7 +4693,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Expression); i {
+			switch v := v.(*ConceptDescriptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3951,7 +4705,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubsumptionRequest); i {
+			switch v := v.(*ExtendedDescription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3963,7 +4717,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubsumptionResponse); i {
+			switch v := v.(*ConceptReference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3975,7 +4729,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefinementRequest); i {
+			switch v := v.(*Expression); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3987,7 +4741,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefinementResponse); i {
+			switch v := v.(*SubsumptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3999,7 +4753,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranslateFromRequest); i {
+			switch v := v.(*SubsumptionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4011,7 +4765,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranslateFromResponse); i {
+			switch v := v.(*RefinementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4023,7 +4777,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CrossMapRequest); i {
+			switch v := v.(*RefinementResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4035,7 +4789,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MapRequest); i {
+			switch v := v.(*TranslateFromRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4047,7 +4801,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MapResponse); i {
+			switch v := v.(*TranslateFromResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4059,7 +4813,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParseRequest); i {
+			switch v := v.(*CrossMapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4071,7 +4825,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtractRequest); i {
+			switch v := v.(*MapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4083,7 +4837,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtractResponse); i {
+			switch v := v.(*MapResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4095,7 +4849,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest); i {
+			switch v := v.(*ParseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4107,7 +4861,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResponse); i {
+			switch v := v.(*ExtractRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4119,7 +4873,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchFeedback); i {
+			switch v := v.(*ExtractResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4131,7 +4885,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SynonymRequest); i {
+			switch v := v.(*SearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4143,7 +4897,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SynonymResponseItem); i {
+			switch v := v.(*SearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4155,7 +4909,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Expression_Clause); i {
+			switch v := v.(*SearchFeedback); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4167,7 +4921,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Expression_RefinementGroup); i {
+			switch v := v.(*SynonymRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4179,7 +4933,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Expression_Refinement); i {
+			switch v := v.(*SynonymResponseItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4191,7 +4945,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefinementResponse_Refinement); i {
+			switch v := v.(*Expression_Clause); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4203,7 +4957,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranslateFromResponse_Item); i {
+			switch v := v.(*Expression_RefinementGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4215,7 +4969,7 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtractResponse_Entity); i {
+			switch v := v.(*Expression_Refinement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4227,6 +4981,54 @@ func file_snomed_proto_init() {
 			}
 		}
 		file_snomed_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefinementResponse_Refinement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_snomed_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslateFromResponse_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_snomed_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtractResponse_Entity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_snomed_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse_Facet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_snomed_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchResponse_Item); i {
 			case 0:
 				return &v.state
@@ -4238,6 +5040,30 @@ func file_snomed_proto_init() {
 				return nil
 			}
 		}
+		file_snomed_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse_Suggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_snomed_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_snomed_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*ReferenceSetItem_RefsetDescriptor)(nil),
@@ -4247,8 +5073,15 @@ func file_snomed_proto_init() {
 		(*ReferenceSetItem_ComplexMap)(nil),
 		(*ReferenceSetItem_AttributeValue)(nil),
 		(*ReferenceSetItem_Association)(nil),
+		(*ReferenceSetItem_ModuleDependency)(nil),
+		(*ReferenceSetItem_Generic)(nil),
+	}
+	file_snomed_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*ReferenceSetField_ComponentId)(nil),
+		(*ReferenceSetField_IntegerValue)(nil),
+		(*ReferenceSetField_StringValue)(nil),
 	}
-	file_snomed_proto_msgTypes[35].OneofWrappers = []interface{}{
+	file_snomed_proto_msgTypes[38].OneofWrappers = []interface{}{
 		(*Expression_Refinement_ConceptValue)(nil),
 		(*Expression_Refinement_ClauseValue)(nil),
 		(*Expression_Refinement_StringValue)(nil),
@@ -4260,8 +5093,8 @@ func file_snomed_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_snomed_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   40,
+			NumEnums:      5,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
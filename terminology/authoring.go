@@ -0,0 +1,366 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrNoExtension is returned when authoring without a local extension having been configured
+var ErrNoExtension = errors.New("no local extension configured")
+
+// ErrInvalidComponent is returned when a component to be authored fails validation
+var ErrInvalidComponent = errors.New("invalid component")
+
+// Extension is a local extension, in which concepts, descriptions, relationships and reference set items
+// are authored within a single module, with identifiers allocated from a namespace.
+type Extension struct {
+	Namespace int64                      // seven digit namespace allocated to the organisation
+	ModuleID  int64                      // module of the components authored
+	Sequences map[snomed.Partition]int64 `json:",omitempty"` // last item identifier allocated, by partition
+}
+
+// SetExtension configures the local extension for authoring. The sequences used to allocate identifiers
+// are retained if the namespace is unchanged.
+func (svc *Svc) SetExtension(namespace int64, moduleID int64) error {
+	if _, err := snomed.NewNamespaceIdentifier(namespace, snomed.ConceptPartition, 1); err != nil {
+		return err
+	}
+	if id := snomed.Identifier(moduleID); !id.IsValid() || !id.IsConcept() {
+		return fmt.Errorf("invalid module identifier: %d", moduleID)
+	}
+	return svc.updateDescriptor(func(d *Descriptor) {
+		ext := &Extension{Namespace: namespace, ModuleID: moduleID}
+		if d.Extension != nil && d.Extension.Namespace == namespace {
+			ext.Sequences = d.Extension.Sequences
+		}
+		d.Extension = ext
+	})
+}
+
+// extensionModule returns the module of the local extension
+func (svc *Svc) extensionModule() (int64, error) {
+	svc.descriptorMu.Lock()
+	defer svc.descriptorMu.Unlock()
+	if svc.Extension == nil {
+		return 0, ErrNoExtension
+	}
+	return svc.Extension.ModuleID, nil
+}
+
+// nextIdentifier allocates the next identifier in the partition specified, persisting the sequence so that
+// an identifier is never reissued. Identifiers already in use, such as from handcrafted release files, are skipped.
+func (svc *Svc) nextIdentifier(partition snomed.Partition) (int64, error) {
+	for {
+		var id snomed.Identifier
+		var err error
+		if err2 := svc.updateDescriptor(func(d *Descriptor) {
+			if d.Extension == nil {
+				err = ErrNoExtension
+				return
+			}
+			if d.Extension.Sequences == nil {
+				d.Extension.Sequences = make(map[snomed.Partition]int64)
+			}
+			d.Extension.Sequences[partition]++
+			id, err = snomed.NewNamespaceIdentifier(d.Extension.Namespace, partition, d.Extension.Sequences[partition])
+		}); err2 != nil {
+			return 0, err2
+		}
+		if err != nil {
+			return 0, err
+		}
+		exists, err := svc.componentExists(id.Integer())
+		if err != nil {
+			return 0, err
+		}
+		if !exists {
+			return id.Integer(), nil
+		}
+	}
+}
+
+// componentExists determines whether a concept, description or relationship with the identifier specified exists
+func (svc *Svc) componentExists(componentID int64) (bool, error) {
+	var err error
+	switch id := snomed.Identifier(componentID); {
+	case id.IsConcept():
+		_, err = svc.Concept(componentID)
+	case id.IsDescription():
+		_, err = svc.Description(componentID)
+	case id.IsRelationship():
+		var r snomed.Relationship
+		err = svc.getComponent(bkRelationships, componentID, &r)
+	default:
+		return false, fmt.Errorf("%w: invalid identifier: %d", ErrInvalidComponent, componentID)
+	}
+	if err == ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// getComponent fetches the component with the identifier specified from the bucket specified
+func (svc *Svc) getComponent(b bucket, componentID int64, pb proto.Message) error {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(componentID))
+	return svc.store.View(func(batch Batch) error {
+		return batch.Get(b, key, pb)
+	})
+}
+
+// checkConcepts checks that the concepts specified exist
+func (svc *Svc) checkConcepts(conceptIDs ...int64) error {
+	for _, conceptID := range conceptIDs {
+		if _, err := svc.Concept(conceptID); err == ErrNotFound {
+			return fmt.Errorf("%w: concept %d not found", ErrInvalidComponent, conceptID)
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newUUID generates a random (version 4) UUID, as used to identify reference set items
+func newUUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// effectiveDate returns the date of the effective time specified, or today if zero, as effective times are dates
+func effectiveDate(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		t = time.Now()
+	}
+	return timestamppb.New(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+}
+
+// CreateConcept creates a new active concept in the local extension, with the effective time specified,
+// or today, if zero. The identifier is allocated from the namespace of the extension.
+func (svc *Svc) CreateConcept(ctx context.Context, c *snomed.Concept, effectiveTime time.Time) (*snomed.Concept, error) {
+	svc.authoringMu.Lock()
+	defer svc.authoringMu.Unlock()
+	moduleID, err := svc.extensionModule()
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.checkConcepts(c.DefinitionStatusId); err != nil {
+		return nil, err
+	}
+	c = proto.Clone(c).(*snomed.Concept)
+	if c.Id, err = svc.nextIdentifier(snomed.ConceptPartition); err != nil {
+		return nil, err
+	}
+	c.ModuleId, c.EffectiveTime, c.Active = moduleID, effectiveDate(effectiveTime), true
+	return c, svc.Put(ctx, []*snomed.Concept{c})
+}
+
+// CreateDescription creates a new active description of an existing concept in the local extension.
+func (svc *Svc) CreateDescription(ctx context.Context, d *snomed.Description, effectiveTime time.Time) (*snomed.Description, error) {
+	svc.authoringMu.Lock()
+	defer svc.authoringMu.Unlock()
+	moduleID, err := svc.extensionModule()
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.validateDescription(d); err != nil {
+		return nil, err
+	}
+	d = proto.Clone(d).(*snomed.Description)
+	if d.Id, err = svc.nextIdentifier(snomed.DescriptionPartition); err != nil {
+		return nil, err
+	}
+	d.ModuleId, d.EffectiveTime, d.Active = moduleID, effectiveDate(effectiveTime), true
+	return d, svc.Put(ctx, []*snomed.Description{d})
+}
+
+func (svc *Svc) validateDescription(d *snomed.Description) error {
+	if d.Term == "" {
+		return fmt.Errorf("%w: description of concept %d has no term", ErrInvalidComponent, d.ConceptId)
+	}
+	if d.LanguageCode == "" {
+		return fmt.Errorf("%w: description '%s' has no language code", ErrInvalidComponent, d.Term)
+	}
+	return svc.checkConcepts(d.ConceptId, d.TypeId, d.CaseSignificance)
+}
+
+// CreateRelationship creates a new active relationship between existing concepts in the local extension.
+func (svc *Svc) CreateRelationship(ctx context.Context, r *snomed.Relationship, effectiveTime time.Time) (*snomed.Relationship, error) {
+	svc.authoringMu.Lock()
+	defer svc.authoringMu.Unlock()
+	moduleID, err := svc.extensionModule()
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.checkConcepts(r.SourceId, r.TypeId, r.DestinationId, r.CharacteristicTypeId); err != nil {
+		return nil, err
+	}
+	r = proto.Clone(r).(*snomed.Relationship)
+	if r.Id, err = svc.nextIdentifier(snomed.RelationshipPartition); err != nil {
+		return nil, err
+	}
+	r.ModuleId, r.EffectiveTime, r.Active = moduleID, effectiveDate(effectiveTime), true
+	return r, svc.Put(ctx, []*snomed.Relationship{r})
+}
+
+// CreateReferenceSetItem creates a new active item in a reference set, referencing an existing component,
+// in the local extension. The item is identified by a new UUID.
+func (svc *Svc) CreateReferenceSetItem(ctx context.Context, item *snomed.ReferenceSetItem, effectiveTime time.Time) (*snomed.ReferenceSetItem, error) {
+	svc.authoringMu.Lock()
+	defer svc.authoringMu.Unlock()
+	moduleID, err := svc.extensionModule()
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.validateReferenceSetItem(item); err != nil {
+		return nil, err
+	}
+	item = proto.Clone(item).(*snomed.ReferenceSetItem)
+	if item.Id, err = newUUID(); err != nil {
+		return nil, err
+	}
+	item.ModuleId, item.EffectiveTime, item.Active = moduleID, effectiveDate(effectiveTime), true
+	return item, svc.Put(ctx, []*snomed.ReferenceSetItem{item})
+}
+
+func (svc *Svc) validateReferenceSetItem(item *snomed.ReferenceSetItem) error {
+	if err := svc.checkConcepts(item.RefsetId); err != nil {
+		return err
+	}
+	exists, err := svc.componentExists(item.ReferencedComponentId)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("%w: referenced component %d not found", ErrInvalidComponent, item.ReferencedComponentId)
+	}
+	return nil
+}
+
+// Update records a new version of a concept, description, relationship or reference set item previously
+// authored in the local extension, such as to change its term or to inactivate it. The effective time of the new
+// version, or today if zero, must be after that of the existing version, which is always kept in the history of the component.
+// The component specified is not modified; the new version is returned.
+func (svc *Svc) Update(ctx context.Context, component proto.Message, effectiveTime time.Time) (proto.Message, error) {
+	svc.authoringMu.Lock()
+	defer svc.authoringMu.Unlock()
+	moduleID, err := svc.extensionModule()
+	if err != nil {
+		return nil, err
+	}
+	et := effectiveDate(effectiveTime)
+	check := func(id interface{}, existingModuleID int64, existingTime *timestamppb.Timestamp) error {
+		if existingModuleID != moduleID {
+			return fmt.Errorf("%w: component %v is in module %d, not in the local extension %d", ErrInvalidComponent, id, existingModuleID, moduleID)
+		}
+		if !et.AsTime().After(existingTime.AsTime()) {
+			return fmt.Errorf("%w: component %v: effective time %s not after that of existing version %s", ErrInvalidComponent, id,
+				et.AsTime().Format("20060102"), existingTime.AsTime().Format("20060102"))
+		}
+		return nil
+	}
+	switch c := component.(type) {
+	case *snomed.Concept:
+		existing, err := svc.Concept(c.Id)
+		if err != nil {
+			return nil, err
+		}
+		if err := check(c.Id, existing.ModuleId, existing.EffectiveTime); err != nil {
+			return nil, err
+		}
+		if err := svc.checkConcepts(c.DefinitionStatusId); err != nil {
+			return nil, err
+		}
+		c = proto.Clone(c).(*snomed.Concept)
+		c.ModuleId, c.EffectiveTime = moduleID, et
+		return c, svc.putVersion(ctx, bkConceptHistory, sctKey(c.Id), existing, c, []*snomed.Concept{c})
+	case *snomed.Description:
+		existing, err := svc.Description(c.Id)
+		if err != nil {
+			return nil, err
+		}
+		if err := check(c.Id, existing.ModuleId, existing.EffectiveTime); err != nil {
+			return nil, err
+		}
+		if c.ConceptId != existing.ConceptId {
+			return nil, fmt.Errorf("%w: description %d: cannot change concept from %d to %d", ErrInvalidComponent, c.Id, existing.ConceptId, c.ConceptId)
+		}
+		if err := svc.validateDescription(c); err != nil {
+			return nil, err
+		}
+		c = proto.Clone(c).(*snomed.Description)
+		c.ModuleId, c.EffectiveTime = moduleID, et
+		return c, svc.putVersion(ctx, bkDescriptionHistory, sctKey(c.Id), existing, c, []*snomed.Description{c})
+	case *snomed.Relationship:
+		var existing snomed.Relationship
+		if err := svc.getComponent(bkRelationships, c.Id, &existing); err != nil {
+			return nil, err
+		}
+		if err := check(c.Id, existing.ModuleId, existing.EffectiveTime); err != nil {
+			return nil, err
+		}
+		if c.SourceId != existing.SourceId {
+			return nil, fmt.Errorf("%w: relationship %d: cannot change source from %d to %d", ErrInvalidComponent, c.Id, existing.SourceId, c.SourceId)
+		}
+		if err := svc.checkConcepts(c.TypeId, c.DestinationId, c.CharacteristicTypeId); err != nil {
+			return nil, err
+		}
+		c = proto.Clone(c).(*snomed.Relationship)
+		c.ModuleId, c.EffectiveTime = moduleID, et
+		return c, svc.putVersion(ctx, bkRelationshipHistory, sctKey(c.Id), &existing, c, []*snomed.Relationship{c})
+	case *snomed.ReferenceSetItem:
+		existing, err := svc.ReferenceSetItem(c.Id)
+		if err != nil {
+			return nil, err
+		}
+		if err := check(c.Id, existing.ModuleId, existing.EffectiveTime); err != nil {
+			return nil, err
+		}
+		if c.RefsetId != existing.RefsetId || c.ReferencedComponentId != existing.ReferencedComponentId {
+			return nil, fmt.Errorf("%w: reference set item %s: cannot change reference set or referenced component", ErrInvalidComponent, c.Id)
+		}
+		c = proto.Clone(c).(*snomed.ReferenceSetItem)
+		c.ModuleId, c.EffectiveTime = moduleID, et
+		return c, svc.putVersion(ctx, bkRefsetItemHistory, []byte(c.Id), existing, c, []*snomed.ReferenceSetItem{c})
+	}
+	return nil, fmt.Errorf("%w: unknown component type: %T", ErrInvalidComponent, component)
+}
+
+// putVersion persists a new version of an authored component, recording both it and the version it replaces
+// in the history of the component, even if the history of every component is not otherwise recorded.
+func (svc *Svc) putVersion(ctx context.Context, history bucket, id []byte, previous versioned, next versioned, components interface{}) error {
+	if err := svc.recordVersion(history, id, previous); err != nil {
+		return err
+	}
+	if err := svc.Put(ctx, components); err != nil {
+		return err
+	}
+	return svc.recordVersion(history, id, next)
+}
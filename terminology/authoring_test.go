@@ -0,0 +1,124 @@
+package terminology
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+)
+
+func TestAuthoring(t *testing.T) {
+	filename := "authoring-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer func() { svc.Close() }()
+	ctx := context.Background()
+	primitive, caseInsensitive, stated := int64(900000000000074008), int64(900000000000448009), int64(900000000000010007)
+	synonym, module, namespace := int64(snomed.Synonym), int64(999000011000000103), int64(1000230)
+	var concepts []*snomed.Concept
+	var descriptions []*snomed.Description
+	for i, id := range []int64{snomed.Root.Integer(), snomed.IsA, primitive, caseInsensitive, stated, synonym, module, 24700007, 447562003} {
+		concepts = append(concepts, &snomed.Concept{Id: id, Active: true, DefinitionStatusId: primitive})
+		descriptionID, _ := snomed.NewNamespaceIdentifier(1000000, snomed.DescriptionPartition, int64(i+1))
+		descriptions = append(descriptions, &snomed.Description{Id: descriptionID.Integer(), ConceptId: id, Active: true, TypeId: synonym, Term: descriptionID.String(), LanguageCode: "en"})
+	}
+	if err := svc.Put(ctx, concepts); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.Put(ctx, descriptions); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.CreateConcept(ctx, &snomed.Concept{DefinitionStatusId: primitive}, time.Time{}); err != ErrNoExtension {
+		t.Fatalf("authoring permitted without an extension: %v", err)
+	}
+	if err := svc.SetExtension(namespace, module); err != nil {
+		t.Fatal(err)
+	}
+	day1 := time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+	c, err := svc.CreateConcept(ctx, &snomed.Concept{DefinitionStatusId: primitive}, day1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := snomed.Identifier(c.Id); !id.IsValid() || !id.IsConcept() || id.Namespace() != namespace || c.ModuleId != module {
+		t.Fatalf("incorrect identifier or module for new concept: %v", c)
+	}
+	d, err := svc.CreateDescription(ctx, &snomed.Description{ConceptId: c.Id, TypeId: synonym, Term: "Relapsing multiple sclerosis", LanguageCode: "en", CaseSignificance: caseInsensitive}, day1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := snomed.Identifier(d.Id); !id.IsDescription() || id.Namespace() != namespace {
+		t.Fatalf("incorrect identifier for new description: %d", d.Id)
+	}
+	r, err := svc.CreateRelationship(ctx, &snomed.Relationship{SourceId: c.Id, TypeId: snomed.IsA, DestinationId: 24700007, CharacteristicTypeId: stated}, day1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := snomed.Identifier(r.Id); !id.IsRelationship() || id.Namespace() != namespace {
+		t.Fatalf("incorrect identifier for new relationship: %d", r.Id)
+	}
+	item, err := svc.CreateReferenceSetItem(ctx, &snomed.ReferenceSetItem{RefsetId: 447562003, ReferencedComponentId: c.Id, Body: &snomed.ReferenceSetItem_Simple{Simple: &snomed.SimpleReferenceSet{}}}, day1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.CreateRelationship(ctx, &snomed.Relationship{SourceId: c.Id, TypeId: snomed.IsA, DestinationId: 22298006, CharacteristicTypeId: stated}, day1); !errors.Is(err, ErrInvalidComponent) {
+		t.Fatalf("relationship to a non-existent concept created: %v", err)
+	}
+	if err := svc.PerformIncrementalPrecomputations(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	if parents, err := svc.Parents(c.Id); err != nil || len(parents) != 1 || parents[0] != 24700007 {
+		t.Fatalf("incorrect parents for new concept: %v (%v)", parents, err)
+	}
+	if ok, err := svc.IsInReferenceSet(c.Id, 447562003); err != nil || !ok {
+		t.Fatalf("new concept not in reference set: %v", err)
+	}
+	// a new version must be more recent than the existing version
+	d.Active = false
+	if _, err := svc.Update(ctx, d, day1); !errors.Is(err, ErrInvalidComponent) {
+		t.Fatalf("new version with the same effective time accepted: %v", err)
+	}
+	day2 := day1.AddDate(0, 1, 0)
+	updated, err := svc.Update(ctx, d, day2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the new version is returned, leaving the component specified unchanged
+	if d3 := updated.(*snomed.Description); !d3.EffectiveTime.AsTime().Equal(day2) || !d.EffectiveTime.AsTime().Equal(day1) {
+		t.Fatalf("incorrect effective times for updated description: %v, %v", d3.EffectiveTime, d.EffectiveTime)
+	}
+	if d2, err := svc.Description(d.Id); err != nil || d2.Active || !d2.EffectiveTime.AsTime().Equal(day2) {
+		t.Fatalf("description not updated: %v (%v)", d2, err)
+	}
+	// the version replaced is recorded, even though the history of every component is not
+	if history, err := svc.DescriptionHistory(d.Id); err != nil || len(history) != 2 || !history[0].Active || history[1].Active {
+		t.Fatalf("incorrect history for updated description: %v (%v)", history, err)
+	}
+	item.Active = false
+	if _, err := svc.Update(ctx, item, day2); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Update(ctx, &snomed.Concept{Id: 24700007, DefinitionStatusId: primitive}, day2); !errors.Is(err, ErrInvalidComponent) {
+		t.Fatalf("concept outside the local extension updated: %v", err)
+	}
+	// the sequence is persisted, so identifiers are not reissued after reopening
+	svc.Close()
+	if svc, err = NewService(filename, false); err != nil {
+		t.Fatal(err)
+	}
+	c2, err := svc.CreateConcept(ctx, &snomed.Concept{DefinitionStatusId: primitive}, day2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected, _ := snomed.NewNamespaceIdentifier(namespace, snomed.ConceptPartition, 2); c2.Id != expected.Integer() {
+		t.Fatalf("expected identifier %d, got %d", expected, c2.Id)
+	}
+}
@@ -2,13 +2,40 @@ package terminology
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	gounicode "unicode"
+	"unicode/utf8"
 
 	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/blevesearch/bleve/analysis/analyzer/custom"
 	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/analysis/char/asciifolding"
+	"github.com/blevesearch/bleve/analysis/lang/da"
+	"github.com/blevesearch/bleve/analysis/lang/de"
+	"github.com/blevesearch/bleve/analysis/lang/en"
+	"github.com/blevesearch/bleve/analysis/lang/es"
+	"github.com/blevesearch/bleve/analysis/lang/fr"
+	"github.com/blevesearch/bleve/analysis/lang/it"
+	"github.com/blevesearch/bleve/analysis/lang/nl"
+	"github.com/blevesearch/bleve/analysis/lang/no"
+	"github.com/blevesearch/bleve/analysis/lang/pt"
+	"github.com/blevesearch/bleve/analysis/lang/sv"
+	"github.com/blevesearch/bleve/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/analysis/token/porter"
+	"github.com/blevesearch/bleve/analysis/token/stop"
+	"github.com/blevesearch/bleve/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/analysis/tokenmap"
+	bleveindex "github.com/blevesearch/bleve/index"
 	"github.com/blevesearch/bleve/index/scorch"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
 	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/proto"
 )
 
 // bleveService encapsulates the bleve search functionality
@@ -18,8 +45,9 @@ type bleveService struct {
 
 // document is the document indexed by bleve for fast free text search and autocompletion
 type document struct {
-	ID   string // description ID
-	Term string // the term itself
+	ID    string            // description ID
+	Terms map[string]string // the term itself, keyed by the base language of the description
+	Words string            // the term, analysed without stemming, forming a dictionary of words for spelling suggestions
 
 	RecursiveParents   []string
 	DirectParents      []string
@@ -29,6 +57,40 @@ type document struct {
 	DescriptionActive  bool
 }
 
+// termsField is the field containing the terms of descriptions, with a sub-field for each language
+const termsField = "Terms"
+
+// undetermined is the language of descriptions with a missing or invalid language code
+const undetermined = "und"
+
+// wordsField is the field forming a dictionary of the words in all terms, in lowercase without diacritics
+const wordsField = "Words"
+
+// foldedAnalyzer is the analyzer used for terms in languages without specific support, removing diacritics and case
+const foldedAnalyzer = "terms"
+
+// welshStop is the name of the token map and filter of Welsh stop words
+const welshStop = "stop_cy"
+
+// welshStopWords are common Welsh words, after removal of diacritics, ignored in search
+var welshStopWords = []interface{}{"a", "ac", "ag", "am", "ar", "at", "dan", "ei", "eu", "gan", "heb", "i", "mewn", "na", "nac", "neu", "o", "wrth", "y", "yn", "yr"}
+
+// languageAnalyzers are the token filters used to analyse the terms in each language for which stop words and stemming are supported.
+// All terms are first folded to remove diacritics.
+var languageAnalyzers = map[string][]string{
+	"da": {lowercase.Name, da.StopName, da.SnowballStemmerName},
+	"de": {lowercase.Name, de.StopName, de.NormalizeName, de.LightStemmerName},
+	"en": {en.PossessiveName, lowercase.Name, en.StopName, porter.Name},
+	"es": {lowercase.Name, es.StopName, es.LightStemmerName},
+	"fr": {lowercase.Name, fr.ElisionName, fr.StopName, fr.LightStemmerName},
+	"it": {lowercase.Name, it.ElisionName, it.StopName, it.LightStemmerName},
+	"nl": {lowercase.Name, nl.StopName, nl.SnowballStemmerName},
+	"no": {lowercase.Name, no.StopName, no.SnowballStemmerName},
+	"pt": {lowercase.Name, pt.StopName, pt.LightStemmerName},
+	"sv": {lowercase.Name, sv.StopName, sv.SnowballStemmerName},
+	"cy": {lowercase.Name, welshStop},
+}
+
 // NewBleveIndex creates or opens a bleve index at the location specified.
 func newBleveIndex(path string, readOnly bool) (*bleveService, error) {
 	config := map[string]interface{}{
@@ -46,6 +108,8 @@ func newBleveIndex(path string, readOnly bool) (*bleveService, error) {
 		return nil, fmt.Errorf("cannot open index in read-only mode: index doesn't exist at %s", path)
 	}
 	indexMapping := bleve.NewIndexMapping()
+	indexMapping.StoreDynamic = false
+	indexMapping.DocValuesDynamic = false
 	documentMapping := bleve.NewDocumentMapping() // index only a single type of document
 	indexMapping.AddDocumentMapping("document", documentMapping)
 	indexMapping.DefaultType = "document"
@@ -56,11 +120,13 @@ func newBleveIndex(path string, readOnly bool) (*bleveService, error) {
 	idMapping.Store = true
 	idMapping.Analyzer = keyword.Name
 
-	// the term
-	termMapping := bleve.NewTextFieldMapping()
-	termMapping.Analyzer = "en"
-	termMapping.Store = false
-	documentMapping.AddFieldMappingsAt("Term", termMapping)
+	// the terms, analysed according to their language, or simply folded for languages without specific support
+	if err := addTermsMapping(indexMapping, documentMapping); err != nil {
+		return nil, err
+	}
+
+	// the words of the terms, for spelling suggestions
+	addWordsMapping(documentMapping)
 
 	// the keywords
 	keywordMapping := bleve.NewTextFieldMapping()
@@ -84,6 +150,86 @@ func newBleveIndex(path string, readOnly bool) (*bleveService, error) {
 	return &bleveService{index: index}, err
 }
 
+// addTermsMapping adds the mapping of the terms of a document, with a sub-field for each language, to the document mapping,
+// registering the analyzers for terms in each language with the index mapping
+func addTermsMapping(m *mapping.IndexMappingImpl, dm *mapping.DocumentMapping) error {
+	if err := addLanguageAnalyzers(m); err != nil {
+		return err
+	}
+	termsMapping := bleve.NewDocumentMapping()
+	termsMapping.DefaultAnalyzer = foldedAnalyzer
+	for lang := range languageAnalyzers {
+		termMapping := bleve.NewTextFieldMapping()
+		termMapping.Analyzer = languageAnalyzer(lang)
+		termMapping.Store = false
+		termsMapping.AddFieldMappingsAt(lang, termMapping)
+	}
+	dm.AddSubDocumentMapping(termsField, termsMapping)
+	return nil
+}
+
+// addWordsMapping adds the mapping for the words of the terms of a document, forming a dictionary of words
+// for spelling suggestions. This requires the analyzers registered by addTermsMapping.
+func addWordsMapping(dm *mapping.DocumentMapping) {
+	wordsMapping := bleve.NewTextFieldMapping()
+	wordsMapping.Analyzer = foldedAnalyzer
+	wordsMapping.Store = false
+	wordsMapping.IncludeInAll = false
+	wordsMapping.IncludeTermVectors = false
+	wordsMapping.DocValues = false
+	dm.AddFieldMappingsAt(wordsField, wordsMapping)
+}
+
+// addLanguageAnalyzers registers the analyzers for terms in each language with the index mapping
+func addLanguageAnalyzers(m *mapping.IndexMappingImpl) error {
+	if err := m.AddCustomTokenMap(welshStop, map[string]interface{}{
+		"type":   tokenmap.Name,
+		"tokens": welshStopWords,
+	}); err != nil {
+		return err
+	}
+	if err := m.AddCustomTokenFilter(welshStop, map[string]interface{}{
+		"type":           stop.Name,
+		"stop_token_map": welshStop,
+	}); err != nil {
+		return err
+	}
+	if err := m.AddCustomAnalyzer(foldedAnalyzer, map[string]interface{}{
+		"type":          custom.Name,
+		"char_filters":  []string{asciifolding.Name},
+		"tokenizer":     unicode.Name,
+		"token_filters": []string{lowercase.Name},
+	}); err != nil {
+		return err
+	}
+	for lang, filters := range languageAnalyzers {
+		if err := m.AddCustomAnalyzer(languageAnalyzer(lang), map[string]interface{}{
+			"type":          custom.Name,
+			"char_filters":  []string{asciifolding.Name},
+			"tokenizer":     unicode.Name,
+			"token_filters": filters,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// languageAnalyzer returns the name of the analyzer for terms in the language specified
+func languageAnalyzer(lang string) string {
+	return foldedAnalyzer + "_" + lang
+}
+
+// termLanguage returns the base language of a term in the language specified, as used to index that term
+func termLanguage(languageCode string) string {
+	tag, err := language.Parse(languageCode)
+	if err != nil {
+		return undetermined
+	}
+	base, _ := tag.Base()
+	return base.String()
+}
+
 func (bs *bleveService) Statistics() (uint64, error) {
 	return bs.index.DocCount()
 }
@@ -95,7 +241,8 @@ func (bs *bleveService) Index(eds []*snomed.ExtendedDescription) error {
 		if ed.GetDescription().IsFullySpecifiedName() { // always omit FSN from the index
 			continue
 		}
-		docs[i].Term = ed.GetDescription().GetTerm()
+		docs[i].Terms = map[string]string{termLanguage(ed.GetDescription().GetLanguageCode()): ed.GetDescription().GetTerm()}
+		docs[i].Words = ed.GetDescription().GetTerm()
 		docs[i].ID = strconv.FormatInt(ed.GetDescription().GetId(), 10)
 		for _, id := range ed.GetAllParentIds() {
 			docs[i].RecursiveParents = append(docs[i].RecursiveParents, strconv.FormatInt(id, 10))
@@ -119,37 +266,165 @@ func (bs *bleveService) Index(eds []*snomed.ExtendedDescription) error {
 }
 
 func (bs *bleveService) Search(sr *snomed.SearchRequest) ([]int64, error) {
+	hits, _, err := bs.SearchFiltered(sr, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]int64, len(hits))
+	for i, hit := range hits {
+		results[i] = hit.DescriptionID
+	}
+	return results, nil
+}
+
+// maxFilteredHits is the maximum number of hits examined when filtering the results of a search
+const maxFilteredHits = 20000
+
+// SearchFiltered searches, returning only those results accepted by the filter, if specified.
+// The terms in each of the languages specified are searched in turn, until there are results,
+// or the terms in all languages are searched if none of the languages specified have been indexed.
+// Results are examined in order of relevance, until either the maximum number of hits is
+// found or a limit to the number examined is reached.
+// If there are no results and fallback to fuzzy matching is requested, the search is repeated with
+// fuzzy matching, and fellBack reports that this was the case.
+func (bs *bleveService) SearchFiltered(sr *snomed.SearchRequest, tags []language.Tag, accept func(descriptionID int64) (bool, error)) (hits []SearchHit, fellBack bool, err error) {
 	if len(sr.GetIsA()) == 0 {
 		sr.IsA = []int64{138875005}
 	}
 	if sr.MaximumHits == 0 {
 		sr.MaximumHits = 100
 	}
-	if sr.S == "" {
-		// TODO: implement list of recursive children, up to a maximum (useful for drop-downs)
-		return nil, fmt.Errorf("no search string in request")
+	if sr.S == "" { // concepts are listed without a search string by the service, rather than by the index
+		return nil, false, fmt.Errorf("no search string in request")
+	}
+	languages, err := bs.termFields(tags)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, fields := range languages {
+		results, err := bs.searchFields(sr, fields, accept)
+		if err != nil || len(results) > 0 {
+			return results, false, err
+		}
+	}
+
+	// perform fallback if no hits, and if requested.
+	if sr.Fuzzy == snomed.SearchRequest_FALLBACK_FUZZY {
+		fuzzy := proto.Clone(sr).(*snomed.SearchRequest)
+		fuzzy.Fuzzy = snomed.SearchRequest_ALWAYS_FUZZY
+		hits, _, err := bs.SearchFiltered(fuzzy, tags, accept)
+		return hits, true, err
+	}
+	return make([]SearchHit, 0), false, nil
+}
+
+// searchFields searches the term fields specified
+func (bs *bleveService) searchFields(sr *snomed.SearchRequest, fields []string, accept func(descriptionID int64) (bool, error)) ([]SearchHit, error) {
+	query := bs.query(sr, fields)
+	size := int(sr.GetMaximumHits())
+	if accept != nil && size < 500 {
+		size = 500 // page through more hits at a time when filtering
+	}
+	results := make([]SearchHit, 0)
+	for from := 0; len(results) < int(sr.GetMaximumHits()) && from < maxFilteredHits; from += size {
+		req := bleve.NewSearchRequestOptions(query, size, from, false)
+		result, err := bs.index.Search(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, hit := range result.Hits {
+			id, err := strconv.ParseInt(hit.ID, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			if accept != nil {
+				ok, err := accept(id)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+			}
+			results = append(results, SearchHit{DescriptionID: id, Score: hit.Score})
+			if len(results) == int(sr.GetMaximumHits()) {
+				break
+			}
+		}
+		if accept == nil || len(result.Hits) < size {
+			break
+		}
+	}
+	return results, nil
+}
+
+// termFields returns the fields containing the terms in each of the languages specified, in order of preference,
+// omitting languages that have not been indexed. If none of the languages have been indexed, or none are specified,
+// all of the fields containing terms are returned, to be searched together.
+func (bs *bleveService) termFields(tags []language.Tag) ([][]string, error) {
+	fields, err := bs.index.Fields()
+	if err != nil {
+		return nil, err
+	}
+	indexed := make(map[string]struct{})
+	var all []string
+	for _, field := range fields {
+		if strings.HasPrefix(field, termsField+".") {
+			indexed[field] = struct{}{}
+			all = append(all, field)
+		}
+	}
+	result := make([][]string, 0, len(tags))
+	for _, tag := range tags {
+		base, _ := tag.Base()
+		field := termsField + "." + base.String()
+		if _, ok := indexed[field]; ok {
+			result = append(result, []string{field})
+			delete(indexed, field)
+		}
+	}
+	if len(result) == 0 {
+		sort.Strings(all)
+		result = append(result, all)
 	}
+	return result, nil
+}
+
+// query returns the bleve query for a search request, matching terms in any of the fields specified
+func (bs *bleveService) query(sr *snomed.SearchRequest, fields []string) query.Query {
 	s := strings.TrimSpace(sr.S)
 	query := bleve.NewConjunctionQuery()
+	m := bs.index.Mapping()
+	matched := false
 	for _, token := range strings.Split(s, " ") {
-		tokenQuery := bleve.NewMatchQuery(token)
-		tokenQuery.SetField("Term")
-		if len(token) < 3 {
-			query.AddQuery(tokenQuery)
-			continue
-		}
+		folded := foldToken(token)
 		termQuery := bleve.NewDisjunctionQuery()
-		termQuery.AddQuery(tokenQuery)
-		prefixQuery := bleve.NewPrefixQuery(token)
-		prefixQuery.SetField("Term")
-		termQuery.AddQuery(prefixQuery)
-		if sr.Fuzzy == snomed.SearchRequest_ALWAYS_FUZZY {
-			fuzzyQuery := bleve.NewFuzzyQuery(token)
-			fuzzyQuery.SetField("Term")
-			fuzzyQuery.SetFuzziness(2)
-			termQuery.AddQuery(fuzzyQuery)
-		}
-		query.AddQuery(termQuery)
+		for _, field := range fields {
+			if analyzer := m.AnalyzerNamed(m.AnalyzerNameForPath(field)); analyzer == nil || len(analyzer.Analyze([]byte(token))) > 0 { // omit stop words
+				tokenQuery := bleve.NewMatchQuery(token)
+				tokenQuery.SetField(field)
+				termQuery.AddQuery(tokenQuery)
+			}
+			if len(token) < 3 {
+				continue
+			}
+			prefixQuery := bleve.NewPrefixQuery(folded)
+			prefixQuery.SetField(field)
+			termQuery.AddQuery(prefixQuery)
+			if sr.Fuzzy == snomed.SearchRequest_ALWAYS_FUZZY {
+				fuzzyQuery := bleve.NewFuzzyQuery(folded)
+				fuzzyQuery.SetField(field)
+				fuzzyQuery.SetFuzziness(2)
+				termQuery.AddQuery(fuzzyQuery)
+			}
+		}
+		if len(termQuery.Disjuncts) > 0 {
+			query.AddQuery(termQuery)
+			matched = true
+		}
+	}
+	if !matched {
+		return bleve.NewMatchNoneQuery()
 	}
 	if len(sr.GetIsA()) > 0 {
 		qs := bleve.NewDisjunctionQuery()
@@ -192,28 +467,199 @@ func (bs *bleveService) Search(sr *snomed.SearchRequest) ([]int64, error) {
 		q.SetField("ConceptActive")
 		query.AddQuery(q)
 	}
-	req := bleve.NewSearchRequest(query)
-	req.Size = int(sr.GetMaximumHits())
-	result, err := bs.index.Search(req)
-	if err != nil {
-		return nil, err
-	}
+	return query
+}
 
-	results := make([]int64, 0)
-	for _, hit := range result.Hits {
-		id, err := strconv.ParseInt(hit.ID, 10, 64)
+// minSuggestionLength is the minimum number of characters in a token of a search string for spelling suggestions
+const minSuggestionLength = 4
+
+// Suggest returns spelling suggestions for each of the tokens of the search string that are neither a word, nor the
+// prefix of a word, in any term. Suggestions are the words within two edits of each token, or one edit for short tokens,
+// ordered by the number of edits and then by the number of terms containing each word, up to the maximum specified
+// for each token.
+func (bs *bleveService) Suggest(s string, maximum int) ([]*snomed.SearchResponse_Suggestion, error) {
+	return suggestWords(s, maximum, bs)
+}
+
+// suggestWords returns spelling suggestions from the words of the terms in all of the indexes specified,
+// for each of the tokens of the search string that is neither a word, nor the prefix of a word, in any of those indexes.
+func suggestWords(s string, maximum int, indexes ...*bleveService) ([]*snomed.SearchResponse_Suggestion, error) {
+	readers := make([]bleveindex.IndexReaderFuzzy, len(indexes))
+	for i, bs := range indexes {
+		idx, _, err := bs.index.Advanced()
 		if err != nil {
 			return nil, err
 		}
-		results = append(results, id)
+		reader, err := idx.Reader()
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		fuzzyReader, ok := reader.(bleveindex.IndexReaderFuzzy)
+		if !ok {
+			return nil, fmt.Errorf("index does not support fuzzy matching of words")
+		}
+		readers[i] = fuzzyReader
+	}
+	result := make([]*snomed.SearchResponse_Suggestion, 0)
+	seen := make(map[string]struct{})
+tokens:
+	for _, token := range strings.Fields(s) {
+		folded := foldToken(token)
+		if _, done := seen[token]; done || utf8.RuneCountInString(folded) < minSuggestionLength || strings.IndexFunc(folded, gounicode.IsLetter) == -1 {
+			continue
+		}
+		seen[token] = struct{}{}
+		for _, bs := range indexes {
+			known, err := bs.isWordPrefix(folded)
+			if err != nil {
+				return nil, err
+			}
+			if known {
+				continue tokens
+			}
+		}
+		fuzziness := 2
+		if utf8.RuneCountInString(folded) < 6 {
+			fuzziness = 1
+		}
+		words := make(map[string]*snomed.SearchResponse_Suggestion)
+		for _, reader := range readers {
+			dict, err := reader.FieldDictFuzzy(wordsField, folded, fuzziness, "")
+			if err != nil {
+				return nil, err
+			}
+			for {
+				entry, err := dict.Next()
+				if err != nil {
+					dict.Close()
+					return nil, err
+				}
+				if entry == nil {
+					break
+				}
+				edits, exceeded := search.LevenshteinDistanceMax(folded, entry.Term, fuzziness)
+				if exceeded {
+					continue
+				}
+				if suggestion, ok := words[entry.Term]; ok {
+					suggestion.Frequency += int64(entry.Count)
+					continue
+				}
+				words[entry.Term] = &snomed.SearchResponse_Suggestion{Token: token, Suggestion: entry.Term, Edits: int32(edits), Frequency: int64(entry.Count)}
+			}
+			if err := dict.Close(); err != nil {
+				return nil, err
+			}
+		}
+		suggestions := make([]*snomed.SearchResponse_Suggestion, 0, len(words))
+		for _, suggestion := range words {
+			suggestions = append(suggestions, suggestion)
+		}
+		sort.Slice(suggestions, func(i, j int) bool {
+			if suggestions[i].Edits != suggestions[j].Edits {
+				return suggestions[i].Edits < suggestions[j].Edits
+			}
+			if suggestions[i].Frequency != suggestions[j].Frequency {
+				return suggestions[i].Frequency > suggestions[j].Frequency
+			}
+			return suggestions[i].Suggestion < suggestions[j].Suggestion
+		})
+		if len(suggestions) > maximum {
+			suggestions = suggestions[:maximum]
+		}
+		result = append(result, suggestions...)
 	}
+	return result, nil
+}
 
-	// perform fallback if no hits, and if requested.
-	if (len(results) == 0) && (sr.Fuzzy == snomed.SearchRequest_FALLBACK_FUZZY) {
-		sr.Fuzzy = snomed.SearchRequest_ALWAYS_FUZZY
-		return bs.Search(sr)
+// isWordPrefix returns whether the token, in lowercase without diacritics, is a word, or the prefix of a word, in any term
+func (bs *bleveService) isWordPrefix(folded string) (bool, error) {
+	dict, err := bs.index.FieldDictPrefix(wordsField, []byte(folded))
+	if err != nil {
+		return false, err
 	}
-	return results, nil
+	defer dict.Close()
+	entry, err := dict.Next()
+	return entry != nil, err
+}
+
+// Highlight returns the parts of the term of a description that match the tokens of the search string of a request,
+// using the analysis of terms in the language of the description, and including prefix and, if used for the search,
+// fuzzy matches. Matches are returned in order, as offsets in characters within the term.
+func (bs *bleveService) Highlight(sr *snomed.SearchRequest, d *snomed.Description) ([]*snomed.SearchResponse_Match, error) {
+	m := bs.index.Mapping()
+	analyzer := m.AnalyzerNamed(m.AnalyzerNameForPath(termsField + "." + termLanguage(d.GetLanguageCode())))
+	if analyzer == nil {
+		return nil, fmt.Errorf("no analyzer for terms in language '%s'", d.GetLanguageCode())
+	}
+	var tokens []analysedToken
+	for _, token := range strings.Fields(sr.GetS()) {
+		tokens = append(tokens, analyseToken(analyzer, token))
+	}
+	term := d.GetTerm()
+	result := make([]*snomed.SearchResponse_Match, 0)
+	for _, t := range unicode.NewUnicodeTokenizer().Tokenize([]byte(term)) {
+		termToken := analyseToken(analyzer, string(t.Term))
+		for _, token := range tokens {
+			if token.matches(termToken, sr.GetFuzzy() == snomed.SearchRequest_ALWAYS_FUZZY) {
+				start := utf8.RuneCountInString(term[:t.Start])
+				result = append(result, &snomed.SearchResponse_Match{
+					Start: int32(start),
+					End:   int32(start + utf8.RuneCount(t.Term)),
+				})
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// analysedToken is a token of a search string or of a term, in the forms used by the match, prefix and fuzzy queries
+type analysedToken struct {
+	token    string
+	folded   string              // in lowercase without diacritics, for prefix and fuzzy queries
+	analysed map[string]struct{} // the terms resulting from analysis, which are empty for stop words
+}
+
+func analyseToken(a *analysis.Analyzer, token string) analysedToken {
+	result := analysedToken{token: token, folded: foldToken(token), analysed: make(map[string]struct{})}
+	for _, t := range a.Analyze([]byte(token)) {
+		result.analysed[string(t.Term)] = struct{}{}
+	}
+	return result
+}
+
+// matches determines whether a token of a term is matched by this token of a search string, in the same way as
+// the match, prefix and, optionally, fuzzy queries used for the search.
+func (at analysedToken) matches(term analysedToken, fuzzy bool) bool {
+	for t := range term.analysed {
+		if _, ok := at.analysed[t]; ok {
+			return true
+		}
+	}
+	if len(at.token) < 3 {
+		return false
+	}
+	if strings.HasPrefix(term.folded, at.folded) {
+		return true
+	}
+	if fuzzy {
+		if _, exceeded := search.LevenshteinDistanceMax(at.folded, term.folded, 2); !exceeded {
+			return true
+		}
+		for t := range term.analysed {
+			if _, exceeded := search.LevenshteinDistanceMax(at.folded, t, 2); !exceeded {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// foldToken returns a token in lowercase without diacritics, as used in prefix and fuzzy queries
+func foldToken(token string) string {
+	return string(asciifolding.New().Filter([]byte(strings.ToLower(token))))
 }
 
 func (bs *bleveService) Close() error {
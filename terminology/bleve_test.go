@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
 )
 
 const fakeIndex = "fakeIndex"
@@ -67,3 +68,120 @@ func TestStore(t *testing.T) {
 		t.Fatalf("incorrect number of results, expected 0, got: %d", len(r2))
 	}
 }
+
+func TestLanguageSearch(t *testing.T) {
+	defer os.RemoveAll(fakeIndex)
+	bleve, err := newBleveIndex(fakeIndex, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bleve.Close()
+	welsh := language.MustParse("cy")
+	terms := []struct {
+		id        int64
+		conceptID int64
+		lang      string
+		term      string
+	}{
+		{1, 22298006, "en", "Heart attack"},
+		{2, 22298006, "cy", "Trawiad ar y galon"},
+		{3, 22298006, "fr", "Crise cardiaque"},
+		{4, 24700007, "en", "Multiple sclerosis"},
+		{5, 24700007, "fr", "Sclérose en plaques"},
+		{6, 24700007, "cy", "Sglerosis ymledol"},
+		{7, 24700007, "ja", "多発性硬化症"},
+	}
+	eds := make([]*snomed.ExtendedDescription, len(terms))
+	for i, term := range terms {
+		eds[i] = &snomed.ExtendedDescription{
+			Concept:      &snomed.Concept{Id: term.conceptID, Active: true},
+			Description:  &snomed.Description{Id: term.id, ConceptId: term.conceptID, Active: true, LanguageCode: term.lang, Term: term.term},
+			AllParentIds: []int64{138875005},
+		}
+	}
+	if err := bleve.Index(eds); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		s        string
+		tags     []language.Tag
+		expected []int64
+	}{
+		{"trawiad", []language.Tag{welsh, language.BritishEnglish}, []int64{2}},
+		{"heart", []language.Tag{welsh, language.BritishEnglish}, []int64{1}}, // falls back to English
+		{"heart", []language.Tag{welsh}, []int64{}},
+		{"heart attacks", []language.Tag{language.BritishEnglish}, []int64{1}}, // stemmed
+		{"ar y galon", []language.Tag{welsh}, []int64{2}},                      // stop words ignored
+		{"sclerose", []language.Tag{language.French}, []int64{5}},              // diacritics folded
+		{"scléroses", []language.Tag{language.French}, []int64{5}},
+		{"scler", []language.Tag{language.German}, []int64{4, 5}}, // no German terms, so all languages searched
+		{"多発性硬化症", nil, []int64{7}},
+	}
+	for _, test := range tests {
+		hits, _, err := bleve.SearchFiltered(&snomed.SearchRequest{S: test.s, Fuzzy: snomed.SearchRequest_NO_FUZZY}, test.tags, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := make(map[int64]bool)
+		for _, hit := range hits {
+			found[hit.DescriptionID] = true
+		}
+		if len(hits) != len(test.expected) {
+			t.Errorf("%s %v: expected %v, got %v", test.s, test.tags, test.expected, hits)
+			continue
+		}
+		for _, id := range test.expected {
+			if !found[id] {
+				t.Errorf("%s %v: expected %v, got %v", test.s, test.tags, test.expected, hits)
+			}
+		}
+	}
+	sr := &snomed.SearchRequest{S: "sclerossis", Fuzzy: snomed.SearchRequest_FALLBACK_FUZZY}
+	hits, fellBack, err := bleve.SearchFiltered(sr, []language.Tag{language.BritishEnglish}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 1 || hits[0].DescriptionID != 4 || !fellBack {
+		t.Errorf("expected results from fallback to fuzzy matching, got %v (fell back: %t)", hits, fellBack)
+	}
+	if sr.Fuzzy != snomed.SearchRequest_FALLBACK_FUZZY {
+		t.Errorf("search request modified by fallback: %v", sr)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	defer os.RemoveAll(fakeIndex)
+	bleve, err := newBleveIndex(fakeIndex, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bleve.Close()
+	tests := []struct {
+		s           string
+		fuzzy       snomed.SearchRequest_Fuzzy
+		term        string
+		highlighted string
+	}{
+		{"mult scler", snomed.SearchRequest_NO_FUZZY, "Multiple sclerosis", "<mark>Multiple</mark> <mark>sclerosis</mark>"},
+		{"fractures of femur", snomed.SearchRequest_NO_FUZZY, "Fracture of neck of femur", "<mark>Fracture</mark> of neck of <mark>femur</mark>"},
+		{"meniere", snomed.SearchRequest_NO_FUZZY, "Ménière's disease & vertigo", "<mark>Ménière&#39;s</mark> disease &amp; vertigo"},
+		{"sclerosus", snomed.SearchRequest_ALWAYS_FUZZY, "Multiple sclerosis", "Multiple <mark>sclerosis</mark>"},
+		{"sclerosus", snomed.SearchRequest_NO_FUZZY, "Multiple sclerosis", "Multiple sclerosis"},
+	}
+	for _, test := range tests {
+		matches, err := bleve.Highlight(&snomed.SearchRequest{S: test.s, Fuzzy: test.fuzzy}, &snomed.Description{Term: test.term, LanguageCode: "en"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if highlighted := markMatches(test.term, matches); highlighted != test.highlighted {
+			t.Errorf("%s: expected '%s', got '%s' (%v)", test.s, test.highlighted, highlighted, matches)
+		}
+	}
+	matches, err := bleve.Highlight(&snomed.SearchRequest{S: "meniere"}, &snomed.Description{Term: "Ménière's disease", LanguageCode: "en"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 || matches[0].Start != 0 || matches[0].End != 9 {
+		t.Errorf("expected match of characters 0 to 9, got %v", matches)
+	}
+}
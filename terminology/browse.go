@@ -0,0 +1,300 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxBrowseConcepts is the maximum number of concepts that can be listed without a search string
+const maxBrowseConcepts = 20000
+
+// ErrTooManyConcepts is the error when the filters of a request without a search string are absent,
+// or are satisfied by too many concepts to be listed.
+var ErrTooManyConcepts = errors.New("too many concepts to list without a search string")
+
+// browsedConcept is a concept listed without a search string, with the components needed to order it
+type browsedConcept struct {
+	preferred *snomed.Description
+	frequency float64
+}
+
+// browsed is the result of listing concepts without a search string, ordered alphabetically, which is cached
+// so that successive pages of the same request do not list the concepts again
+type browsed struct {
+	concepts []browsedConcept
+	facets   *snomed.SearchResponse // the facets of all of the concepts listed, if requested
+}
+
+// browse lists the concepts satisfying the filters of a request without a search string, with their preferred synonyms,
+// ordered alphabetically or by the frequency with which they have been selected from search results.
+// If requested, all of the concepts listed are counted by top-level hierarchy, semantic tag and reference set.
+func (svc *Svc) browse(ctx context.Context, req *snomed.SearchRequest, tags []language.Tag, offset int, maximum int) (*snomed.SearchResponse, error) {
+	b, err := svc.browsed(ctx, req, tags)
+	if err != nil {
+		return nil, err
+	}
+	result := b.concepts
+	if svc.feedback != nil && req.GetOrder() == snomed.SearchRequest_FREQUENCY { // frequencies change, so are never cached
+		var selected map[int64]float64
+		if len(req.GetHints()) > 0 {
+			if selected, err = svc.feedback.Scores(&snomed.SearchRequest{Hints: req.GetHints()}); err != nil {
+				return nil, err
+			}
+		}
+		result = make([]browsedConcept, len(b.concepts))
+		for i, bc := range b.concepts {
+			frequency, err := svc.feedback.Frequency(bc.preferred.ConceptId)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = browsedConcept{preferred: bc.preferred, frequency: selected[bc.preferred.ConceptId] + frequency}
+		}
+		sort.SliceStable(result, func(i, j int) bool { // retaining alphabetical order for the same frequency
+			return result[i].frequency > result[j].frequency
+		})
+	}
+	response := &snomed.SearchResponse{Total: int32(len(result))}
+	if b.facets != nil {
+		response = proto.Clone(b.facets).(*snomed.SearchResponse)
+	}
+	if offset > len(result) {
+		offset = len(result)
+	}
+	result = result[offset:]
+	if len(result) > maximum {
+		result = result[:maximum]
+	}
+	response.Items = make([]*snomed.SearchResponse_Item, len(result))
+	for i, bc := range result {
+		response.Items[i] = &snomed.SearchResponse_Item{
+			DescriptionId: bc.preferred.Id,
+			Term:          bc.preferred.Term,
+			ConceptId:     bc.preferred.ConceptId,
+			PreferredTerm: bc.preferred.Term,
+		}
+	}
+	return response, nil
+}
+
+// browsed returns the concepts satisfying the filters of a request without a search string, ordered alphabetically,
+// from the cache if the same request has been listed before, regardless of the page or order requested
+func (svc *Svc) browsed(ctx context.Context, req *snomed.SearchRequest, tags []language.Tag) (*browsed, error) {
+	key := proto.Clone(req).(*snomed.SearchRequest)
+	key.Offset, key.MaximumHits, key.Order, key.Hints = 0, 0, snomed.SearchRequest_ALPHABETICAL, nil
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	cacheKey := browseKey{request: string(b), tags: tagsKey(tags)}
+	if cached, ok := svc.caches.browsed.get(cacheKey); ok {
+		return cached.(*browsed), nil
+	}
+	result, err := svc.listConcepts(ctx, req, tags)
+	if err != nil {
+		return nil, err
+	}
+	svc.caches.browsed.put(cacheKey, result)
+	return result, nil
+}
+
+// listConcepts lists the concepts satisfying the filters of a request without a search string, ordered alphabetically
+func (svc *Svc) listConcepts(ctx context.Context, req *snomed.SearchRequest, tags []language.Tag) (*browsed, error) {
+	concepts, err := svc.browseConcepts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := svc.conceptFilter(ctx, req, tags)
+	if err != nil {
+		return nil, err
+	}
+	var facets *facetCounter
+	if req.GetFacets() {
+		facets = svc.newFacetCounter(tags)
+	}
+	result := make([]browsedConcept, 0, len(concepts))
+	for conceptID := range concepts {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		c, err := svc.Concept(conceptID)
+		if err == ErrNotFound { // e.g. a refset member referencing a concept not in this distribution
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !c.GetActive() && !req.GetIncludeInactive() {
+			continue
+		}
+		if filter != nil {
+			ok, err := filter(conceptID)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		if facets != nil {
+			if err := facets.add(conceptID); err != nil {
+				return nil, err
+			}
+		}
+		pd, err := svc.PreferredSynonym(conceptID, tags)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, browsedConcept{preferred: pd})
+	}
+	tag := language.Und
+	if len(tags) > 0 {
+		tag = tags[0]
+	}
+	collator := collate.New(tag, collate.IgnoreCase, collate.IgnoreDiacritics)
+	sort.Slice(result, func(i, j int) bool {
+		if c := collator.CompareString(result[i].preferred.Term, result[j].preferred.Term); c != 0 {
+			return c < 0
+		}
+		return result[i].preferred.ConceptId < result[j].preferred.ConceptId
+	})
+	b := &browsed{concepts: result}
+	if facets != nil {
+		b.facets = &snomed.SearchResponse{Total: int32(len(result))}
+		if err := facets.addTo(b.facets); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// browseConcepts returns the concepts satisfying all of the filters of a request
+func (svc *Svc) browseConcepts(ctx context.Context, req *snomed.SearchRequest) (map[int64]struct{}, error) {
+	var result map[int64]struct{}
+	filter := func(concepts map[int64]struct{}) {
+		if result == nil {
+			result = make(map[int64]struct{}, len(concepts))
+			for conceptID := range concepts {
+				result[conceptID] = struct{}{}
+			}
+			return
+		}
+		for conceptID := range result {
+			if _, ok := concepts[conceptID]; !ok {
+				delete(result, conceptID)
+			}
+		}
+	}
+	if req.GetConstraint() != "" {
+		concepts, err := svc.ExpandConstraint(ctx, req.GetConstraint())
+		if err != nil {
+			return nil, err
+		}
+		filter(concepts)
+	}
+	if len(req.GetConceptRefsets()) > 0 {
+		concepts := make(map[int64]struct{})
+		for _, refsetID := range req.GetConceptRefsets() {
+			members, err := svc.ReferenceSetComponents(refsetID)
+			if err != nil {
+				return nil, err
+			}
+			for conceptID := range members {
+				concepts[conceptID] = struct{}{}
+			}
+		}
+		filter(concepts)
+	}
+	if len(req.GetDescriptionRefsets()) > 0 {
+		concepts := make(map[int64]struct{})
+		for _, refsetID := range req.GetDescriptionRefsets() {
+			members, err := svc.ReferenceSetComponents(refsetID)
+			if err != nil {
+				return nil, err
+			}
+			for descriptionID := range members {
+				d, err := svc.Description(descriptionID)
+				if err == ErrNotFound {
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+				concepts[d.ConceptId] = struct{}{}
+			}
+		}
+		filter(concepts)
+	}
+	if len(req.GetDirectParents()) > 0 {
+		concepts := make(map[int64]struct{})
+		for _, parentID := range req.GetDirectParents() {
+			children, err := svc.Children(parentID)
+			if err != nil {
+				return nil, err
+			}
+			for _, child := range children {
+				concepts[child] = struct{}{}
+			}
+		}
+		filter(concepts)
+	}
+	if len(req.GetIsA()) > 0 && result != nil { // test only the concepts already listed, rather than every descendant
+		for conceptID := range result {
+			ok, err := svc.isDescendant(conceptID, req.GetIsA())
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				delete(result, conceptID)
+			}
+		}
+	} else if len(req.GetIsA()) > 0 {
+		concepts := make(map[int64]struct{})
+		for _, parentID := range req.GetIsA() {
+			if err := svc.descendants(ctx, parentID, concepts, maxBrowseConcepts); err != nil {
+				return nil, err
+			}
+		}
+		filter(concepts)
+	}
+	if result == nil || len(result) > maxBrowseConcepts {
+		return nil, ErrTooManyConcepts
+	}
+	return result, nil
+}
+
+// isDescendant returns whether the concept specified is a descendant of any of the parents
+func (svc *Svc) isDescendant(conceptID int64, parents []int64) (bool, error) {
+	ancestors, err := svc.AllParentIDs(conceptID)
+	if err != nil {
+		return false, err
+	}
+	for _, ancestor := range ancestors {
+		for _, parentID := range parents {
+			if ancestor == parentID && conceptID != parentID {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,155 @@
+package terminology
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+)
+
+func TestBrowse(t *testing.T) {
+	filename := "browse-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	ctx := context.Background()
+	root, severity, refset := snomed.Root.Integer(), int64(272141005), int64(991381000000107)
+	terms := map[int64]string{
+		root:      "SNOMED CT Concept",
+		severity:  "Severities",
+		24484000:  "Severe",
+		255604002: "Mild",
+		6736007:   "Moderate",
+		399166001: "Fatal",
+		442452003: "Life threatening severity",
+	}
+	var concepts []*snomed.Concept
+	var descriptions []*snomed.Description
+	var relationships []*snomed.Relationship
+	for conceptID, term := range terms {
+		concepts = append(concepts, &snomed.Concept{Id: conceptID, Active: conceptID != 442452003})
+		descriptions = append(descriptions, &snomed.Description{Id: conceptID, ConceptId: conceptID, Active: true, TypeId: int64(snomed.Synonym), Term: term, LanguageCode: "en"})
+		if conceptID == root {
+			continue
+		}
+		parentID := severity
+		if conceptID == severity {
+			parentID = root
+		}
+		relationships = append(relationships, &snomed.Relationship{Id: conceptID, SourceId: conceptID, TypeId: snomed.IsA, DestinationId: parentID, Active: true})
+	}
+	var items []*snomed.ReferenceSetItem
+	for i, conceptID := range []int64{24484000, 255604002, 6736007} {
+		items = append(items, &snomed.ReferenceSetItem{Id: fmt.Sprintf("2d0b9d58-3d1f-4d5e-8d1c-%012d", i), RefsetId: refset, ReferencedComponentId: conceptID, Active: true,
+			Body: &snomed.ReferenceSetItem_Simple{Simple: &snomed.SimpleReferenceSet{}}})
+	}
+	for _, c := range []interface{}{concepts, descriptions, relationships, items} {
+		if err := svc.Put(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	tags := []language.Tag{language.BritishEnglish}
+	tests := []struct {
+		name     string
+		request  *snomed.SearchRequest
+		expected []int64
+		total    int32
+	}{
+		{"is-a", &snomed.SearchRequest{IsA: []int64{severity}}, []int64{399166001, 255604002, 6736007, 24484000}, 4},
+		{"inactive", &snomed.SearchRequest{IsA: []int64{severity}, IncludeInactive: true}, []int64{399166001, 442452003, 255604002, 6736007, 24484000}, 5},
+		{"paged", &snomed.SearchRequest{IsA: []int64{severity}, Offset: 1, MaximumHits: 2}, []int64{255604002, 6736007}, 4},
+		{"last page", &snomed.SearchRequest{IsA: []int64{severity}, Offset: 4, MaximumHits: 2}, []int64{}, 4},
+		{"refset", &snomed.SearchRequest{IsA: []int64{severity}, ConceptRefsets: []int64{refset}}, []int64{255604002, 6736007, 24484000}, 3},
+		{"constraint", &snomed.SearchRequest{Constraint: "<< 272141005 MINUS ^ 991381000000107"}, []int64{399166001, severity}, 2},
+		{"direct parents", &snomed.SearchRequest{DirectParents: []int64{root}}, []int64{severity}, 1},
+	}
+	for _, test := range tests {
+		response, err := svc.Search(ctx, test.request, tags)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.Total != test.total || len(response.Items) != len(test.expected) {
+			t.Errorf("%s: expected %v (total %d), got %v (total %d)", test.name, test.expected, test.total, response.Items, response.Total)
+			continue
+		}
+		for i, item := range response.Items {
+			if item.ConceptId != test.expected[i] || item.Term != terms[item.ConceptId] || item.PreferredTerm != item.Term {
+				t.Errorf("%s: expected %v, got %v", test.name, test.expected, response.Items)
+				break
+			}
+		}
+	}
+	// later pages of the same request are listed from the cache
+	hits := svc.caches.browsed.statistics().Hits
+	if _, err := svc.Search(ctx, &snomed.SearchRequest{IsA: []int64{severity}, Offset: 2, MaximumHits: 2}, tags); err != nil {
+		t.Fatal(err)
+	}
+	if svc.caches.browsed.statistics().Hits != hits+1 {
+		t.Error("later page of a listing not listed from the cache")
+	}
+	// listing stops if the request is cancelled
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := svc.Search(cancelled, &snomed.SearchRequest{IsA: []int64{root}}, tags); !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled listing not stopped: %v", err)
+	}
+	// the walk of descendants stops once there are too many
+	if err := svc.descendants(ctx, severity, make(map[int64]struct{}), 2); !errors.Is(err, ErrTooManyConcepts) {
+		t.Errorf("walk of descendants not limited: %v", err)
+	}
+	if _, err := svc.Search(ctx, &snomed.SearchRequest{}, tags); !errors.Is(err, ErrTooManyConcepts) {
+		t.Errorf("listed concepts without any filter: %v", err)
+	}
+	response, err := svc.Search(ctx, &snomed.SearchRequest{S: "mild", Offset: 1}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Items) != 0 {
+		t.Errorf("offset not applied to search results: %v", response.Items)
+	}
+	response, err = svc.Search(ctx, &snomed.SearchRequest{S: "mil", Highlight: true}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Items) != 1 || response.Items[0].HighlightedTerm != "<mark>Mild</mark>" || response.Items[0].HighlightedPreferredTerm != "<mark>Mild</mark>" {
+		t.Errorf("search results not highlighted: %v", response.Items)
+	}
+
+	dir, err := ioutil.TempDir("", "feedback")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fb, err := OpenFeedback(dir, DefaultFeedbackHalfLife)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fb.Close()
+	svc.SetFeedback(fb)
+	for _, conceptID := range []int64{24484000, 255604002, 24484000} {
+		if err := fb.Record(&snomed.SearchFeedback{Request: &snomed.SearchRequest{IsA: []int64{severity}}, SelectedConcept: conceptID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	response, err = svc.Search(ctx, &snomed.SearchRequest{IsA: []int64{severity}, Order: snomed.SearchRequest_FREQUENCY}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []int64{24484000, 255604002, 399166001, 6736007}
+	for i, item := range response.Items {
+		if i >= len(expected) || item.ConceptId != expected[i] {
+			t.Fatalf("expected concepts in order of frequency %v, got %v", expected, response.Items)
+		}
+	}
+}
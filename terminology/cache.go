@@ -0,0 +1,236 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"container/list"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// DefaultCacheSize is the default maximum number of entries in each in-process cache.
+const DefaultCacheSize = 100000
+
+// lruCache is a simple size-bounded, least-recently-used cache that is safe for concurrent use.
+// A capacity of zero or less disables the cache.
+type lruCache struct {
+	name     string
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[interface{}]*list.Element
+	hits     uint64
+	misses   uint64
+}
+
+type lruEntry struct {
+	key   interface{}
+	value interface{}
+}
+
+func newLRUCache(name string, capacity int) *lruCache {
+	return &lruCache{
+		name:     name,
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[interface{}]*list.Element),
+	}
+}
+
+// get returns the cached value for the key, if it exists, recording a hit or miss.
+func (c *lruCache) get(key interface{}) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		c.hits++
+		return el.Value.(*lruEntry).value, true
+	}
+	c.misses++
+	return nil, false
+}
+
+// put adds a value to the cache, evicting the least recently used entry if the cache is full.
+func (c *lruCache) put(key interface{}, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.capacity <= 0 {
+		return
+	}
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		el.Value.(*lruEntry).value = value
+		return
+	}
+	c.items[key] = c.ll.PushFront(&lruEntry{key: key, value: value})
+	for c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// purge removes all entries from the cache, but retains the hit and miss counters.
+func (c *lruCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ll.Init()
+	c.items = make(map[interface{}]*list.Element)
+}
+
+// resize changes the capacity of the cache, evicting entries if necessary.
+func (c *lruCache) resize(capacity int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.capacity = capacity
+	for c.ll.Len() > 0 && c.ll.Len() > capacity {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry).key)
+	}
+}
+
+func (c *lruCache) statistics() CacheStatistics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStatistics{
+		Name:     c.name,
+		Capacity: c.capacity,
+		Size:     c.ll.Len(),
+		Hits:     c.hits,
+		Misses:   c.misses,
+	}
+}
+
+// CacheStatistics provides metrics for a single in-process cache.
+type CacheStatistics struct {
+	Name     string
+	Capacity int
+	Size     int
+	Hits     uint64
+	Misses   uint64
+}
+
+// HitRatio returns the proportion of lookups that were served from the cache.
+func (cs CacheStatistics) HitRatio() float64 {
+	total := cs.Hits + cs.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(cs.Hits) / float64(total)
+}
+
+func (cs CacheStatistics) String() string {
+	return fmt.Sprintf("%s cache: %d/%d entries, %d hits, %d misses (hit ratio: %.2f)", cs.Name, cs.Size, cs.Capacity, cs.Hits, cs.Misses, cs.HitRatio())
+}
+
+// caches are the in-process caches for commonly derived data structures.
+type caches struct {
+	concepts          *lruCache // conceptID -> *snomed.Concept
+	preferredSynonyms *lruCache // synonymKey -> *snomed.Description
+	ancestors         *lruCache // conceptID -> []int64
+	constraints       *lruCache // expression constraint -> map[int64]struct{}
+	browsed           *lruCache // browseKey -> *browsed
+}
+
+// maxConstraintCacheSize is the maximum number of expanded expression constraints, or listings of concepts, cached,
+// as each may be large
+const maxConstraintCacheSize = 100
+
+func constraintCacheSize(size int) int {
+	if size > maxConstraintCacheSize {
+		return maxConstraintCacheSize
+	}
+	return size
+}
+
+func newCaches(size int) *caches {
+	return &caches{
+		concepts:          newLRUCache("concepts", size),
+		preferredSynonyms: newLRUCache("preferred synonyms", size),
+		ancestors:         newLRUCache("ancestors", size),
+		constraints:       newLRUCache("constraints", constraintCacheSize(size)),
+		browsed:           newLRUCache("browsed", constraintCacheSize(size)),
+	}
+}
+
+func (c *caches) all() []*lruCache {
+	return []*lruCache{c.concepts, c.preferredSynonyms, c.ancestors, c.constraints, c.browsed}
+}
+
+func (c *caches) purge() {
+	for _, cache := range c.all() {
+		cache.purge()
+	}
+}
+
+// synonymKey is the cache key for a preferred synonym for a concept in a given set of languages.
+type synonymKey struct {
+	conceptID int64
+	tags      string
+}
+
+func newSynonymKey(conceptID int64, tags []language.Tag) synonymKey {
+	return synonymKey{conceptID: conceptID, tags: tagsKey(tags)}
+}
+
+// tagsKey returns a key for a set of languages, for use within a cache key
+func tagsKey(tags []language.Tag) string {
+	var sb strings.Builder
+	for i, tag := range tags {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(tag.String())
+	}
+	return sb.String()
+}
+
+// browseKey is the cache key for the concepts listed for a request without a search string in a given set of languages.
+type browseKey struct {
+	request string // the request, without its paging or ordering
+	tags    string
+}
+
+// SetCacheSize sets the maximum number of entries in each in-process cache.
+// A size of zero disables caching.
+func (svc *Svc) SetCacheSize(size int) {
+	for _, cache := range svc.caches.all() {
+		if cache == svc.caches.constraints || cache == svc.caches.browsed {
+			cache.resize(constraintCacheSize(size))
+			continue
+		}
+		cache.resize(size)
+	}
+}
+
+// CacheStatistics returns metrics for each of the in-process caches.
+func (svc *Svc) CacheStatistics() []CacheStatistics {
+	all := svc.caches.all()
+	result := make([]CacheStatistics, len(all))
+	for i, cache := range all {
+		result[i] = cache.statistics()
+	}
+	return result
+}
+
+// ClearCaches empties all in-process caches.
+func (svc *Svc) ClearCaches() {
+	svc.caches.purge()
+}
@@ -0,0 +1,73 @@
+package terminology
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestLRUCache(t *testing.T) {
+	c := newLRUCache("test", 2)
+	c.put(1, "one")
+	c.put(2, "two")
+	if v, ok := c.get(1); !ok || v.(string) != "one" {
+		t.Fatalf("expected cached value 'one', got %v", v)
+	}
+	c.put(3, "three") // should evict 2, as 1 was more recently used
+	if _, ok := c.get(2); ok {
+		t.Fatal("least recently used entry not evicted")
+	}
+	if _, ok := c.get(3); !ok {
+		t.Fatal("most recently added entry missing")
+	}
+	stats := c.statistics()
+	if stats.Hits != 2 || stats.Misses != 1 || stats.Size != 2 {
+		t.Fatalf("incorrect cache statistics: %v", stats)
+	}
+	c.purge()
+	if _, ok := c.get(1); ok {
+		t.Fatal("cache not purged")
+	}
+	c.resize(0)
+	c.put(4, "four")
+	if _, ok := c.get(4); ok {
+		t.Fatal("disabled cache stored a value")
+	}
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	filename := "cache-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	ctx := context.Background()
+	d1 := timestamppb.New(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC))
+	d2 := timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err := svc.Put(ctx, []*snomed.Concept{{Id: 24700007, EffectiveTime: d1, Active: true}}); err != nil {
+		t.Fatal(err)
+	}
+	c, err := svc.Concept(24700007)
+	if err != nil || !c.Active {
+		t.Fatalf("failed to fetch concept: %v", err)
+	}
+	c.Active = false // modifying a concept returned must not modify the cached concept
+	if c, err := svc.Concept(24700007); err != nil || !c.Active {
+		t.Fatalf("failed to fetch cached concept: %v", err)
+	}
+	if hits := svc.caches.concepts.statistics().Hits; hits != 1 {
+		t.Fatalf("expected one cache hit, got %d", hits)
+	}
+	if err := svc.Put(ctx, []*snomed.Concept{{Id: 24700007, EffectiveTime: d2, Active: false}}); err != nil {
+		t.Fatal(err)
+	}
+	if c, err := svc.Concept(24700007); err != nil || c.Active {
+		t.Fatalf("stale concept returned from cache after update: %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"context"
+	"sync"
+)
+
+// Checkpoint records progress through a long-running import or precomputation, so that
+// it can be resumed should it be interrupted.
+type Checkpoint struct {
+	Files      map[string]FileCheckpoint `json:",omitempty"` // import progress, keyed by absolute filename
+	Precompute int                       `json:",omitempty"` // number of completed precomputation stages
+	Indexed    int64                     `json:",omitempty"` // last concept whose descriptions are in the search index
+}
+
+// FileCheckpoint records the progress of the import of a single file
+type FileCheckpoint struct {
+	Batches  int  // number of batches, from the start of the file, that have been stored
+	Complete bool // whether the whole file has been stored
+}
+
+// stages of precomputation, which are checkpointed as each completes
+const (
+	stageNone = iota
+	stageDescriptions
+	stageRelationships
+	stageReferenceSets
+	stageSearchIndex
+)
+
+// Checkpointer is a Storer that can record the progress of an import so that it may be resumed
+type Checkpointer interface {
+	ImportProgress(filename string) FileCheckpoint
+	RecordImportProgress(filename string, fc FileCheckpoint) error
+}
+
+var _ Checkpointer = (*Svc)(nil)
+
+// ImportProgress returns the recorded progress of the import of the specified file
+func (svc *Svc) ImportProgress(filename string) FileCheckpoint {
+	svc.descriptorMu.Lock()
+	defer svc.descriptorMu.Unlock()
+	return svc.Checkpoint.Files[filename]
+}
+
+// RecordImportProgress records the progress of the import of the specified file.
+// As new data invalidates any earlier partial precomputation, precomputation progress is reset.
+func (svc *Svc) RecordImportProgress(filename string, fc FileCheckpoint) error {
+	return svc.updateDescriptor(func(d *Descriptor) {
+		if d.Checkpoint.Files == nil {
+			d.Checkpoint.Files = make(map[string]FileCheckpoint)
+		}
+		d.Checkpoint.Files[filename] = fc
+		d.Checkpoint.Precompute = stageNone
+		d.Checkpoint.Indexed = 0
+	})
+}
+
+// ClearImportCheckpoints clears the recorded progress of imports. This should be called once
+// all imports have completed, so that the same files may be imported again in the future.
+func (svc *Svc) ClearImportCheckpoints() error {
+	return svc.updateDescriptor(func(d *Descriptor) {
+		d.Checkpoint.Files = nil
+	})
+}
+
+// precomputeStage returns the number of completed precomputation stages
+func (svc *Svc) precomputeStage() int {
+	svc.descriptorMu.Lock()
+	defer svc.descriptorMu.Unlock()
+	return svc.Checkpoint.Precompute
+}
+
+// recordPrecomputeStage records the completion of a stage of precomputation.
+// Any progress within the search index stage is cleared, as it is either complete or yet to start.
+func (svc *Svc) recordPrecomputeStage(stage int) error {
+	return svc.updateDescriptor(func(d *Descriptor) {
+		d.Checkpoint.Precompute = stage
+		d.Checkpoint.Indexed = 0
+	})
+}
+
+// searchIndexProgress returns the identifier of the last concept whose descriptions have been indexed
+// by an incomplete search index stage, or zero
+func (svc *Svc) searchIndexProgress() int64 {
+	svc.descriptorMu.Lock()
+	defer svc.descriptorMu.Unlock()
+	return svc.Checkpoint.Indexed
+}
+
+// recordSearchIndexProgress records that the descriptions of all concepts up to and including
+// the specified concept have been indexed
+func (svc *Svc) recordSearchIndexProgress(conceptID int64) error {
+	return svc.updateDescriptor(func(d *Descriptor) {
+		d.Checkpoint.Indexed = conceptID
+	})
+}
+
+// firstError records the first error from a group of concurrent operations, cancelling their context
+type firstError struct {
+	mu     sync.Mutex
+	err    error
+	cancel context.CancelFunc
+}
+
+func newFirstError(ctx context.Context) (context.Context, *firstError) {
+	ctx, cancel := context.WithCancel(ctx)
+	return ctx, &firstError{cancel: cancel}
+}
+
+// set records the error, if it is the first, and cancels the other operations
+func (fe *firstError) set(err error) {
+	if err == nil {
+		return
+	}
+	fe.mu.Lock()
+	defer fe.mu.Unlock()
+	if fe.err == nil {
+		fe.err = err
+		fe.cancel()
+	}
+}
+
+// get returns the first error recorded
+func (fe *firstError) get() error {
+	fe.mu.Lock()
+	defer fe.mu.Unlock()
+	return fe.err
+}
@@ -1,6 +1,8 @@
 package terminology
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -19,7 +21,7 @@ var typeRootMap = map[string]int64{
 }
 
 // Extract using NLP to extract entities from a block of text using Amazon Comprehend's API
-func (svc *Svc) Extract(r *snomed.ExtractRequest, tags []language.Tag) (*snomed.ExtractResponse, error) {
+func (svc *Svc) Extract(ctx context.Context, r *snomed.ExtractRequest, tags []language.Tag) (*snomed.ExtractResponse, error) {
 	s, err := session.NewSession(&aws.Config{
 		Region:      aws.String("eu-west-1"), // TODO: allow configuration
 		Credentials: credentials.NewEnvCredentials(),
@@ -30,14 +32,14 @@ func (svc *Svc) Extract(r *snomed.ExtractRequest, tags []language.Tag) (*snomed.
 	client := comprehendmedical.New(s)
 	input := comprehendmedical.DetectEntitiesInput{}
 	input.SetText(r.S)
-	result, err := client.DetectEntities(&input)
+	result, err := client.DetectEntitiesWithContext(ctx, &input)
 	if err != nil {
 		return nil, err
 	}
 	results, errors := make(chan *snomed.ExtractResponse_Entity), make(chan error)
 	for _, entity := range result.Entities {
 		go func(e *comprehendmedical.Entity) {
-			r, err := processEntity(svc, e, tags)
+			r, err := processEntity(ctx, svc, e, tags)
 			if err != nil {
 				errors <- err
 				return
@@ -59,7 +61,7 @@ func (svc *Svc) Extract(r *snomed.ExtractRequest, tags []language.Tag) (*snomed.
 	}, nil
 }
 
-func processEntity(svc *Svc, entity *comprehendmedical.Entity, tags []language.Tag) (*snomed.ExtractResponse_Entity, error) {
+func processEntity(ctx context.Context, svc *Svc, entity *comprehendmedical.Entity, tags []language.Tag) (*snomed.ExtractResponse_Entity, error) {
 	key := *entity.Category + "-" + *entity.Type
 	roots := make([]int64, 0)
 	root := typeRootMap[key]
@@ -74,7 +76,7 @@ func processEntity(svc *Svc, entity *comprehendmedical.Entity, tags []language.T
 			responseEntity.Negated = true
 		}
 	}
-	sr, err := svc.Search(&snomed.SearchRequest{
+	sr, err := svc.Search(ctx, &snomed.SearchRequest{
 		S:           *entity.Text,
 		IsA:         roots,
 		MaximumHits: 5,
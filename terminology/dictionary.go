@@ -0,0 +1,517 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/index/scorch"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxExpansionTokens is the maximum number of tokens of a search string that can be replaced by a query expansion
+const maxExpansionTokens = 4
+
+// dictionaryPageSize is the number of entries fetched at a time when listing or removing entries
+const dictionaryPageSize = 1000
+
+// Dictionary is a local index of synonyms and abbreviations, such as "NOF #" or "AKI", which are not descriptions
+// in SNOMED CT. Each entry is either a synonym of a concept, whose term is searched alongside descriptions, or a
+// query expansion, which is searched as an alternative whenever its term forms part of a search string.
+// A dictionary is kept separately from a terminology database, so that it is retained when a database is
+// replaced by a new release, and so that it does not require editing a distribution or authoring an extension.
+type Dictionary struct {
+	bs *bleveService
+	mu sync.Mutex // serialises updates
+}
+
+// dictionaryDocument is the document indexed by bleve for each entry in a dictionary
+type dictionaryDocument struct {
+	Terms        map[string]string // the term itself, keyed by its base language
+	Words        string            // the term, forming part of the dictionary of words for spelling suggestions
+	Key          string            // the term normalised, for exact matches of query expansions
+	Term         string
+	ConceptID    string
+	Expansion    string
+	LanguageCode string
+}
+
+// OpenDictionary opens or creates a local dictionary of synonyms and abbreviations at the path specified
+func OpenDictionary(path string) (*Dictionary, error) {
+	index, err := bleve.Open(path)
+	if err == nil {
+		return &Dictionary{bs: &bleveService{index: index}}, nil
+	}
+	if err != bleve.ErrorIndexPathDoesNotExist {
+		return nil, err
+	}
+	indexMapping := bleve.NewIndexMapping()
+	indexMapping.StoreDynamic = false
+	indexMapping.DocValuesDynamic = false
+	documentMapping := bleve.NewDocumentMapping()
+	indexMapping.AddDocumentMapping("entry", documentMapping)
+	indexMapping.DefaultType = "entry"
+	if err := addTermsMapping(indexMapping, documentMapping); err != nil {
+		return nil, err
+	}
+	addWordsMapping(documentMapping)
+	keywordMapping := bleve.NewTextFieldMapping()
+	keywordMapping.Analyzer = keyword.Name
+	keywordMapping.Store = true
+	keywordMapping.IncludeInAll = false
+	keywordMapping.IncludeTermVectors = false
+	storedMapping := bleve.NewTextFieldMapping()
+	storedMapping.Index = false
+	storedMapping.Store = true
+	storedMapping.IncludeInAll = false
+	storedMapping.IncludeTermVectors = false
+	documentMapping.AddFieldMappingsAt("Key", keywordMapping)
+	documentMapping.AddFieldMappingsAt("ConceptID", keywordMapping)
+	documentMapping.AddFieldMappingsAt("Term", storedMapping)
+	documentMapping.AddFieldMappingsAt("Expansion", storedMapping)
+	documentMapping.AddFieldMappingsAt("LanguageCode", storedMapping)
+	index, err = bleve.NewUsing(path, indexMapping, scorch.Name, scorch.Name, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Dictionary{bs: &bleveService{index: index}}, nil
+}
+
+// Close closes the dictionary
+func (d *Dictionary) Close() error {
+	return d.bs.Close()
+}
+
+// Count returns the number of entries in the dictionary
+func (d *Dictionary) Count() (uint64, error) {
+	return d.bs.index.DocCount()
+}
+
+// Add adds the entries specified to the dictionary. An entry with the same term and concept or expansion
+// as an existing entry replaces that entry.
+func (d *Dictionary) Add(entries []*snomed.DictionaryEntry) error {
+	return d.update(entries, false)
+}
+
+// Replace replaces all of the entries in the dictionary with those specified.
+// The existing entries are kept if any of the entries specified is invalid.
+func (d *Dictionary) Replace(entries []*snomed.DictionaryEntry) error {
+	return d.update(entries, true)
+}
+
+// update adds the entries specified to the dictionary, optionally removing all existing entries,
+// once every entry has been validated.
+func (d *Dictionary) update(entries []*snomed.DictionaryEntry, replace bool) error {
+	for _, e := range entries {
+		if err := validateDictionaryEntry(e); err != nil {
+			return err
+		}
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	batch := d.bs.index.NewBatch()
+	if replace {
+		existing, err := d.all(bleve.NewMatchAllQuery())
+		if err != nil {
+			return err
+		}
+		for _, hit := range existing {
+			batch.Delete(hit.ID)
+		}
+	}
+	for _, e := range entries {
+		doc := &dictionaryDocument{
+			Terms:        map[string]string{termLanguage(e.GetLanguageCode()): e.GetTerm()},
+			Words:        e.GetTerm(),
+			Key:          normaliseDictionaryTerm(e.GetTerm()),
+			Term:         e.GetTerm(),
+			Expansion:    e.GetExpansion(),
+			LanguageCode: e.GetLanguageCode(),
+		}
+		target := e.GetExpansion()
+		if e.GetConceptId() != 0 {
+			doc.ConceptID = strconv.FormatInt(e.GetConceptId(), 10)
+			target = doc.ConceptID
+		}
+		if err := batch.Index(doc.Key+"\t"+normaliseDictionaryTerm(target), doc); err != nil {
+			return err
+		}
+	}
+	return d.bs.index.Batch(batch)
+}
+
+// Entries returns all of the entries in the dictionary, ordered by term
+func (d *Dictionary) Entries() ([]*snomed.DictionaryEntry, error) {
+	hits, err := d.all(bleve.NewMatchAllQuery(), "Term", "ConceptID", "Expansion", "LanguageCode")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*snomed.DictionaryEntry, len(hits))
+	for i, hit := range hits {
+		result[i] = &snomed.DictionaryEntry{
+			Term:         storedField(hit, "Term"),
+			Expansion:    storedField(hit, "Expansion"),
+			LanguageCode: storedField(hit, "LanguageCode"),
+		}
+		if conceptID := storedField(hit, "ConceptID"); conceptID != "" {
+			if result[i].ConceptId, err = strconv.ParseInt(conceptID, 10, 64); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// Remove removes the entries for each of the terms specified, returning the number of entries removed
+func (d *Dictionary) Remove(terms []string) (int, error) {
+	if len(terms) == 0 {
+		return 0, nil
+	}
+	q := bleve.NewDisjunctionQuery()
+	for _, term := range terms {
+		tq := bleve.NewTermQuery(normaliseDictionaryTerm(term))
+		tq.SetField("Key")
+		q.AddQuery(tq)
+	}
+	return d.remove(q)
+}
+
+// Clear removes all of the entries in the dictionary
+func (d *Dictionary) Clear() error {
+	_, err := d.remove(bleve.NewMatchAllQuery())
+	return err
+}
+
+// remove removes the entries matching the query specified
+func (d *Dictionary) remove(q query.Query) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	hits, err := d.all(q)
+	if err != nil {
+		return 0, err
+	}
+	batch := d.bs.index.NewBatch()
+	for _, hit := range hits {
+		batch.Delete(hit.ID)
+	}
+	return len(hits), d.bs.index.Batch(batch)
+}
+
+// all returns all of the entries matching the query, with the stored fields specified, ordered by identifier
+func (d *Dictionary) all(q query.Query, fields ...string) ([]*search.DocumentMatch, error) {
+	var result []*search.DocumentMatch
+	for from := 0; ; from += dictionaryPageSize {
+		req := bleve.NewSearchRequestOptions(q, dictionaryPageSize, from, false)
+		req.Fields = fields
+		req.SortBy([]string{"_id"})
+		sr, err := d.bs.index.Search(req)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, sr.Hits...)
+		if len(sr.Hits) < dictionaryPageSize {
+			return result, nil
+		}
+	}
+}
+
+// Expand returns the alternative search strings resulting from replacing any part of the search string that is
+// the term of a query expansion with that expansion.
+func (d *Dictionary) Expand(s string) ([]string, error) {
+	tokens := strings.Fields(s)
+	type span struct{ start, end int }
+	spans := make(map[string]span)
+	q := bleve.NewDisjunctionQuery()
+	for i := range tokens {
+		for j := i + 1; j <= len(tokens) && j-i <= maxExpansionTokens; j++ {
+			key := normaliseDictionaryTerm(strings.Join(tokens[i:j], " "))
+			if _, exists := spans[key]; exists {
+				continue
+			}
+			spans[key] = span{start: i, end: j}
+			tq := bleve.NewTermQuery(key)
+			tq.SetField("Key")
+			q.AddQuery(tq)
+		}
+	}
+	if len(spans) == 0 {
+		return nil, nil
+	}
+	hits, err := d.all(q, "Key", "Expansion")
+	if err != nil {
+		return nil, err
+	}
+	expanded := make(map[string]struct{})
+	var result []string
+	for _, hit := range hits {
+		expansion := storedField(hit, "Expansion")
+		sp, ok := spans[storedField(hit, "Key")]
+		if expansion == "" || !ok {
+			continue
+		}
+		alternative := make([]string, 0, len(tokens))
+		alternative = append(alternative, tokens[:sp.start]...)
+		alternative = append(alternative, expansion)
+		alternative = append(alternative, tokens[sp.end:]...)
+		s := strings.Join(alternative, " ")
+		if _, done := expanded[s]; !done {
+			expanded[s] = struct{}{}
+			result = append(result, s)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// search returns the synonyms of concepts matching the search string, most relevant first, as search hits
+// with the term of each synonym rather than the identifier of a description.
+func (d *Dictionary) search(s string, fuzzy snomed.SearchRequest_Fuzzy, maximumHits int) ([]SearchHit, error) {
+	fields, err := d.bs.termFields(nil)
+	if err != nil {
+		return nil, err
+	}
+	q := d.bs.query(&snomed.SearchRequest{S: s, Fuzzy: fuzzy, IncludeInactive: true}, fields[0])
+	req := bleve.NewSearchRequestOptions(q, maximumHits, 0, false)
+	req.Fields = []string{"Term", "ConceptID", "LanguageCode"}
+	sr, err := d.bs.index.Search(req)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]SearchHit, 0, len(sr.Hits))
+	for _, hit := range sr.Hits {
+		if storedField(hit, "ConceptID") == "" { // a query expansion
+			continue
+		}
+		conceptID, err := strconv.ParseInt(storedField(hit, "ConceptID"), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, SearchHit{Score: hit.Score, Local: &snomed.Description{
+			ConceptId:    conceptID,
+			Term:         storedField(hit, "Term"),
+			LanguageCode: storedField(hit, "LanguageCode"),
+			TypeId:       int64(snomed.Synonym),
+			Active:       true,
+		}})
+	}
+	return result, nil
+}
+
+// storedField returns the value of a stored field of a search hit, or an empty string if it has no value
+func storedField(hit *search.DocumentMatch, field string) string {
+	s, _ := hit.Fields[field].(string)
+	return s
+}
+
+// normaliseDictionaryTerm returns a term in lowercase, with whitespace normalised
+func normaliseDictionaryTerm(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// validateDictionaryEntry checks that an entry has a term, and either a valid concept identifier or an expansion
+func validateDictionaryEntry(e *snomed.DictionaryEntry) error {
+	if normaliseDictionaryTerm(e.GetTerm()) == "" {
+		return fmt.Errorf("dictionary entry without a term")
+	}
+	if (e.GetConceptId() == 0) == (strings.TrimSpace(e.GetExpansion()) == "") {
+		return fmt.Errorf("dictionary entry '%s' must have either a concept or an expansion", e.GetTerm())
+	}
+	if id := snomed.Identifier(e.GetConceptId()); id != 0 && (!id.IsValid() || !id.IsConcept()) {
+		return fmt.Errorf("dictionary entry '%s' has an invalid concept identifier: %d", e.GetTerm(), id)
+	}
+	return nil
+}
+
+// ReadDictionary reads the entries of a dictionary file. Each line contains a term and either a concept identifier
+// or an expansion, separated by a tab, and optionally followed by a tab and the language of the term.
+// Blank lines and lines starting with '#' are ignored.
+func ReadDictionary(r io.Reader) ([]*snomed.DictionaryEntry, error) {
+	var result []*snomed.DictionaryEntry
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("line %d: expected a term and a concept identifier or expansion, separated by a tab", n)
+		}
+		e := &snomed.DictionaryEntry{Term: strings.TrimSpace(fields[0])}
+		target := strings.TrimSpace(fields[1])
+		if id, err := snomed.ParseAndValidate(target); err == nil && id.IsConcept() {
+			e.ConceptId = id.Integer()
+		} else {
+			e.Expansion = target
+		}
+		if len(fields) == 3 {
+			e.LanguageCode = strings.TrimSpace(fields[2])
+		}
+		if err := validateDictionaryEntry(e); err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		result = append(result, e)
+	}
+	return result, scanner.Err()
+}
+
+// SetDictionary sets the local dictionary of synonyms and abbreviations to be used in searches,
+// or nil to search without a local dictionary. It should be set before the service is used to search.
+func (svc *Svc) SetDictionary(d *Dictionary) {
+	svc.dictionary = d
+}
+
+// searchDictionary adds to the hits from the search index those from searching each of the alternative search
+// strings from query expansions in the local dictionary, and the synonyms from the local dictionary for concepts
+// that satisfy the filters of the request, returning the hits in order of relevance.
+// The scores of each search are not comparable with those of another, so each is normalised before merging.
+func (svc *Svc) searchDictionary(req *snomed.SearchRequest, candidates *snomed.SearchRequest, tags []language.Tag, accept func(int64) (bool, error), filter func(int64) (bool, error), hits []SearchHit) ([]SearchHit, error) {
+	expansions, err := svc.dictionary.Expand(candidates.GetS())
+	if err != nil {
+		return nil, err
+	}
+	normaliseScores(hits)
+	seen := make(map[int64]int, len(hits))
+	for i, hit := range hits {
+		seen[hit.DescriptionID] = i
+	}
+	for _, s := range expansions {
+		sr := proto.Clone(candidates).(*snomed.SearchRequest)
+		sr.S = s
+		more, _, err := svc.search.SearchFiltered(sr, tags, accept)
+		if err != nil {
+			return nil, err
+		}
+		normaliseScores(more)
+		for _, hit := range more {
+			if i, ok := seen[hit.DescriptionID]; ok {
+				if hit.Score > hits[i].Score {
+					hits[i].Score = hit.Score
+				}
+				continue
+			}
+			seen[hit.DescriptionID] = len(hits)
+			hits = append(hits, hit)
+		}
+	}
+	local, err := svc.dictionary.search(candidates.GetS(), candidates.GetFuzzy(), int(candidates.GetMaximumHits()))
+	if err != nil {
+		return nil, err
+	}
+	normaliseScores(local)
+	for _, hit := range local {
+		ok, err := svc.acceptLocal(req, hit.Local.ConceptId, filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			hits = append(hits, hit)
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].Score > hits[j].Score
+	})
+	return hits, nil
+}
+
+// normaliseScores scales the scores of search hits so that the most relevant has a score of 1
+func normaliseScores(hits []SearchHit) {
+	var best float64
+	for _, hit := range hits {
+		if hit.Score > best {
+			best = hit.Score
+		}
+	}
+	if best == 0 {
+		return
+	}
+	for i := range hits {
+		hits[i].Score /= best
+	}
+}
+
+// acceptLocal determines whether a concept with a synonym from the local dictionary satisfies the filters of a request,
+// which are otherwise applied by the search index. A local synonym is never a member of a description reference set.
+func (svc *Svc) acceptLocal(req *snomed.SearchRequest, conceptID int64, filter func(int64) (bool, error)) (bool, error) {
+	c, err := svc.Concept(conceptID)
+	if err == ErrNotFound { // e.g. a synonym for a concept not in this release
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !c.GetActive() && !req.GetIncludeInactive() {
+		return false, nil
+	}
+	if len(req.GetDescriptionRefsets()) > 0 {
+		return false, nil
+	}
+	if len(req.GetIsA()) > 0 {
+		parents := make(map[int64]struct{}, len(req.GetIsA()))
+		for _, id := range req.GetIsA() {
+			parents[id] = struct{}{}
+		}
+		if ok, err := svc.isAnyOf(conceptID, parents); err != nil || !ok {
+			return false, err
+		}
+	}
+	if len(req.GetDirectParents()) > 0 {
+		parents, err := svc.Parents(conceptID)
+		if err != nil {
+			return false, err
+		}
+		if !containsAny(parents, req.GetDirectParents()) {
+			return false, nil
+		}
+	}
+	if len(req.GetConceptRefsets()) > 0 {
+		refsets, err := svc.ComponentReferenceSets(conceptID)
+		if err != nil {
+			return false, err
+		}
+		if !containsAny(refsets, req.GetConceptRefsets()) {
+			return false, nil
+		}
+	}
+	if filter != nil {
+		return filter(conceptID)
+	}
+	return true, nil
+}
+
+// containsAny returns whether any of the identifiers specified are in the list
+func containsAny(list []int64, ids []int64) bool {
+	for _, a := range list {
+		for _, b := range ids {
+			if a == b {
+				return true
+			}
+		}
+	}
+	return false
+}
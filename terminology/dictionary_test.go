@@ -0,0 +1,173 @@
+package terminology
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+)
+
+func TestReadDictionary(t *testing.T) {
+	entries, err := ReadDictionary(strings.NewReader("# local abbreviations\nNOF #\t263225007\n\nAKI\tacute kidney injury\r\nSHO\tsenior house officer\ten-GB\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %v", entries)
+	}
+	if entries[0].Term != "NOF #" || entries[0].ConceptId != 263225007 || entries[0].Expansion != "" {
+		t.Errorf("incorrect synonym: %v", entries[0])
+	}
+	if entries[1].Term != "AKI" || entries[1].ConceptId != 0 || entries[1].Expansion != "acute kidney injury" {
+		t.Errorf("incorrect expansion: %v", entries[1])
+	}
+	if entries[2].LanguageCode != "en-GB" {
+		t.Errorf("incorrect language: %v", entries[2])
+	}
+	for _, invalid := range []string{"NOF #", "NOF #\t263225007\ten\textra", "\t263225007", "NOF #\t "} {
+		if _, err := ReadDictionary(strings.NewReader(invalid)); err == nil {
+			t.Errorf("invalid dictionary read without error: %s", invalid)
+		}
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	filename := "dictionary-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	dir, err := ioutil.TempDir("", "dictionary")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+	root, finding, procedure := snomed.Root.Integer(), int64(snomed.ClinicalFinding), int64(snomed.Procedure)
+	terms := map[int64]string{
+		root:      "SNOMED CT Concept",
+		finding:   "Clinical finding",
+		procedure: "Procedure",
+		263225007: "Fracture of neck of femur",
+		14669001:  "Acute kidney injury",
+		19031009:  "Fracture reduction",
+	}
+	parents := map[int64]int64{finding: root, procedure: root, 263225007: finding, 14669001: finding, 19031009: procedure}
+	var concepts []*snomed.Concept
+	var descriptions []*snomed.Description
+	var relationships []*snomed.Relationship
+	for conceptID, term := range terms {
+		concepts = append(concepts, &snomed.Concept{Id: conceptID, Active: true})
+		descriptions = append(descriptions, &snomed.Description{Id: conceptID, ConceptId: conceptID, Active: true, TypeId: int64(snomed.Synonym), Term: term, LanguageCode: "en"})
+		if parentID, ok := parents[conceptID]; ok {
+			relationships = append(relationships, &snomed.Relationship{Id: conceptID, SourceId: conceptID, TypeId: snomed.IsA, DestinationId: parentID, Active: true})
+		}
+	}
+	for _, c := range []interface{}{concepts, descriptions, relationships} {
+		if err := svc.Put(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	d, err := OpenDictionary(filepath.Join(dir, "dictionary"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	if err := d.Add([]*snomed.DictionaryEntry{{Term: "NOF #", ConceptId: 263225008}}); err == nil {
+		t.Error("added entry with an invalid concept identifier")
+	}
+	entries := []*snomed.DictionaryEntry{
+		{Term: "NOF #", ConceptId: 263225007},
+		{Term: "AKI", Expansion: "acute kidney injury"},
+		{Term: "AKI", Expansion: "acute kidney injury"}, // duplicates are loaded only once
+	}
+	if err := d.Add(entries); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := d.Count(); err != nil || n != 2 {
+		t.Errorf("expected 2 entries, got %d (%v)", n, err)
+	}
+	svc.SetDictionary(d)
+	tags := []language.Tag{language.BritishEnglish}
+
+	response, err := svc.Search(ctx, &snomed.SearchRequest{S: "nof"}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Items) != 1 {
+		t.Fatalf("expected a local synonym, got %v", response.Items)
+	}
+	if item := response.Items[0]; !item.Local || item.DescriptionId != 0 || item.Term != "NOF #" || item.ConceptId != 263225007 || item.PreferredTerm != "Fracture of neck of femur" {
+		t.Errorf("incorrect local synonym: %v", item)
+	}
+	response, err = svc.Search(ctx, &snomed.SearchRequest{S: "nof", IsA: []int64{procedure}}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Items) != 0 {
+		t.Errorf("local synonym not filtered: %v", response.Items)
+	}
+	response, err = svc.Search(ctx, &snomed.SearchRequest{S: "AKI"}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Items) != 1 || response.Items[0].ConceptId != 14669001 || response.Items[0].Local {
+		t.Errorf("search string not expanded: %v", response.Items)
+	}
+
+	removed, err := d.Remove([]string{"nof  #"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 1 {
+		t.Errorf("expected to remove 1 entry, removed %d", removed)
+	}
+	list, err := d.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Term != "AKI" || list[0].Expansion != "acute kidney injury" {
+		t.Errorf("incorrect entries after removal: %v", list)
+	}
+	if response, err = svc.Search(ctx, &snomed.SearchRequest{S: "nof"}, tags); err != nil || len(response.Items) != 0 {
+		t.Errorf("removed local synonym still found: %v (%v)", response.Items, err)
+	}
+	// an invalid entry means that no entries are replaced
+	if err := d.Replace([]*snomed.DictionaryEntry{{Term: "MS", Expansion: "multiple sclerosis"}, {Term: "NOF #"}}); err == nil {
+		t.Error("replaced entries with an invalid entry")
+	}
+	if list, err = d.Entries(); err != nil || len(list) != 1 || list[0].Term != "AKI" {
+		t.Errorf("entries changed by a failed replacement: %v (%v)", list, err)
+	}
+	if err := d.Replace([]*snomed.DictionaryEntry{{Term: "MS", Expansion: "multiple sclerosis"}}); err != nil {
+		t.Fatal(err)
+	}
+	if list, err = d.Entries(); err != nil || len(list) != 1 || list[0].Term != "MS" {
+		t.Errorf("incorrect entries after replacement: %v (%v)", list, err)
+	}
+	if err := d.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := d.Count(); err != nil || n != 0 {
+		t.Errorf("expected no entries, got %d (%v)", n, err)
+	}
+}
+
+func TestNormaliseScores(t *testing.T) {
+	hits := []SearchHit{{DescriptionID: 1, Score: 4}, {DescriptionID: 2, Score: 1}}
+	normaliseScores(hits)
+	if hits[0].Score != 1 || hits[1].Score != 0.25 {
+		t.Errorf("incorrectly normalised scores: %v", hits)
+	}
+	normaliseScores(nil)
+}
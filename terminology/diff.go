@@ -0,0 +1,520 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+)
+
+// DiffOptions configures a comparison between two releases
+type DiffOptions struct {
+	Tags          []language.Tag // language preferences for terms
+	ReferenceSets []int64        // reference sets for which membership changes should be reported
+}
+
+// DiffReport is a structured report of the differences between two releases
+type DiffReport struct {
+	From          string               `json:"from"`
+	To            string               `json:"to"`
+	Added         []ConceptSummary     `json:"added"`
+	Reactivated   []ConceptSummary     `json:"reactivated"`
+	Inactivated   []InactivatedConcept `json:"inactivated"`
+	Removed       []ConceptSummary     `json:"removed"` // concepts only in the older release
+	Moved         []MovedConcept       `json:"moved"`
+	TermChanged   []TermChange         `json:"termChanged"`
+	RefsetChanges []RefsetChange       `json:"refsetChanges"`
+}
+
+// ConceptSummary identifies a concept and its preferred term
+type ConceptSummary struct {
+	ConceptID int64  `json:"conceptId"`
+	Term      string `json:"term"`
+}
+
+// InactivatedConcept records a concept inactivated, with its reason and any replacements
+type InactivatedConcept struct {
+	ConceptSummary
+	Reason       *ConceptSummary `json:"reason,omitempty"`
+	Replacements []Replacement   `json:"replacements"`
+}
+
+// Replacement records a historical association from an inactivated concept to another concept
+type Replacement struct {
+	Association string `json:"association"`
+	ConceptSummary
+}
+
+// MovedConcept records a concept with changed IS-A parents
+type MovedConcept struct {
+	ConceptSummary
+	OldParents []int64 `json:"oldParents"`
+	NewParents []int64 `json:"newParents"`
+}
+
+// TermChange records a change in the preferred term for a concept
+type TermChange struct {
+	ConceptID int64  `json:"conceptId"`
+	OldTerm   string `json:"oldTerm"`
+	NewTerm   string `json:"newTerm"`
+}
+
+// RefsetChange records changes in the active membership of a reference set
+type RefsetChange struct {
+	ConceptSummary
+	Added   []int64 `json:"added"`
+	Removed []int64 `json:"removed"`
+}
+
+// historical associations used to report replacements for inactivated concepts
+var replacementReferenceSets = []struct {
+	name     string
+	refsetID int64
+}{
+	{"SAME_AS", snomed.SameAsReferenceSet},
+	{"REPLACED_BY", snomed.ReplacedByReferenceSet},
+	{"POSSIBLY_EQUIVALENT_TO", snomed.PossiblyEquivalentToReferenceSet},
+	{"ALTERNATIVE", snomed.AlternativeReferenceSet},
+}
+
+func (dr *DiffReport) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Changes from %s to %s\n", dr.From, dr.To))
+	b.WriteString(fmt.Sprintf("\nConcepts added: %d\n", len(dr.Added)))
+	for _, c := range dr.Added {
+		b.WriteString(fmt.Sprintf("  %d | %s |\n", c.ConceptID, c.Term))
+	}
+	b.WriteString(fmt.Sprintf("\nConcepts reactivated: %d\n", len(dr.Reactivated)))
+	for _, c := range dr.Reactivated {
+		b.WriteString(fmt.Sprintf("  %d | %s |\n", c.ConceptID, c.Term))
+	}
+	b.WriteString(fmt.Sprintf("\nConcepts inactivated: %d\n", len(dr.Inactivated)))
+	for _, c := range dr.Inactivated {
+		b.WriteString(fmt.Sprintf("  %d | %s |", c.ConceptID, c.Term))
+		if c.Reason != nil {
+			b.WriteString(fmt.Sprintf(" reason: %s", c.Reason.Term))
+		}
+		b.WriteString("\n")
+		for _, r := range c.Replacements {
+			b.WriteString(fmt.Sprintf("    %s %d | %s |\n", r.Association, r.ConceptID, r.Term))
+		}
+	}
+	b.WriteString(fmt.Sprintf("\nConcepts removed: %d\n", len(dr.Removed)))
+	for _, c := range dr.Removed {
+		b.WriteString(fmt.Sprintf("  %d | %s |\n", c.ConceptID, c.Term))
+	}
+	b.WriteString(fmt.Sprintf("\nConcepts moved in hierarchy: %d\n", len(dr.Moved)))
+	for _, c := range dr.Moved {
+		b.WriteString(fmt.Sprintf("  %d | %s | parents: %v -> %v\n", c.ConceptID, c.Term, c.OldParents, c.NewParents))
+	}
+	b.WriteString(fmt.Sprintf("\nPreferred terms changed: %d\n", len(dr.TermChanged)))
+	for _, c := range dr.TermChanged {
+		b.WriteString(fmt.Sprintf("  %d: %q -> %q\n", c.ConceptID, c.OldTerm, c.NewTerm))
+	}
+	for _, rc := range dr.RefsetChanges {
+		b.WriteString(fmt.Sprintf("\nReference set %d | %s |: %d added, %d removed\n", rc.ConceptID, rc.Term, len(rc.Added), len(rc.Removed)))
+		for _, id := range rc.Added {
+			b.WriteString(fmt.Sprintf("  + %d\n", id))
+		}
+		for _, id := range rc.Removed {
+			b.WriteString(fmt.Sprintf("  - %d\n", id))
+		}
+	}
+	return b.String()
+}
+
+// releaseView is a read-only view of a release, either the current contents of a store or as at a date.
+type releaseView interface {
+	concept(conceptID int64) (*snomed.Concept, error)
+	parents(conceptID int64) ([]int64, error)
+	preferredSynonym(conceptID int64, tags []language.Tag) (*snomed.Description, error)
+	referenceSetItems(refsetID int64, componentID int64) ([]*snomed.ReferenceSetItem, error)
+	store() *Svc
+}
+
+// currentView is a view of the current contents of a store
+type currentView struct {
+	svc *Svc
+}
+
+func (v currentView) concept(conceptID int64) (*snomed.Concept, error) {
+	return v.svc.Concept(conceptID)
+}
+func (v currentView) parents(conceptID int64) ([]int64, error) {
+	return v.svc.Parents(conceptID)
+}
+func (v currentView) preferredSynonym(conceptID int64, tags []language.Tag) (*snomed.Description, error) {
+	return v.svc.PreferredSynonym(conceptID, tags)
+}
+func (v currentView) referenceSetItems(refsetID int64, componentID int64) ([]*snomed.ReferenceSetItem, error) {
+	return v.svc.ComponentFromReferenceSet(refsetID, componentID)
+}
+func (v currentView) store() *Svc {
+	return v.svc
+}
+
+// dateView is a view of a store as at a specific date, using recorded component history
+type dateView struct {
+	svc *Svc
+	t   time.Time
+}
+
+func (v dateView) concept(conceptID int64) (*snomed.Concept, error) {
+	return v.svc.ConceptAt(conceptID, v.t)
+}
+func (v dateView) parents(conceptID int64) ([]int64, error) {
+	return v.svc.ParentsAt(conceptID, v.t)
+}
+func (v dateView) preferredSynonym(conceptID int64, tags []language.Tag) (*snomed.Description, error) {
+	descs, err := v.svc.DescriptionsAt(conceptID, v.t)
+	if err != nil {
+		return nil, err
+	}
+	active := make([]*snomed.Description, 0, len(descs))
+	for _, d := range descs {
+		if d.Active {
+			active = append(active, d)
+		}
+	}
+	if refsetID, ok := v.svc.languageReferenceSet(tags); ok {
+		for _, d := range active {
+			if !d.IsSynonym() {
+				continue
+			}
+			items, err := v.svc.ComponentFromReferenceSetAt(refsetID, d.Id, v.t)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range items {
+				if item.Active && item.GetLanguage().IsPreferred() {
+					return d, nil
+				}
+			}
+		}
+	}
+	return v.svc.simpleLanguageMatch(active, snomed.Synonym, tags)
+}
+func (v dateView) referenceSetItems(refsetID int64, componentID int64) ([]*snomed.ReferenceSetItem, error) {
+	return v.svc.ComponentFromReferenceSetAt(refsetID, componentID, v.t)
+}
+func (v dateView) store() *Svc {
+	return v.svc
+}
+
+// CompareServices reports the differences between two terminology stores, such as two editions.
+func CompareServices(ctx context.Context, from *Svc, to *Svc, opts DiffOptions) (*DiffReport, error) {
+	report, err := compareReleases(ctx, currentView{svc: from}, currentView{svc: to}, opts)
+	if err != nil {
+		return nil, err
+	}
+	report.From = from.path
+	report.To = to.path
+	return report, nil
+}
+
+// CompareDates reports the differences in this store between two dates. The store must have recorded
+// component history, usually by importing a full release, for the comparison to be meaningful.
+func (svc *Svc) CompareDates(ctx context.Context, from time.Time, to time.Time, opts DiffOptions) (*DiffReport, error) {
+	if !svc.History {
+		return nil, fmt.Errorf("cannot compare dates: no component history recorded in %s", svc.path)
+	}
+	report, err := compareReleases(ctx, dateView{svc: svc, t: from}, dateView{svc: svc, t: to}, opts)
+	if err != nil {
+		return nil, err
+	}
+	report.From = from.Format("2006-01-02")
+	report.To = to.Format("2006-01-02")
+	return report, nil
+}
+
+func compareReleases(ctx context.Context, from releaseView, to releaseView, opts DiffOptions) (*DiffReport, error) {
+	report := &DiffReport{
+		Added:         make([]ConceptSummary, 0),
+		Reactivated:   make([]ConceptSummary, 0),
+		Inactivated:   make([]InactivatedConcept, 0),
+		Removed:       make([]ConceptSummary, 0),
+		Moved:         make([]MovedConcept, 0),
+		TermChanged:   make([]TermChange, 0),
+		RefsetChanges: make([]RefsetChange, 0),
+	}
+	ids, err := conceptIDs(ctx, from.store(), to.store())
+	if err != nil {
+		return nil, err
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	work := make(chan int64)
+	errc := make(chan error, 1)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range work {
+				if err := compareConcept(id, from, to, opts.Tags, report, &mu); err != nil {
+					select {
+					case errc <- err:
+					default:
+					}
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+loop:
+	for _, id := range ids {
+		select {
+		case work <- id:
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	close(work)
+	wg.Wait()
+	select {
+	case err := <-errc:
+		return nil, err
+	default:
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, refsetID := range opts.ReferenceSets {
+		rc, err := compareReferenceSet(refsetID, from, to, opts.Tags)
+		if err != nil {
+			return nil, err
+		}
+		report.RefsetChanges = append(report.RefsetChanges, *rc)
+	}
+	sort.Slice(report.Added, func(i, j int) bool { return report.Added[i].ConceptID < report.Added[j].ConceptID })
+	sort.Slice(report.Reactivated, func(i, j int) bool { return report.Reactivated[i].ConceptID < report.Reactivated[j].ConceptID })
+	sort.Slice(report.Inactivated, func(i, j int) bool { return report.Inactivated[i].ConceptID < report.Inactivated[j].ConceptID })
+	sort.Slice(report.Removed, func(i, j int) bool { return report.Removed[i].ConceptID < report.Removed[j].ConceptID })
+	sort.Slice(report.Moved, func(i, j int) bool { return report.Moved[i].ConceptID < report.Moved[j].ConceptID })
+	sort.Slice(report.TermChanged, func(i, j int) bool { return report.TermChanged[i].ConceptID < report.TermChanged[j].ConceptID })
+	return report, nil
+}
+
+// conceptIDs returns the sorted identifiers of all concepts in either of the stores specified
+func conceptIDs(ctx context.Context, stores ...*Svc) ([]int64, error) {
+	seen := make(map[int64]struct{})
+	result := make([]int64, 0)
+	for i, svc := range stores {
+		if i > 0 && svc == stores[0] {
+			continue
+		}
+		err := svc.store.View(func(batch Batch) error {
+			return batch.Iterate(bkConcepts, nil, func(key, value []byte) error {
+				id := int64(binary.BigEndian.Uint64(key[len(key)-8:]))
+				if _, ok := seen[id]; !ok {
+					seen[id] = struct{}{}
+					result = append(result, id)
+				}
+				return ctx.Err()
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result, nil
+}
+
+// optionalConcept returns the concept from the view, or nil if it does not exist
+func optionalConcept(v releaseView, conceptID int64) (*snomed.Concept, error) {
+	c, err := v.concept(conceptID)
+	if err == ErrNotFound {
+		return nil, nil
+	}
+	return c, err
+}
+
+// term returns the preferred term for the concept, or an empty string if there is none
+func term(v releaseView, conceptID int64, tags []language.Tag) string {
+	d, err := v.preferredSynonym(conceptID, tags)
+	if err != nil || d == nil {
+		return ""
+	}
+	return d.Term
+}
+
+func compareConcept(conceptID int64, from releaseView, to releaseView, tags []language.Tag, report *DiffReport, mu *sync.Mutex) error {
+	before, err := optionalConcept(from, conceptID)
+	if err != nil {
+		return err
+	}
+	after, err := optionalConcept(to, conceptID)
+	if err != nil {
+		return err
+	}
+	if after == nil {
+		if before != nil {
+			mu.Lock()
+			report.Removed = append(report.Removed, ConceptSummary{ConceptID: conceptID, Term: term(from, conceptID, tags)})
+			mu.Unlock()
+		}
+		return nil
+	}
+	summary := ConceptSummary{ConceptID: conceptID, Term: term(to, conceptID, tags)}
+	switch {
+	case before == nil && after.Active:
+		mu.Lock()
+		report.Added = append(report.Added, summary)
+		mu.Unlock()
+	case before != nil && !before.Active && after.Active:
+		mu.Lock()
+		report.Reactivated = append(report.Reactivated, summary)
+		mu.Unlock()
+	case before != nil && before.Active && !after.Active:
+		ic, err := inactivation(summary, to, tags)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		report.Inactivated = append(report.Inactivated, *ic)
+		mu.Unlock()
+	case before != nil && before.Active && after.Active:
+		oldParents, err := from.parents(conceptID)
+		if err != nil {
+			return err
+		}
+		newParents, err := to.parents(conceptID)
+		if err != nil {
+			return err
+		}
+		if !sameIdentifiers(oldParents, newParents) {
+			mu.Lock()
+			report.Moved = append(report.Moved, MovedConcept{ConceptSummary: summary, OldParents: oldParents, NewParents: newParents})
+			mu.Unlock()
+		}
+		if oldTerm := term(from, conceptID, tags); oldTerm != summary.Term {
+			mu.Lock()
+			report.TermChanged = append(report.TermChanged, TermChange{ConceptID: conceptID, OldTerm: oldTerm, NewTerm: summary.Term})
+			mu.Unlock()
+		}
+	}
+	return nil
+}
+
+// inactivation determines the reason for inactivation of a concept, and its replacements, if any
+func inactivation(summary ConceptSummary, v releaseView, tags []language.Tag) (*InactivatedConcept, error) {
+	result := &InactivatedConcept{ConceptSummary: summary, Replacements: make([]Replacement, 0)}
+	items, err := v.referenceSetItems(snomed.ConceptInactivationIndicatorReferenceSet, summary.ConceptID)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.Active && item.GetAttributeValue() != nil {
+			reasonID := item.GetAttributeValue().GetValueId()
+			result.Reason = &ConceptSummary{ConceptID: reasonID, Term: term(v, reasonID, tags)}
+		}
+	}
+	for _, association := range replacementReferenceSets {
+		items, err := v.referenceSetItems(association.refsetID, summary.ConceptID)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if item.Active && item.GetAssociation() != nil {
+				targetID := item.GetAssociation().GetTargetComponentId()
+				result.Replacements = append(result.Replacements, Replacement{
+					Association:    association.name,
+					ConceptSummary: ConceptSummary{ConceptID: targetID, Term: term(v, targetID, tags)},
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+// compareReferenceSet reports the changes in active membership of a reference set
+func compareReferenceSet(refsetID int64, from releaseView, to releaseView, tags []language.Tag) (*RefsetChange, error) {
+	result := &RefsetChange{
+		ConceptSummary: ConceptSummary{ConceptID: refsetID, Term: term(to, refsetID, tags)},
+		Added:          make([]int64, 0),
+		Removed:        make([]int64, 0),
+	}
+	candidates := make(map[int64]struct{})
+	for _, svc := range []*Svc{from.store(), to.store()} {
+		components, err := svc.ReferenceSetComponents(refsetID)
+		if err != nil {
+			return nil, err
+		}
+		for id := range components {
+			candidates[id] = struct{}{}
+		}
+	}
+	for id := range candidates {
+		before, err := isActiveMember(from, refsetID, id)
+		if err != nil {
+			return nil, err
+		}
+		after, err := isActiveMember(to, refsetID, id)
+		if err != nil {
+			return nil, err
+		}
+		if after && !before {
+			result.Added = append(result.Added, id)
+		} else if before && !after {
+			result.Removed = append(result.Removed, id)
+		}
+	}
+	sort.Slice(result.Added, func(i, j int) bool { return result.Added[i] < result.Added[j] })
+	sort.Slice(result.Removed, func(i, j int) bool { return result.Removed[i] < result.Removed[j] })
+	return result, nil
+}
+
+func isActiveMember(v releaseView, refsetID int64, componentID int64) (bool, error) {
+	items, err := v.referenceSetItems(refsetID, componentID)
+	if err != nil {
+		return false, err
+	}
+	for _, item := range items {
+		if item.Active {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// sameIdentifiers returns whether the two lists contain the same identifiers, irrespective of order
+func sameIdentifiers(a []int64, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[int64]struct{}, len(a))
+	for _, id := range a {
+		set[id] = struct{}{}
+	}
+	for _, id := range b {
+		if _, ok := set[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
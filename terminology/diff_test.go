@@ -0,0 +1,167 @@
+package terminology
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCompareDates(t *testing.T) {
+	filename := "diff-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	if err := svc.RecordHistory(true); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	t1 := time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2019, 7, 31, 0, 0, 0, 0, time.UTC)
+	d1, d2 := timestamppb.New(t1), timestamppb.New(t2)
+	synonym := int64(snomed.Synonym)
+	root := snomed.Root.Integer()
+	duplicate := int64(900000000000482003)
+	simpleRefset := int64(991411000000109)
+	components := []interface{}{
+		[]*snomed.Concept{
+			{Id: root, EffectiveTime: d1, Active: true},
+			{Id: snomed.IsA, EffectiveTime: d1, Active: true},
+			{Id: 64572001, EffectiveTime: d1, Active: true},
+			{Id: 6118003, EffectiveTime: d1, Active: true},
+			{Id: 24700007, EffectiveTime: d1, Active: true},
+			{Id: 22298006, EffectiveTime: d1, Active: true},
+			{Id: 1755008, EffectiveTime: d1, Active: true},
+			{Id: duplicate, EffectiveTime: d1, Active: true},
+			{Id: 1755008, EffectiveTime: d2, Active: false},
+			{Id: 73211009, EffectiveTime: d2, Active: true},
+		},
+		[]*snomed.Description{
+			{Id: 220309016, ConceptId: root, EffectiveTime: d1, Active: true, Term: "SNOMED CT Concept", TypeId: synonym, LanguageCode: "en"},
+			{Id: 181114011, ConceptId: snomed.IsA, EffectiveTime: d1, Active: true, Term: "Is a", TypeId: synonym, LanguageCode: "en"},
+			{Id: 1230985017, ConceptId: 64572001, EffectiveTime: d1, Active: true, Term: "Disease", TypeId: synonym, LanguageCode: "en"},
+			{Id: 11161017, ConceptId: 6118003, EffectiveTime: d1, Active: true, Term: "Demyelinating disorder", TypeId: synonym, LanguageCode: "en"},
+			{Id: 11161017, ConceptId: 6118003, EffectiveTime: d2, Active: false, Term: "Demyelinating disorder", TypeId: synonym, LanguageCode: "en"},
+			{Id: 2969212016, ConceptId: 6118003, EffectiveTime: d2, Active: true, Term: "Demyelinating disease", TypeId: synonym, LanguageCode: "en"},
+			{Id: 41398015, ConceptId: 24700007, EffectiveTime: d1, Active: true, Term: "Multiple sclerosis", TypeId: synonym, LanguageCode: "en"},
+			{Id: 37436014, ConceptId: 22298006, EffectiveTime: d1, Active: true, Term: "Myocardial infarction", TypeId: synonym, LanguageCode: "en"},
+			{Id: 2868014, ConceptId: 1755008, EffectiveTime: d1, Active: true, Term: "Old myocardial infarction", TypeId: synonym, LanguageCode: "en"},
+			{Id: 900000000000896014, ConceptId: duplicate, EffectiveTime: d1, Active: true, Term: "Duplicate", TypeId: synonym, LanguageCode: "en"},
+			{Id: 121589010, ConceptId: 73211009, EffectiveTime: d2, Active: true, Term: "Diabetes mellitus", TypeId: synonym, LanguageCode: "en"},
+		},
+		[]*snomed.Relationship{
+			{Id: 1, Active: true, EffectiveTime: d1, SourceId: 64572001, DestinationId: root, TypeId: snomed.IsA},
+			{Id: 2, Active: true, EffectiveTime: d1, SourceId: 6118003, DestinationId: 64572001, TypeId: snomed.IsA},
+			{Id: 3, Active: true, EffectiveTime: d1, SourceId: 24700007, DestinationId: 64572001, TypeId: snomed.IsA},
+			{Id: 3, Active: false, EffectiveTime: d2, SourceId: 24700007, DestinationId: 64572001, TypeId: snomed.IsA},
+			{Id: 4, Active: true, EffectiveTime: d2, SourceId: 24700007, DestinationId: 6118003, TypeId: snomed.IsA},
+			{Id: 5, Active: true, EffectiveTime: d1, SourceId: 22298006, DestinationId: 64572001, TypeId: snomed.IsA},
+			{Id: 6, Active: true, EffectiveTime: d1, SourceId: 1755008, DestinationId: 22298006, TypeId: snomed.IsA},
+			{Id: 7, Active: true, EffectiveTime: d2, SourceId: 73211009, DestinationId: 64572001, TypeId: snomed.IsA},
+		},
+		[]*snomed.ReferenceSetItem{
+			{Id: "c7d5f0a4-1c2b-4f3e-9a7a-1b2c3d4e5f60", EffectiveTime: d2, Active: true, RefsetId: snomed.ConceptInactivationIndicatorReferenceSet, ReferencedComponentId: 1755008,
+				Body: &snomed.ReferenceSetItem_AttributeValue{AttributeValue: &snomed.AttributeValueReferenceSet{ValueId: duplicate}}},
+			{Id: "0a1b2c3d-4e5f-4a6b-8c7d-8e9f0a1b2c3d", EffectiveTime: d2, Active: true, RefsetId: snomed.SameAsReferenceSet, ReferencedComponentId: 1755008,
+				Body: &snomed.ReferenceSetItem_Association{Association: &snomed.AssociationReferenceSet{TargetComponentId: 22298006}}},
+			{Id: "5b6c7d8e-9f0a-4b1c-8d2e-3f4a5b6c7d8e", EffectiveTime: d1, Active: true, RefsetId: simpleRefset, ReferencedComponentId: 1755008},
+			{Id: "5b6c7d8e-9f0a-4b1c-8d2e-3f4a5b6c7d8e", EffectiveTime: d2, Active: false, RefsetId: simpleRefset, ReferencedComponentId: 1755008},
+			{Id: "9e8d7c6b-5a4f-4e3d-9c2b-1a0f9e8d7c6b", EffectiveTime: d2, Active: true, RefsetId: simpleRefset, ReferencedComponentId: 73211009},
+		},
+	}
+	for _, c := range components {
+		if err := svc.Put(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	opts := DiffOptions{Tags: []language.Tag{language.BritishEnglish}, ReferenceSets: []int64{simpleRefset}}
+	report, err := svc.CompareDates(ctx, t1, t2, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Added) != 1 || report.Added[0].ConceptID != 73211009 || report.Added[0].Term != "Diabetes mellitus" {
+		t.Fatalf("added concept not reported: %v", report.Added)
+	}
+	if len(report.Inactivated) != 1 || report.Inactivated[0].ConceptID != 1755008 {
+		t.Fatalf("inactivated concept not reported: %v", report.Inactivated)
+	}
+	inactivated := report.Inactivated[0]
+	if inactivated.Reason == nil || inactivated.Reason.ConceptID != duplicate {
+		t.Fatalf("inactivation reason not reported: %v", inactivated.Reason)
+	}
+	if len(inactivated.Replacements) != 1 || inactivated.Replacements[0].ConceptID != 22298006 || inactivated.Replacements[0].Association != "SAME_AS" {
+		t.Fatalf("replacement not reported: %v", inactivated.Replacements)
+	}
+	if len(report.Moved) != 1 || report.Moved[0].ConceptID != 24700007 || report.Moved[0].NewParents[0] != 6118003 {
+		t.Fatalf("moved concept not reported: %v", report.Moved)
+	}
+	if len(report.TermChanged) != 1 || report.TermChanged[0].OldTerm != "Demyelinating disorder" || report.TermChanged[0].NewTerm != "Demyelinating disease" {
+		t.Fatalf("changed term not reported: %v", report.TermChanged)
+	}
+	if len(report.RefsetChanges) != 1 {
+		t.Fatalf("reference set changes not reported: %v", report.RefsetChanges)
+	}
+	rc := report.RefsetChanges[0]
+	if len(rc.Added) != 1 || rc.Added[0] != 73211009 || len(rc.Removed) != 1 || rc.Removed[0] != 1755008 {
+		t.Fatalf("incorrect reference set changes: %v", rc)
+	}
+	if report, err := svc.CompareDates(ctx, t2, t2, opts); err != nil || len(report.Added)+len(report.Inactivated)+len(report.Moved)+len(report.TermChanged) != 0 {
+		t.Fatalf("found differences comparing a release with itself: %v (%v)", report, err)
+	}
+}
+
+func TestCompareServices(t *testing.T) {
+	ctx := context.Background()
+	synonym := int64(snomed.Synonym)
+	root := snomed.Root.Integer()
+	release := func(filename string, active map[int64]bool) *Svc {
+		svc, err := NewService(filename, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var concepts []*snomed.Concept
+		var descriptions []*snomed.Description
+		for conceptID, isActive := range active {
+			concepts = append(concepts, &snomed.Concept{Id: conceptID, Active: isActive})
+			descriptions = append(descriptions, &snomed.Description{Id: conceptID, ConceptId: conceptID, Active: true, Term: "Concept", TypeId: synonym, LanguageCode: "en"})
+		}
+		for _, c := range []interface{}{concepts, descriptions} {
+			if err := svc.Put(ctx, c); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+			t.Fatal(err)
+		}
+		return svc
+	}
+	from := release("diff-from-tests.db", map[int64]bool{root: true, 24700007: false, 22298006: true})
+	defer os.RemoveAll("diff-from-tests.db")
+	defer from.Close()
+	to := release("diff-to-tests.db", map[int64]bool{root: true, 24700007: true, 73211009: true})
+	defer os.RemoveAll("diff-to-tests.db")
+	defer to.Close()
+	report, err := CompareServices(ctx, from, to, DiffOptions{Tags: []language.Tag{language.BritishEnglish}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Added) != 1 || report.Added[0].ConceptID != 73211009 {
+		t.Errorf("added concept not reported: %v", report.Added)
+	}
+	if len(report.Reactivated) != 1 || report.Reactivated[0].ConceptID != 24700007 {
+		t.Errorf("reactivated concept not reported: %v", report.Reactivated)
+	}
+	if len(report.Removed) != 1 || report.Removed[0].ConceptID != 22298006 || report.Removed[0].Term != "Concept" {
+		t.Errorf("removed concept not reported: %v", report.Removed)
+	}
+}
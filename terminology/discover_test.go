@@ -0,0 +1,97 @@
+package terminology
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+)
+
+func TestDiscoverLanguages(t *testing.T) {
+	filename := "languages-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	ctx := context.Background()
+	synonym := int64(snomed.Synonym)
+	preferred := int64(900000000000548007)
+	british := BritishEnglish.LanguageReferenceSetIdentifier()
+	norwegian := int64(61000202103) // not a well-known language reference set
+	dialect := int64(91000202106)   // another reference set for the same language
+	components := []interface{}{
+		[]*snomed.Concept{
+			{Id: 24700007, Active: true},
+		},
+		[]*snomed.Description{
+			{Id: 41398015, ConceptId: 24700007, Active: true, Term: "Multiple sclerosis", TypeId: synonym, LanguageCode: "en"},
+			{Id: 1000001000202111, ConceptId: 24700007, Active: true, Term: "multippel sklerose", TypeId: synonym, LanguageCode: "nb"},
+			{Id: 1000002000202118, ConceptId: 24700007, Active: true, Term: "MS", TypeId: synonym, LanguageCode: "nb"},
+		},
+		[]*snomed.ReferenceSetItem{
+			{Id: "6d8b1b3c-3f1e-4c4b-9e3a-5b0a5d5f2a01", Active: true, RefsetId: british, ReferencedComponentId: 41398015,
+				Body: &snomed.ReferenceSetItem_Language{Language: &snomed.LanguageReferenceSet{AcceptabilityId: preferred}}},
+			{Id: "6d8b1b3c-3f1e-4c4b-9e3a-5b0a5d5f2a02", Active: true, RefsetId: norwegian, ReferencedComponentId: 1000001000202111,
+				Body: &snomed.ReferenceSetItem_Language{Language: &snomed.LanguageReferenceSet{AcceptabilityId: preferred}}},
+			{Id: "6d8b1b3c-3f1e-4c4b-9e3a-5b0a5d5f2a03", Active: true, RefsetId: snomed.RefsetDescriptorReferenceSet, ReferencedComponentId: norwegian,
+				Body: &snomed.ReferenceSetItem_RefsetDescriptor{RefsetDescriptor: &snomed.RefSetDescriptorReferenceSet{AttributeDescriptionId: snomed.AcceptabilityAttribute}}},
+			{Id: "6d8b1b3c-3f1e-4c4b-9e3a-5b0a5d5f2a04", Active: true, RefsetId: dialect, ReferencedComponentId: 1000002000202118,
+				Body: &snomed.ReferenceSetItem_Language{Language: &snomed.LanguageReferenceSet{AcceptabilityId: preferred}}},
+			{Id: "6d8b1b3c-3f1e-4c4b-9e3a-5b0a5d5f2a05", Active: true, RefsetId: snomed.RefsetDescriptorReferenceSet, ReferencedComponentId: dialect,
+				Body: &snomed.ReferenceSetItem_RefsetDescriptor{RefsetDescriptor: &snomed.RefSetDescriptorReferenceSet{AttributeDescriptionId: snomed.AcceptabilityAttribute}}},
+		},
+	}
+	for _, c := range components {
+		if err := svc.Put(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	available, err := svc.AvailableLanguages()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// reference sets for the same language are distinguished by the region of their namespace, and then by identifier
+	nb, nbDialect := language.MustParse("nb-NO"), language.MustParse("nb-NO-x-9")
+	if len(available) != 3 || available[0] != language.BritishEnglish || available[1] != nb || available[2] != nbDialect {
+		t.Fatalf("incorrect available languages: %v", available)
+	}
+	refsets := svc.LanguageReferenceSets()
+	if refsets[norwegian] != nb || refsets[dialect] != nbDialect {
+		t.Fatalf("incorrect languages of reference sets: %v", refsets)
+	}
+	tests := []struct {
+		tags []language.Tag
+		term string
+	}{
+		{[]language.Tag{nb}, "multippel sklerose"},
+		{[]language.Tag{language.Norwegian}, "multippel sklerose"},
+		{[]language.Tag{nbDialect}, "MS"},
+		{[]language.Tag{language.English}, "Multiple sclerosis"},
+	}
+	for _, test := range tests {
+		d, err := svc.PreferredSynonym(24700007, test.tags)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d.Term != test.term {
+			t.Errorf("%v: expected %s, got %s", test.tags, test.term, d.Term)
+		}
+	} // a language configured for a reference set is used instead
+	custom := language.MustParse("nb-x-custom")
+	if err := svc.SetLanguageTag(dialect, custom); err != nil {
+		t.Fatal(err)
+	}
+	if tag := svc.LanguageReferenceSets()[dialect]; tag != custom {
+		t.Errorf("configured language not used: %v", tag)
+	}
+	if d, err := svc.PreferredSynonym(24700007, []language.Tag{custom}); err != nil || d.Term != "MS" {
+		t.Errorf("configured language not matched: %v (%v)", d, err)
+	}
+}
@@ -0,0 +1,503 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/wardle/go-terminology/expression/ecl"
+	"github.com/wardle/go-terminology/snomed"
+)
+
+// ErrInvalidConstraint is the error when an expression constraint cannot be parsed, or uses unsupported features
+var ErrInvalidConstraint = errors.New("invalid expression constraint")
+
+// ExpandConstraint returns the identifiers of the concepts that satisfy an expression in the
+// "Expression Constraint Language" (ECL), such as "<< 24700007 |Multiple sclerosis|".
+// Refinements and dotted attributes are evaluated using the active relationships of each concept.
+// Concrete values (numeric and string comparisons) are not supported.
+// This requires that precomputations have been performed. Expansions are cached, and so the result must not be modified.
+func (svc *Svc) ExpandConstraint(ctx context.Context, s string) (map[int64]struct{}, error) {
+	if result, ok := svc.caches.constraints.get(s); ok {
+		return result.(map[int64]struct{}), nil
+	}
+	el := new(eclErrorListener)
+	is := antlr.NewInputStream(s)
+	lex := ecl.NewECLLexer(is)
+	lex.RemoveErrorListeners() // rather than reporting to the console, and skipping, characters not recognised
+	lex.AddErrorListener(el)
+	tokens := antlr.NewCommonTokenStream(lex, antlr.TokenDefaultChannel)
+	p := ecl.NewECLParser(tokens)
+	p.RemoveErrorListeners()
+	p.AddErrorListener(el)
+	tree := p.Expressionconstraint()
+	if el.err != nil {
+		return nil, el.err
+	}
+	if t := tokens.LT(1); t.GetTokenType() != antlr.TokenEOF { // the rule matches a prefix, ignoring the rest
+		return nil, fmt.Errorf("%w: %d:%d: unexpected '%s'", ErrInvalidConstraint, t.GetLine(), t.GetColumn(), t.GetText())
+	}
+	ex := &expander{ctx: ctx, svc: svc, cache: make(map[antlr.ParserRuleContext]map[int64]struct{})}
+	result := ex.expressionConstraint(tree.(*ecl.ExpressionconstraintContext))
+	if ex.err != nil {
+		return nil, ex.err
+	}
+	svc.caches.constraints.put(s, result)
+	return result, nil
+}
+
+// eclErrorListener records the first syntax error in an expression constraint
+type eclErrorListener struct {
+	*antlr.DefaultErrorListener
+	err error
+}
+
+func (el *eclErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	if el.err == nil {
+		el.err = fmt.Errorf("%w: %d:%d: %s", ErrInvalidConstraint, line, column, msg)
+	}
+}
+
+// expander evaluates a parsed expression constraint, recording the first error encountered
+type expander struct {
+	ctx   context.Context
+	svc   *Svc
+	err   error
+	all   map[int64]struct{}                             // all active concepts, if needed for a wildcard
+	cache map[antlr.ParserRuleContext]map[int64]struct{} // expansions of attribute names and values, evaluated once
+}
+
+func (ex *expander) fail(err error) map[int64]struct{} {
+	if ex.err == nil {
+		ex.err = err
+	}
+	return make(map[int64]struct{})
+}
+
+// expressionConstraint = ws ( refinedExpressionConstraint / compoundExpressionConstraint / dottedExpressionConstraint / subExpressionConstraint ) ws
+func (ex *expander) expressionConstraint(ctx *ecl.ExpressionconstraintContext) map[int64]struct{} {
+	switch {
+	case ctx.Refinedexpressionconstraint() != nil:
+		r := ctx.Refinedexpressionconstraint().(*ecl.RefinedexpressionconstraintContext)
+		return ex.refine(ex.subExpressionConstraint(r.Subexpressionconstraint().(*ecl.SubexpressionconstraintContext)), r.Eclrefinement().(*ecl.EclrefinementContext))
+	case ctx.Compoundexpressionconstraint() != nil:
+		return ex.compoundExpressionConstraint(ctx.Compoundexpressionconstraint().(*ecl.CompoundexpressionconstraintContext))
+	case ctx.Dottedexpressionconstraint() != nil:
+		return ex.dottedExpressionConstraint(ctx.Dottedexpressionconstraint().(*ecl.DottedexpressionconstraintContext))
+	case ctx.Subexpressionconstraint() != nil:
+		return ex.subExpressionConstraint(ctx.Subexpressionconstraint().(*ecl.SubexpressionconstraintContext))
+	}
+	return ex.fail(fmt.Errorf("%w: %s", ErrInvalidConstraint, ctx.GetText()))
+}
+
+// compoundExpressionConstraint = conjunctionExpressionConstraint / disjunctionExpressionConstraint / exclusionExpressionConstraint
+func (ex *expander) compoundExpressionConstraint(ctx *ecl.CompoundexpressionconstraintContext) map[int64]struct{} {
+	switch {
+	case ctx.Conjunctionexpressionconstraint() != nil:
+		var result map[int64]struct{}
+		for i, sub := range ctx.Conjunctionexpressionconstraint().(*ecl.ConjunctionexpressionconstraintContext).AllSubexpressionconstraint() {
+			terms := ex.subExpressionConstraint(sub.(*ecl.SubexpressionconstraintContext))
+			if i == 0 {
+				result = terms
+				continue
+			}
+			for id := range result {
+				if _, ok := terms[id]; !ok {
+					delete(result, id)
+				}
+			}
+		}
+		return result
+	case ctx.Disjunctionexpressionconstraint() != nil:
+		result := make(map[int64]struct{})
+		for _, sub := range ctx.Disjunctionexpressionconstraint().(*ecl.DisjunctionexpressionconstraintContext).AllSubexpressionconstraint() {
+			for id := range ex.subExpressionConstraint(sub.(*ecl.SubexpressionconstraintContext)) {
+				result[id] = struct{}{}
+			}
+		}
+		return result
+	case ctx.Exclusionexpressionconstraint() != nil:
+		subs := ctx.Exclusionexpressionconstraint().(*ecl.ExclusionexpressionconstraintContext).AllSubexpressionconstraint()
+		result := ex.subExpressionConstraint(subs[0].(*ecl.SubexpressionconstraintContext))
+		for id := range ex.subExpressionConstraint(subs[1].(*ecl.SubexpressionconstraintContext)) {
+			delete(result, id)
+		}
+		return result
+	}
+	return ex.fail(fmt.Errorf("%w: invalid compound expression constraint: %s", ErrInvalidConstraint, ctx.GetText()))
+}
+
+// dottedExpressionConstraint = subExpressionConstraint 1*(ws dottedExpressionAttribute)
+// The result is the set of values of the attributes of the concepts specified.
+func (ex *expander) dottedExpressionConstraint(ctx *ecl.DottedexpressionconstraintContext) map[int64]struct{} {
+	result := ex.subExpressionConstraint(ctx.Subexpressionconstraint().(*ecl.SubexpressionconstraintContext))
+	for _, attr := range ctx.AllDottedexpressionattribute() {
+		name := attr.(*ecl.DottedexpressionattributeContext).Eclattributename().(*ecl.EclattributenameContext)
+		types := ex.cached(name, func() map[int64]struct{} {
+			return ex.subExpressionConstraint(name.Subexpressionconstraint().(*ecl.SubexpressionconstraintContext))
+		})
+		values := make(map[int64]struct{})
+		for conceptID := range result {
+			rels, err := ex.svc.ParentRelationships(conceptID)
+			if err != nil {
+				return ex.fail(err)
+			}
+			for _, rel := range rels {
+				if _, ok := types[rel.TypeId]; ok && rel.Active {
+					values[rel.DestinationId] = struct{}{}
+				}
+			}
+		}
+		result = values
+	}
+	return result
+}
+
+// subExpressionConstraint = [constraintOperator ws] [memberOf ws] (eclFocusConcept / "(" ws expressionConstraint ws ")")
+func (ex *expander) subExpressionConstraint(ctx *ecl.SubexpressionconstraintContext) map[int64]struct{} {
+	var focus map[int64]struct{}
+	switch {
+	case ctx.Eclfocusconcept() != nil:
+		fc := ctx.Eclfocusconcept().(*ecl.EclfocusconceptContext)
+		if fc.Wildcard() != nil {
+			if ctx.Memberof() != nil {
+				return ex.allMembers()
+			}
+			return ex.allConcepts() // any concept, irrespective of the constraint operator
+		}
+		conceptID, err := strconv.ParseInt(fc.Eclconceptreference().(*ecl.EclconceptreferenceContext).Conceptid().GetText(), 10, 64)
+		if err != nil {
+			return ex.fail(err)
+		}
+		focus = map[int64]struct{}{conceptID: {}}
+	case ctx.Expressionconstraint() != nil:
+		focus = ex.expressionConstraint(ctx.Expressionconstraint().(*ecl.ExpressionconstraintContext))
+	default:
+		return ex.fail(fmt.Errorf("%w: invalid subexpression: %s", ErrInvalidConstraint, ctx.GetText()))
+	}
+	if ctx.Memberof() != nil {
+		members := make(map[int64]struct{})
+		for refsetID := range focus {
+			components, err := ex.svc.ReferenceSetComponents(refsetID)
+			if err != nil {
+				return ex.fail(err)
+			}
+			for id := range components {
+				members[id] = struct{}{}
+			}
+		}
+		focus = members
+	}
+	if ctx.Constraintoperator() == nil {
+		return focus
+	}
+	op := ctx.Constraintoperator().(*ecl.ConstraintoperatorContext)
+	result := make(map[int64]struct{})
+	for conceptID := range focus {
+		var err error
+		switch {
+		case op.Descendantorselfof() != nil:
+			result[conceptID] = struct{}{}
+			err = ex.descendants(conceptID, result)
+		case op.Descendantof() != nil:
+			err = ex.descendants(conceptID, result)
+		case op.Childof() != nil:
+			err = ex.related(ex.svc.Children, conceptID, result)
+		case op.Ancestororselfof() != nil:
+			result[conceptID] = struct{}{}
+			err = ex.related(ex.svc.AllParentIDs, conceptID, result)
+		case op.Ancestorof() != nil:
+			err = ex.related(ex.svc.AllParentIDs, conceptID, result)
+		case op.Parentof() != nil:
+			err = ex.related(ex.svc.Parents, conceptID, result)
+		default:
+			err = fmt.Errorf("%w: unsupported constraint operator: %s", ErrInvalidConstraint, op.GetText())
+		}
+		if err != nil {
+			return ex.fail(err)
+		}
+	}
+	return result
+}
+
+// related adds the concepts related to the concept specified, using the function specified, to the result
+func (ex *expander) related(f func(int64) ([]int64, error), conceptID int64, result map[int64]struct{}) error {
+	ids, err := f(conceptID)
+	for _, id := range ids {
+		result[id] = struct{}{}
+	}
+	return err
+}
+
+// descendants adds all of the descendants of the concept specified to the result
+func (ex *expander) descendants(conceptID int64, result map[int64]struct{}) error {
+	work := []int64{conceptID}
+	for len(work) > 0 {
+		if err := ex.ctx.Err(); err != nil {
+			return err
+		}
+		children, err := ex.svc.Children(work[len(work)-1])
+		if err != nil {
+			return err
+		}
+		work = work[:len(work)-1]
+		for _, child := range children {
+			if _, done := result[child]; !done {
+				result[child] = struct{}{}
+				work = append(work, child)
+			}
+		}
+	}
+	return nil
+}
+
+// allConcepts returns all active concepts
+func (ex *expander) allConcepts() map[int64]struct{} {
+	if ex.all == nil {
+		ex.all = make(map[int64]struct{})
+		ctx, cancel := context.WithCancel(ex.ctx)
+		defer cancel()
+		for c := range ex.svc.IterateConcepts(ctx) {
+			if c.Err != nil {
+				return ex.fail(c.Err)
+			}
+			if c.Active {
+				ex.all[c.Id] = struct{}{}
+			}
+		}
+	}
+	result := make(map[int64]struct{}, len(ex.all))
+	for id := range ex.all {
+		result[id] = struct{}{}
+	}
+	return result
+}
+
+// allMembers returns the members of any installed reference set
+func (ex *expander) allMembers() map[int64]struct{} {
+	refsets, err := ex.svc.InstalledReferenceSets()
+	if err != nil {
+		return ex.fail(err)
+	}
+	result := make(map[int64]struct{})
+	for refsetID := range refsets {
+		components, err := ex.svc.ReferenceSetComponents(refsetID)
+		if err != nil {
+			return ex.fail(err)
+		}
+		for id := range components {
+			result[id] = struct{}{}
+		}
+	}
+	return result
+}
+
+// cached returns the expansion of a part of the expression, evaluating it only once
+func (ex *expander) cached(ctx antlr.ParserRuleContext, f func() map[int64]struct{}) map[int64]struct{} {
+	if result, ok := ex.cache[ctx]; ok {
+		return result
+	}
+	result := f()
+	ex.cache[ctx] = result
+	return result
+}
+
+// refine returns those concepts that satisfy the refinement
+func (ex *expander) refine(concepts map[int64]struct{}, ctx *ecl.EclrefinementContext) map[int64]struct{} {
+	result := make(map[int64]struct{})
+	for conceptID := range concepts {
+		outgoing, err := ex.svc.ParentRelationships(conceptID)
+		if err != nil {
+			return ex.fail(err)
+		}
+		incoming, err := ex.svc.ChildRelationships(conceptID)
+		if err != nil {
+			return ex.fail(err)
+		}
+		if ex.refinement(ctx, &relationships{outgoing: active(outgoing), incoming: active(incoming)}) {
+			result[conceptID] = struct{}{}
+		}
+		if ex.err != nil {
+			return result
+		}
+	}
+	return result
+}
+
+// relationships are the active relationships of a concept within the scope of a refinement, either all
+// relationships or those of a single relationship group
+type relationships struct {
+	outgoing []*snomed.Relationship // relationships in which the concept is the source
+	incoming []*snomed.Relationship // relationships in which the concept is the destination
+}
+
+func active(rels []*snomed.Relationship) []*snomed.Relationship {
+	result := make([]*snomed.Relationship, 0, len(rels))
+	for _, rel := range rels {
+		if rel.Active {
+			result = append(result, rel)
+		}
+	}
+	return result
+}
+
+// eclRefinement = subRefinement ws [conjunctionRefinementSet / disjunctionRefinementSet]
+func (ex *expander) refinement(ctx *ecl.EclrefinementContext, rels *relationships) bool {
+	result := ex.subRefinement(ctx.Subrefinement().(*ecl.SubrefinementContext), rels)
+	if c := ctx.Conjunctionrefinementset(); c != nil {
+		for _, sub := range c.(*ecl.ConjunctionrefinementsetContext).AllSubrefinement() {
+			result = result && ex.subRefinement(sub.(*ecl.SubrefinementContext), rels)
+		}
+	}
+	if d := ctx.Disjunctionrefinementset(); d != nil {
+		for _, sub := range d.(*ecl.DisjunctionrefinementsetContext).AllSubrefinement() {
+			result = result || ex.subRefinement(sub.(*ecl.SubrefinementContext), rels)
+		}
+	}
+	return result
+}
+
+// subRefinement = eclAttributeSet / eclAttributeGroup / "(" ws eclRefinement ws ")"
+func (ex *expander) subRefinement(ctx *ecl.SubrefinementContext, rels *relationships) bool {
+	switch {
+	case ctx.Eclattributeset() != nil:
+		return ex.attributeSet(ctx.Eclattributeset().(*ecl.EclattributesetContext), rels)
+	case ctx.Eclattributegroup() != nil:
+		return ex.attributeGroup(ctx.Eclattributegroup().(*ecl.EclattributegroupContext), rels)
+	case ctx.Eclrefinement() != nil:
+		return ex.refinement(ctx.Eclrefinement().(*ecl.EclrefinementContext), rels)
+	}
+	ex.fail(fmt.Errorf("%w: invalid refinement: %s", ErrInvalidConstraint, ctx.GetText()))
+	return false
+}
+
+// eclAttributeGroup = ["[" cardinality "]" ws] "{" ws eclAttributeSet ws "}"
+// The attribute set must be satisfied by the relationships within a single group, by the number of groups specified.
+func (ex *expander) attributeGroup(ctx *ecl.EclattributegroupContext, rels *relationships) bool {
+	groups := make(map[int64]*relationships)
+	for _, rel := range rels.outgoing {
+		if rel.RelationshipGroup == 0 && rel.TypeId == snomed.IsA {
+			continue
+		}
+		g, ok := groups[rel.RelationshipGroup]
+		if !ok {
+			g = &relationships{incoming: rels.incoming}
+			groups[rel.RelationshipGroup] = g
+		}
+		g.outgoing = append(g.outgoing, rel)
+	}
+	count := 0
+	for _, g := range groups {
+		if ex.attributeSet(ctx.Eclattributeset().(*ecl.EclattributesetContext), g) {
+			count++
+		}
+	}
+	return ex.inCardinality(ctx.Cardinality(), count)
+}
+
+// eclAttributeSet = subAttributeSet ws [conjunctionAttributeSet / disjunctionAttributeSet]
+func (ex *expander) attributeSet(ctx *ecl.EclattributesetContext, rels *relationships) bool {
+	result := ex.subAttributeSet(ctx.Subattributeset().(*ecl.SubattributesetContext), rels)
+	if c := ctx.Conjunctionattributeset(); c != nil {
+		for _, sub := range c.(*ecl.ConjunctionattributesetContext).AllSubattributeset() {
+			result = result && ex.subAttributeSet(sub.(*ecl.SubattributesetContext), rels)
+		}
+	}
+	if d := ctx.Disjunctionattributeset(); d != nil {
+		for _, sub := range d.(*ecl.DisjunctionattributesetContext).AllSubattributeset() {
+			result = result || ex.subAttributeSet(sub.(*ecl.SubattributesetContext), rels)
+		}
+	}
+	return result
+}
+
+// subAttributeSet = eclAttribute / "(" ws eclAttributeSet ws ")"
+func (ex *expander) subAttributeSet(ctx *ecl.SubattributesetContext, rels *relationships) bool {
+	if ctx.Eclattribute() != nil {
+		return ex.attribute(ctx.Eclattribute().(*ecl.EclattributeContext), rels)
+	}
+	return ex.attributeSet(ctx.Eclattributeset().(*ecl.EclattributesetContext), rels)
+}
+
+// eclAttribute = ["[" cardinality "]" ws] [reverseFlag ws] eclAttributeName ws (expressionComparisonOperator ws subExpressionConstraint / ...)
+// An attribute is satisfied if the number of relationships of the type named with a matching value is within the
+// cardinality specified, or, by default, if there is at least one.
+func (ex *expander) attribute(ctx *ecl.EclattributeContext, rels *relationships) bool {
+	if ctx.Expressioncomparisonoperator() == nil {
+		ex.fail(fmt.Errorf("%w: concrete values not supported: %s", ErrInvalidConstraint, ctx.GetText()))
+		return false
+	}
+	name := ctx.Eclattributename().(*ecl.EclattributenameContext)
+	types := ex.cached(name, func() map[int64]struct{} {
+		return ex.subExpressionConstraint(name.Subexpressionconstraint().(*ecl.SubexpressionconstraintContext))
+	})
+	value := ctx.Subexpressionconstraint().(*ecl.SubexpressionconstraintContext)
+	values := ex.cached(value, func() map[int64]struct{} {
+		return ex.subExpressionConstraint(value)
+	})
+	equals := ctx.Expressioncomparisonoperator().(*ecl.ExpressioncomparisonoperatorContext).EXCLAMATION() == nil
+	candidates, reverse := rels.outgoing, ctx.Reverseflag() != nil
+	if reverse {
+		candidates = rels.incoming
+	}
+	count := 0
+	for _, rel := range candidates {
+		target := rel.DestinationId
+		if reverse {
+			target = rel.SourceId
+		}
+		if _, ok := types[rel.TypeId]; !ok {
+			continue
+		}
+		if _, ok := values[target]; ok == equals {
+			count++
+		}
+	}
+	if ctx.Cardinality() == nil {
+		return count > 0
+	}
+	return ex.inCardinality(ctx.Cardinality(), count)
+}
+
+// inCardinality returns whether the count is within the cardinality specified, or at least one if not specified
+// cardinality = minValue to maxValue
+func (ex *expander) inCardinality(card ecl.ICardinalityContext, count int) bool {
+	if card == nil {
+		return count > 0
+	}
+	c := card.(*ecl.CardinalityContext)
+	min, err := strconv.Atoi(c.Minvalue().GetText())
+	if err != nil {
+		ex.fail(fmt.Errorf("%w: invalid cardinality: %s", ErrInvalidConstraint, c.GetText()))
+		return false
+	}
+	if count < min {
+		return false
+	}
+	if max := c.Maxvalue().(*ecl.MaxvalueContext); max.Many() == nil {
+		n, err := strconv.Atoi(max.GetText())
+		if err != nil {
+			ex.fail(fmt.Errorf("%w: invalid cardinality: %s", ErrInvalidConstraint, c.GetText()))
+			return false
+		}
+		return count <= n
+	}
+	return true
+}
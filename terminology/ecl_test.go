@@ -0,0 +1,150 @@
+package terminology
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+)
+
+const (
+	findingSite       = 363698007
+	multipleSclerosis = 24700007
+	demyelinatingDis  = 6118003
+	myocardialInfarct = 22298006
+	cnsStructure      = 21483005
+	heartStructure    = 80891009
+	clinicalFinding   = 404684003
+	bodyStructure     = 123037004
+	modelComponent    = 900000000000441003
+	relapsingMS       = 426373005
+	simpleRefset      = 991381000000107
+)
+
+// setUpHierarchy creates a small database, with a hierarchy of concepts, relationships and a reference set
+func setUpHierarchy(tb testing.TB, filename string) *Svc {
+	svc, err := NewService(filename, false)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	ctx := context.Background()
+	parents := map[int64]int64{
+		modelComponent:        snomed.Root.Integer(),
+		snomed.IsA:            modelComponent,
+		findingSite:           modelComponent,
+		int64(snomed.Synonym): modelComponent,
+		clinicalFinding:       snomed.Root.Integer(),
+		demyelinatingDis:      clinicalFinding,
+		multipleSclerosis:     demyelinatingDis,
+		relapsingMS:           multipleSclerosis,
+		myocardialInfarct:     clinicalFinding,
+		bodyStructure:         snomed.Root.Integer(),
+		cnsStructure:          bodyStructure,
+		heartStructure:        bodyStructure,
+		simpleRefset:          modelComponent,
+	}
+	sites := map[int64]int64{multipleSclerosis: cnsStructure, relapsingMS: cnsStructure, myocardialInfarct: heartStructure}
+	concepts := []*snomed.Concept{{Id: snomed.Root.Integer(), Active: true}}
+	descriptions := []*snomed.Description{{Id: 1, ConceptId: snomed.Root.Integer(), Active: true, TypeId: int64(snomed.Synonym), Term: "SNOMED CT Concept", LanguageCode: "en"}}
+	var relationships []*snomed.Relationship
+	for conceptID, parentID := range parents {
+		concepts = append(concepts, &snomed.Concept{Id: conceptID, Active: true})
+		n := int64(len(concepts))
+		descriptions = append(descriptions, &snomed.Description{Id: n, ConceptId: conceptID, Active: true, TypeId: int64(snomed.Synonym), Term: "Concept " + strconv.FormatInt(conceptID, 10), LanguageCode: "en"})
+		relationships = append(relationships, &snomed.Relationship{Id: n, SourceId: conceptID, TypeId: snomed.IsA, DestinationId: parentID, Active: true})
+		if site, ok := sites[conceptID]; ok {
+			relationships = append(relationships, &snomed.Relationship{Id: n + 1000, SourceId: conceptID, TypeId: findingSite, DestinationId: site, RelationshipGroup: 1, Active: true})
+		}
+	}
+	items := []*snomed.ReferenceSetItem{{Id: "2d0b9d58-3d1f-4d5e-8d1c-000000000001", RefsetId: simpleRefset, ReferencedComponentId: multipleSclerosis, Active: true,
+		Body: &snomed.ReferenceSetItem_Simple{Simple: &snomed.SimpleReferenceSet{}}}}
+	for _, c := range []interface{}{concepts, descriptions, relationships, items} {
+		if err := svc.Put(ctx, c); err != nil {
+			tb.Fatal(err)
+		}
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		tb.Fatal(err)
+	}
+	return svc
+}
+
+func TestExpandConstraint(t *testing.T) {
+	filename := "ecl-tests.db"
+	svc := setUpHierarchy(t, filename)
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	tests := []struct {
+		ecl      string
+		expected []int64
+	}{
+		{"24700007 |Multiple sclerosis|", []int64{multipleSclerosis}},
+		{"< 6118003", []int64{multipleSclerosis, relapsingMS}},
+		{"<< 6118003", []int64{demyelinatingDis, multipleSclerosis, relapsingMS}},
+		{"<! 404684003", []int64{demyelinatingDis, myocardialInfarct}},
+		{">! 24700007", []int64{demyelinatingDis}},
+		{"> 24700007", []int64{demyelinatingDis, clinicalFinding, snomed.Root.Integer()}},
+		{"^ 991381000000107", []int64{multipleSclerosis}},
+		{"<< 6118003 MINUS << 24700007", []int64{demyelinatingDis}},
+		{"<! 404684003 OR <! 123037004", []int64{demyelinatingDis, myocardialInfarct, cnsStructure, heartStructure}},
+		{"< 404684003 AND ^ 991381000000107", []int64{multipleSclerosis}},
+		{"< 404684003 : 363698007 = << 123037004", []int64{multipleSclerosis, relapsingMS, myocardialInfarct}},
+		{"< 404684003 : 363698007 = 80891009", []int64{myocardialInfarct}},
+		{"< 404684003 : 363698007 != 80891009", []int64{multipleSclerosis, relapsingMS}},
+		{"< 404684003 : { 363698007 = 21483005 }", []int64{multipleSclerosis, relapsingMS}},
+		{"< 404684003 : [0..0] 363698007 = *", []int64{demyelinatingDis}},
+		{"* : R 363698007 = 22298006", []int64{heartStructure}},
+		{"<< 6118003 . 363698007", []int64{cnsStructure}},
+	}
+	ctx := context.Background()
+	for _, test := range tests {
+		result, err := svc.ExpandConstraint(ctx, test.ecl)
+		if err != nil {
+			t.Fatalf("%s: %s", test.ecl, err)
+		}
+		if len(result) != len(test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.ecl, test.expected, result)
+			continue
+		}
+		for _, id := range test.expected {
+			if _, ok := result[id]; !ok {
+				t.Errorf("%s: expected %v, got %v", test.ecl, test.expected, result)
+			}
+		}
+	}
+	if _, err := svc.ExpandConstraint(ctx, "wibble"); err == nil {
+		t.Error("failed to identify syntax error")
+	}
+	for _, invalid := range []string{"<< 6118\x01003", "<< 6118003 §"} { // a character not recognised, and text not parsed
+		if _, err := svc.ExpandConstraint(ctx, invalid); !errors.Is(err, ErrInvalidConstraint) {
+			t.Errorf("%q: failed to identify invalid constraint: %v", invalid, err)
+		}
+	}
+}
+
+func TestSearchConstraint(t *testing.T) {
+	filename := "ecl-search-tests.db"
+	svc := setUpHierarchy(t, filename)
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	ctx := context.Background()
+	tags := []language.Tag{language.BritishEnglish}
+	response, err := svc.Search(ctx, &snomed.SearchRequest{S: "concept", Constraint: "<< 6118003 |Demyelinating disease|", MaximumHits: 10}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := make(map[int64]struct{})
+	for _, item := range response.Items {
+		found[item.ConceptId] = struct{}{}
+	}
+	if len(found) != 3 || len(response.Items) != 3 {
+		t.Fatalf("expected only the three concepts satisfying the constraint, got %v", response.Items)
+	}
+	if _, err := svc.Search(ctx, &snomed.SearchRequest{S: "concept", Constraint: "wibble"}, tags); !errors.Is(err, ErrInvalidConstraint) {
+		t.Fatalf("expected invalid constraint, got %v", err)
+	}
+}
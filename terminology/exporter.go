@@ -25,6 +25,7 @@ import (
 
 	"github.com/gogo/protobuf/io"
 	"golang.org/x/text/language"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/wardle/go-terminology/snomed"
 )
@@ -39,8 +40,13 @@ func (svc *Svc) Export(lang string) error {
 	}
 	count := 0
 	start := time.Now()
-	eds := svc.iterateExtendedDescriptions(context.Background(), tags)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	eds := svc.iterateExtendedDescriptions(ctx, tags)
 	for ed := range eds {
+		if ed.Err != nil {
+			return ed.Err
+		}
 		w.WriteMsg(ed)
 		count++
 		if count%10000 == 0 {
@@ -81,31 +87,52 @@ func (svc *Svc) iterateExtendedDescriptions(ctx context.Context, tags []language
 }
 
 func (svc *Svc) makeExtendedDescriptions(ctx context.Context, concept *snomed.Concept, tags []language.Tag, resultc chan<- ExtendedDescriptionStream) {
+	eds, err := svc.extendedDescriptions(concept, tags)
+	if err != nil {
+		select {
+		case <-ctx.Done():
+		case resultc <- ExtendedDescriptionStream{Err: err}:
+		}
+		return
+	}
+	for _, ed := range eds {
+		select {
+		case <-ctx.Done():
+			return
+		case resultc <- ExtendedDescriptionStream{ExtendedDescription: ed}:
+		}
+	}
+}
+
+// extendedDescriptions returns the extended descriptions for all of the descriptions of the specified concept
+func (svc *Svc) extendedDescriptions(concept *snomed.Concept, tags []language.Tag) ([]*snomed.ExtendedDescription, error) {
 	ed := snomed.ExtendedDescription{}
 	err := initialiseExtendedFromConcept(svc, &ed, concept, tags)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	descs, err := svc.Descriptions(concept.Id)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
+	result := make([]*snomed.ExtendedDescription, 0, len(descs))
 	for _, d := range descs {
-		ded := ed // make a copy
-		if err = initialiseExtendedFromDescription(svc, &ded, d); err != nil {
-			panic(err)
-		}
-		select {
-		case <-ctx.Done():
-			return
-		case resultc <- ExtendedDescriptionStream{ExtendedDescription: &ded}:
+		ded := proto.Clone(&ed).(*snomed.ExtendedDescription)
+		if err = initialiseExtendedFromDescription(svc, ded, d); err != nil {
+			return nil, err
 		}
+		result = append(result, ded)
 	}
+	return result, nil
 }
 
 func initialiseExtendedFromConcept(svc *Svc, ed *snomed.ExtendedDescription, c *snomed.Concept, tags []language.Tag) error {
 	ed.Concept = c
-	ed.PreferredDescription = svc.MustGetPreferredSynonym(c.Id, tags)
+	preferred, err := svc.PreferredSynonym(c.Id, tags)
+	if err != nil {
+		return fmt.Errorf("could not determine preferred synonym for concept %d : %s", c.Id, err)
+	}
+	ed.PreferredDescription = preferred
 	allParents, err := svc.AllParentIDs(c.Id)
 	if err != nil {
 		return err
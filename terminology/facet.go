@@ -0,0 +1,175 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"sort"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+)
+
+// maxFacetCandidates is the maximum number of the most relevant results of a search from which facets are counted
+const maxFacetCandidates = 1000
+
+// topLevel are the top-level concepts of each hierarchy
+var topLevel = make(map[int64]struct{}, len(snomed.TopLevel))
+
+func init() {
+	for _, conceptID := range snomed.TopLevel {
+		topLevel[conceptID] = struct{}{}
+	}
+}
+
+// SemanticTag returns the semantic tag of the fully specified name of the concept, such as "disorder",
+// using the language preferences specified, or an empty string if the concept has no fully specified name.
+func (svc *Svc) SemanticTag(conceptID int64, tags []language.Tag) (string, error) {
+	descs, err := svc.Descriptions(conceptID)
+	if err != nil {
+		return "", err
+	}
+	for _, d := range descs {
+		if d.IsFullySpecifiedName() {
+			fsn, err := svc.languageMatch(descs, snomed.FullySpecifiedName, tags)
+			if err != nil {
+				return "", err
+			}
+			return fsn.SemanticTag(), nil
+		}
+	}
+	return "", nil
+}
+
+// Hierarchies returns the top-level concepts of the hierarchies to which the concept belongs
+func (svc *Svc) Hierarchies(conceptID int64) ([]int64, error) {
+	if _, ok := topLevel[conceptID]; ok {
+		return []int64{conceptID}, nil
+	}
+	parents, err := svc.AllParentIDs(conceptID)
+	if err != nil {
+		return nil, err
+	}
+	var result []int64
+	for _, parentID := range parents {
+		if _, ok := topLevel[parentID]; ok {
+			result = append(result, parentID)
+		}
+	}
+	return result, nil
+}
+
+// facetCounter counts distinct concepts by top-level hierarchy, semantic tag and reference set
+type facetCounter struct {
+	svc          *Svc
+	tags         []language.Tag
+	seen         map[int64]struct{}
+	hierarchies  map[int64]int
+	semanticTags map[string]int
+	refsets      map[int64]int
+}
+
+func (svc *Svc) newFacetCounter(tags []language.Tag) *facetCounter {
+	return &facetCounter{
+		svc:          svc,
+		tags:         tags,
+		seen:         make(map[int64]struct{}),
+		hierarchies:  make(map[int64]int),
+		semanticTags: make(map[string]int),
+		refsets:      make(map[int64]int),
+	}
+}
+
+// add counts the concept specified, unless already counted
+func (fc *facetCounter) add(conceptID int64) error {
+	if _, done := fc.seen[conceptID]; done {
+		return nil
+	}
+	fc.seen[conceptID] = struct{}{}
+	hierarchies, err := fc.svc.Hierarchies(conceptID)
+	if err != nil {
+		return err
+	}
+	for _, id := range hierarchies {
+		fc.hierarchies[id]++
+	}
+	tag, err := fc.svc.SemanticTag(conceptID, fc.tags)
+	if err != nil {
+		return err
+	}
+	if tag != "" {
+		fc.semanticTags[tag]++
+	}
+	refsets, err := fc.svc.ComponentReferenceSets(conceptID)
+	if err != nil {
+		return err
+	}
+	for _, id := range refsets {
+		fc.refsets[id]++
+	}
+	return nil
+}
+
+// addTo adds the facets counted to the search response
+func (fc *facetCounter) addTo(response *snomed.SearchResponse) error {
+	var err error
+	if response.Hierarchies, err = fc.conceptFacets(fc.hierarchies); err != nil {
+		return err
+	}
+	if response.Refsets, err = fc.conceptFacets(fc.refsets); err != nil {
+		return err
+	}
+	response.SemanticTags = make([]*snomed.SearchResponse_Facet, 0, len(fc.semanticTags))
+	for tag, count := range fc.semanticTags {
+		response.SemanticTags = append(response.SemanticTags, &snomed.SearchResponse_Facet{Value: tag, Count: int32(count)})
+	}
+	sortFacets(response.SemanticTags)
+	return nil
+}
+
+// conceptFacets returns the facets for the counts of concepts specified, with the preferred synonym of each
+func (fc *facetCounter) conceptFacets(counts map[int64]int) ([]*snomed.SearchResponse_Facet, error) {
+	result := make([]*snomed.SearchResponse_Facet, 0, len(counts))
+	for id, count := range counts {
+		facet := &snomed.SearchResponse_Facet{Id: id, Count: int32(count)}
+		descs, err := fc.svc.Descriptions(id)
+		if err != nil {
+			return nil, err
+		}
+		if len(descs) > 0 { // e.g. a reference set without a concept in this distribution has no descriptions
+			d, err := fc.svc.PreferredSynonym(id, fc.tags)
+			if err != nil {
+				return nil, err
+			}
+			facet.Value = d.Term
+		}
+		result = append(result, facet)
+	}
+	sortFacets(result)
+	return result, nil
+}
+
+// sortFacets sorts facets so that those with most concepts are first
+func sortFacets(facets []*snomed.SearchResponse_Facet) {
+	sort.Slice(facets, func(i, j int) bool {
+		if facets[i].Count != facets[j].Count {
+			return facets[i].Count > facets[j].Count
+		}
+		if facets[i].Value != facets[j].Value {
+			return facets[i].Value < facets[j].Value
+		}
+		return facets[i].Id < facets[j].Id
+	})
+}
@@ -0,0 +1,141 @@
+package terminology
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+)
+
+func TestFacets(t *testing.T) {
+	filename := "facet-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	ctx := context.Background()
+	root, refset := snomed.Root.Integer(), int64(991411000000109)
+	finding, procedure, bodyStructure := int64(snomed.ClinicalFinding), int64(snomed.Procedure), int64(snomed.BodyStructure)
+	fsns := map[int64]string{
+		root:          "SNOMED CT Concept (SNOMED RT+CTV3)",
+		finding:       "Clinical finding (finding)",
+		procedure:     "Procedure (procedure)",
+		bodyStructure: "Body structure (body structure)",
+		71620000:      "Fracture of femur (disorder)",
+		263225007:     "Fracture of neck of femur (disorder)",
+		125605004:     "Fracture of bone (disorder)",
+		19031009:      "Fracture reduction (procedure)",
+		72704001:      "Fracture (morphologic abnormality)",
+	}
+	parents := map[int64]int64{
+		finding:       root,
+		procedure:     root,
+		bodyStructure: root,
+		71620000:      finding,
+		263225007:     71620000,
+		125605004:     finding,
+		19031009:      procedure,
+		72704001:      bodyStructure,
+	}
+	var concepts []*snomed.Concept
+	var descriptions []*snomed.Description
+	var relationships []*snomed.Relationship
+	for conceptID, fsn := range fsns {
+		concepts = append(concepts, &snomed.Concept{Id: conceptID, Active: true})
+		descriptions = append(descriptions,
+			&snomed.Description{Id: conceptID*10 + 1, ConceptId: conceptID, Active: true, TypeId: int64(snomed.FullySpecifiedName), Term: fsn, LanguageCode: "en"},
+			&snomed.Description{Id: conceptID*10 + 2, ConceptId: conceptID, Active: true, TypeId: int64(snomed.Synonym), Term: strings.TrimSpace(fsn[:strings.LastIndex(fsn, "(")]), LanguageCode: "en"})
+		if parentID, ok := parents[conceptID]; ok {
+			relationships = append(relationships, &snomed.Relationship{Id: conceptID, SourceId: conceptID, TypeId: snomed.IsA, DestinationId: parentID, Active: true})
+		}
+	}
+	items := []*snomed.ReferenceSetItem{
+		{Id: "8c5a3d2e-8c4b-4a0e-9a53-000000000001", RefsetId: refset, ReferencedComponentId: 71620000, Active: true, Body: &snomed.ReferenceSetItem_Simple{Simple: &snomed.SimpleReferenceSet{}}},
+		{Id: "8c5a3d2e-8c4b-4a0e-9a53-000000000002", RefsetId: refset, ReferencedComponentId: 263225007, Active: true, Body: &snomed.ReferenceSetItem_Simple{Simple: &snomed.SimpleReferenceSet{}}},
+	}
+	for _, c := range []interface{}{concepts, descriptions, relationships, items} {
+		if err := svc.Put(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	tags := []language.Tag{language.BritishEnglish}
+	if tag, err := svc.SemanticTag(263225007, tags); err != nil || tag != "disorder" {
+		t.Errorf("expected semantic tag 'disorder', got '%s' (%v)", tag, err)
+	}
+	if hierarchies, err := svc.Hierarchies(263225007); err != nil || len(hierarchies) != 1 || hierarchies[0] != finding {
+		t.Errorf("expected hierarchy %d, got %v (%v)", finding, hierarchies, err)
+	}
+
+	response, err := svc.Search(ctx, &snomed.SearchRequest{S: "fracture", Facets: true}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Items) != 5 {
+		t.Fatalf("expected 5 results, got %v", response.Items)
+	}
+	checkFacets(t, "hierarchies", response.Hierarchies, []*snomed.SearchResponse_Facet{
+		{Id: finding, Value: "Clinical finding", Count: 3},
+		{Id: bodyStructure, Value: "Body structure", Count: 1},
+		{Id: procedure, Value: "Procedure", Count: 1},
+	})
+	checkFacets(t, "semantic tags", response.SemanticTags, []*snomed.SearchResponse_Facet{
+		{Value: "disorder", Count: 3},
+		{Value: "morphologic abnormality", Count: 1},
+		{Value: "procedure", Count: 1},
+	})
+	checkFacets(t, "refsets", response.Refsets, []*snomed.SearchResponse_Facet{
+		{Id: refset, Count: 2},
+	})
+
+	response, err = svc.Search(ctx, &snomed.SearchRequest{S: "fracture", SemanticTags: []string{"procedure", "morphologic abnormality"}, Facets: true}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Items) != 2 {
+		t.Fatalf("expected results with semantic tags filtered, got %v", response.Items)
+	}
+	for _, item := range response.Items {
+		if item.ConceptId != 19031009 && item.ConceptId != 72704001 {
+			t.Errorf("unexpected result with semantic tags filtered: %v", item)
+		}
+	}
+	checkFacets(t, "filtered semantic tags", response.SemanticTags, []*snomed.SearchResponse_Facet{
+		{Value: "morphologic abnormality", Count: 1},
+		{Value: "procedure", Count: 1},
+	})
+
+	response, err = svc.Search(ctx, &snomed.SearchRequest{IsA: []int64{finding}, SemanticTags: []string{"disorder"}, Facets: true}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Total != 3 {
+		t.Errorf("expected 3 disorders, got %v (total %d)", response.Items, response.Total)
+	}
+	checkFacets(t, "browsed refsets", response.Refsets, []*snomed.SearchResponse_Facet{
+		{Id: refset, Count: 2},
+	})
+	if response, err = svc.Search(ctx, &snomed.SearchRequest{S: "fracture"}, tags); err != nil || len(response.Hierarchies) != 0 {
+		t.Errorf("facets counted when not requested: %v (%v)", response.Hierarchies, err)
+	}
+}
+
+func checkFacets(t *testing.T, name string, got []*snomed.SearchResponse_Facet, expected []*snomed.SearchResponse_Facet) {
+	if len(got) != len(expected) {
+		t.Errorf("%s: expected %v, got %v", name, expected, got)
+		return
+	}
+	for i, facet := range got {
+		if facet.Id != expected[i].Id || facet.Value != expected[i].Value || facet.Count != expected[i].Count {
+			t.Errorf("%s: expected %v, got %v", name, expected, got)
+			return
+		}
+	}
+}
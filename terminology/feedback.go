@@ -0,0 +1,206 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/wardle/go-terminology/snomed"
+)
+
+// DefaultFeedbackHalfLife is the default period after which a selection counts half as much as when it was made
+const DefaultFeedbackHalfLife = 30 * 24 * time.Hour
+
+const (
+	minFeedbackPrefix = 3    // the shortest query prefix, in characters, for which selections are aggregated
+	maxFeedbackPrefix = 32   // the longest query prefix, in characters, for which selections are aggregated
+	minFeedbackScore  = 0.01 // selections which have decayed below this frequency are forgotten
+)
+
+// Prefixes for the keys of the aggregated selections
+var (
+	feedbackConceptKey = []byte("c") // frequency of selection of a concept, in any search
+	feedbackPrefixKey  = []byte("q") // frequency of selection of a concept, by query prefix
+	feedbackHintKey    = []byte("h") // frequency of selection of a concept, by context hint
+)
+
+// Feedback is a local, file-based record of the concepts selected from search results.
+// Selections are aggregated by the prefixes of the search string and by the concepts given as hints
+// to the search, and decay over time, so that recent and frequent selections are ranked most highly.
+// Feedback is kept separately from a terminology database, so that it is retained when a database
+// is replaced by a new release.
+type Feedback struct {
+	db       *leveldb.DB
+	halfLife time.Duration
+	mu       sync.Mutex // serialises updates to the aggregated frequencies
+	now      func() time.Time
+}
+
+// OpenFeedback opens or creates a record of search selections at the path specified. Selections decay
+// with the half-life specified, or never decay if the half-life is zero. Any selections that have decayed
+// to insignificance are discarded.
+func OpenFeedback(path string, halfLife time.Duration) (*Feedback, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	fb := &Feedback{db: db, halfLife: halfLife, now: time.Now}
+	if _, err := fb.Prune(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return fb, nil
+}
+
+// Close closes the record of search selections
+func (fb *Feedback) Close() error {
+	return fb.db.Close()
+}
+
+// Record records that the concept was selected from the results of the search request specified
+func (fb *Feedback) Record(feedback *snomed.SearchFeedback) error {
+	if feedback.GetSelectedConcept() == 0 {
+		return errors.New("no selected concept")
+	}
+	concept := sctKey(feedback.GetSelectedConcept())
+	keys := [][]byte{compoundKey(feedbackConceptKey, concept)}
+	s := []rune(normaliseFeedbackQuery(feedback.GetRequest().GetS()))
+	for i := minFeedbackPrefix; i <= len(s); i++ {
+		keys = append(keys, compoundKey(feedbackPrefixKey, []byte(string(s[:i])), []byte{0}, concept))
+	}
+	for _, hint := range feedback.GetRequest().GetHints() {
+		keys = append(keys, compoundKey(feedbackHintKey, sctKey(hint), concept))
+	}
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	now := fb.now()
+	batch := new(leveldb.Batch)
+	for _, key := range keys {
+		score := 0.0
+		value, err := fb.db.Get(key, nil)
+		if err == nil {
+			score = fb.decay(value, now)
+		} else if err != leveldb.ErrNotFound {
+			return err
+		}
+		batch.Put(key, feedbackValue(score+1, now))
+	}
+	return fb.db.Write(batch, nil)
+}
+
+// Scores returns the frequency with which concepts have been selected for searches similar to the request specified,
+// with each selection decayed according to its age.
+func (fb *Feedback) Scores(req *snomed.SearchRequest) (map[int64]float64, error) {
+	var prefixes [][]byte
+	s := []rune(normaliseFeedbackQuery(req.GetS()))
+	if len(s) >= minFeedbackPrefix {
+		prefixes = append(prefixes, compoundKey(feedbackPrefixKey, []byte(string(s)), []byte{0}))
+	}
+	for _, hint := range req.GetHints() {
+		prefixes = append(prefixes, compoundKey(feedbackHintKey, sctKey(hint)))
+	}
+	now := fb.now()
+	result := make(map[int64]float64)
+	for _, prefix := range prefixes {
+		iter := fb.db.NewIterator(util.BytesPrefix(prefix), nil)
+		for iter.Next() {
+			key := iter.Key()
+			conceptID := int64(binary.BigEndian.Uint64(key[len(key)-8:]))
+			result[conceptID] += fb.decay(iter.Value(), now)
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Frequency returns the frequency with which the concept has been selected from the results of any search,
+// with each selection decayed according to its age.
+func (fb *Feedback) Frequency(conceptID int64) (float64, error) {
+	value, err := fb.db.Get(compoundKey(feedbackConceptKey, sctKey(conceptID)), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return fb.decay(value, fb.now()), nil
+}
+
+// Prune discards selections which have decayed to insignificance, returning the number discarded
+func (fb *Feedback) Prune() (int, error) {
+	if fb.halfLife == 0 {
+		return 0, nil
+	}
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	now := fb.now()
+	batch := new(leveldb.Batch)
+	iter := fb.db.NewIterator(nil, nil)
+	for iter.Next() {
+		if fb.decay(iter.Value(), now) < minFeedbackScore {
+			batch.Delete(append([]byte(nil), iter.Key()...))
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+	return batch.Len(), fb.db.Write(batch, nil)
+}
+
+// decay returns the frequency recorded, decayed exponentially according to the time since it was recorded
+func (fb *Feedback) decay(value []byte, now time.Time) float64 {
+	if len(value) != 16 {
+		return 0
+	}
+	score := math.Float64frombits(binary.BigEndian.Uint64(value[:8]))
+	if fb.halfLife == 0 {
+		return score
+	}
+	elapsed := now.Sub(time.Unix(0, int64(binary.BigEndian.Uint64(value[8:]))))
+	if elapsed <= 0 {
+		return score
+	}
+	return score * math.Exp2(-float64(elapsed)/float64(fb.halfLife))
+}
+
+// feedbackValue encodes a frequency and the time at which it was recorded
+func feedbackValue(score float64, t time.Time) []byte {
+	value := make([]byte, 16)
+	binary.BigEndian.PutUint64(value[:8], math.Float64bits(score))
+	binary.BigEndian.PutUint64(value[8:], uint64(t.UnixNano()))
+	return value
+}
+
+// normaliseFeedbackQuery returns the search string in lowercase, with whitespace normalised,
+// truncated to the longest prefix for which selections are aggregated.
+func normaliseFeedbackQuery(s string) string {
+	r := []rune(strings.ToLower(strings.Join(strings.Fields(s), " ")))
+	if len(r) > maxFeedbackPrefix {
+		r = r[:maxFeedbackPrefix]
+	}
+	return string(r)
+}
@@ -0,0 +1,86 @@
+package terminology
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+)
+
+func TestFeedback(t *testing.T) {
+	dir, err := ioutil.TempDir("", "feedback")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fb, err := OpenFeedback(dir, 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fb.Close()
+	now := time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+	fb.now = func() time.Time { return now }
+	if err := fb.Record(&snomed.SearchFeedback{Request: &snomed.SearchRequest{S: "multiple"}}); err == nil {
+		t.Fatal("recorded feedback without a selected concept")
+	}
+	selections := []struct {
+		s       string
+		hints   []int64
+		concept int64
+	}{
+		{"Multiple  Scler", nil, 24700007},
+		{"mult", []int64{6118003}, 24700007},
+		{"multiple", []int64{6118003}, 24700007},
+		{"multiple myeloma", nil, 109989006},
+	}
+	for _, s := range selections {
+		if err := fb.Record(&snomed.SearchFeedback{Request: &snomed.SearchRequest{S: s.s, Hints: s.hints}, SelectedConcept: s.concept}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		request  *snomed.SearchRequest
+		expected map[int64]float64
+	}{
+		{&snomed.SearchRequest{S: "mul"}, map[int64]float64{24700007: 3, 109989006: 1}},
+		{&snomed.SearchRequest{S: "multiple s"}, map[int64]float64{24700007: 1}},
+		{&snomed.SearchRequest{S: "mu"}, map[int64]float64{}},
+		{&snomed.SearchRequest{Hints: []int64{6118003}}, map[int64]float64{24700007: 2}},
+		{&snomed.SearchRequest{S: "myeloma"}, map[int64]float64{}},
+	}
+	for _, test := range tests {
+		scores, err := fb.Scores(test.request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(scores) != len(test.expected) {
+			t.Errorf("%v: expected %v, got %v", test.request, test.expected, scores)
+		}
+		for conceptID, expected := range test.expected {
+			if scores[conceptID] != expected {
+				t.Errorf("%v: expected %v, got %v", test.request, test.expected, scores)
+			}
+		}
+	}
+	now = now.Add(48 * time.Hour)
+	scores, err := fb.Scores(&snomed.SearchRequest{S: "multiple m"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scores[109989006] != 0.25 {
+		t.Errorf("selection did not decay as expected: %v", scores)
+	}
+	now = now.Add(7 * 24 * time.Hour)
+	pruned, err := fb.Prune()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned == 0 {
+		t.Error("selections not forgotten")
+	}
+	if scores, err := fb.Scores(&snomed.SearchRequest{S: "mul"}); err != nil || len(scores) != 0 {
+		t.Errorf("selections not forgotten: %v (%v)", scores, err)
+	}
+}
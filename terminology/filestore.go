@@ -0,0 +1,403 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/wardle/go-terminology/snomed"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FileStorer is a Storer that writes components to files, rather than to a database, such as
+// for conversion of a distribution into formats suitable for analytics. It must be closed once
+// an import has completed.
+type FileStorer interface {
+	Storer
+	Close() error
+}
+
+// Names of the files written by a FileStorer, without extension, one for each type of component
+const (
+	conceptsFilename      = "concepts"
+	descriptionsFilename  = "descriptions"
+	relationshipsFilename = "relationships"
+	refsetItemsFilename   = "refsetItems"
+)
+
+// JSONLinesStorer writes components as JSON Lines; one JSON object per line, using the same
+// field names as the JSON representation of the REST API, with a file for each type of component.
+type JSONLinesStorer struct {
+	dir   string
+	mu    sync.Mutex
+	files map[string]*jsonLinesFile
+}
+
+type jsonLinesFile struct {
+	mu sync.Mutex
+	f  *os.File
+	w  *bufio.Writer
+}
+
+var _ FileStorer = (*JSONLinesStorer)(nil)
+
+// NewJSONLinesStorer creates a storer that writes JSON Lines files within the directory specified
+func NewJSONLinesStorer(dir string) (*JSONLinesStorer, error) {
+	if err := os.MkdirAll(dir, 0771); err != nil {
+		return nil, err
+	}
+	return &JSONLinesStorer{dir: dir, files: make(map[string]*jsonLinesFile)}, nil
+}
+
+// file returns the file with the name specified, creating it if necessary
+func (js *JSONLinesStorer) file(name string) (*jsonLinesFile, error) {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	if f, ok := js.files[name]; ok {
+		return f, nil
+	}
+	f, err := os.Create(filepath.Join(js.dir, name+".jsonl"))
+	if err != nil {
+		return nil, err
+	}
+	result := &jsonLinesFile{f: f, w: bufio.NewWriter(f)}
+	js.files[name] = result
+	return result, nil
+}
+
+// Put writes a batch of SNOMED components, which must be a slice of a type of component.
+func (js *JSONLinesStorer) Put(context context.Context, components interface{}) error {
+	var name string
+	var msgs []proto.Message
+	switch components := components.(type) {
+	case []*snomed.Concept:
+		name = conceptsFilename
+		for _, c := range components {
+			msgs = append(msgs, c)
+		}
+	case []*snomed.Description:
+		name = descriptionsFilename
+		for _, d := range components {
+			msgs = append(msgs, d)
+		}
+	case []*snomed.Relationship:
+		name = relationshipsFilename
+		for _, r := range components {
+			msgs = append(msgs, r)
+		}
+	case []*snomed.ReferenceSetItem:
+		name = refsetItemsFilename
+		for _, item := range components {
+			msgs = append(msgs, item)
+		}
+	default:
+		return fmt.Errorf("unknown component type: %T", components)
+	}
+	f, err := js.file(name)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, msg := range msgs {
+		b, err := protojson.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		if _, err := f.w.Write(b); err != nil {
+			return err
+		}
+		if err := f.w.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close flushes and closes the files written
+func (js *JSONLinesStorer) Close() error {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	var result error
+	for name, f := range js.files {
+		if err := f.w.Flush(); err != nil && result == nil {
+			result = fmt.Errorf("error writing %s: %w", name, err)
+		}
+		if err := f.f.Close(); err != nil && result == nil {
+			result = err
+		}
+	}
+	return result
+}
+
+// ParquetStorer writes components as Parquet files, with a file for each type of component.
+// Effective times are written as dates. Reference set items are written to a single table, with
+// optional columns for the attributes of each type of reference set, and the attributes of
+// reference sets of other types as a JSON object.
+type ParquetStorer struct {
+	dir   string
+	mu    sync.Mutex
+	files map[string]*parquetTable
+}
+
+type parquetTable struct {
+	mu sync.Mutex
+	f  *os.File
+	pw *writer.ParquetWriter
+}
+
+var _ FileStorer = (*ParquetStorer)(nil)
+
+// parquetRowGroupSize is the size of each row group, which is buffered in memory before writing
+const parquetRowGroupSize = 32 * 1024 * 1024
+
+type parquetConcept struct {
+	ID                 int64 `parquet:"name=id, type=INT64"`
+	EffectiveTime      int32 `parquet:"name=effectiveTime, type=DATE"`
+	Active             bool  `parquet:"name=active, type=BOOLEAN"`
+	ModuleID           int64 `parquet:"name=moduleId, type=INT64"`
+	DefinitionStatusID int64 `parquet:"name=definitionStatusId, type=INT64"`
+}
+
+type parquetDescription struct {
+	ID                 int64  `parquet:"name=id, type=INT64"`
+	EffectiveTime      int32  `parquet:"name=effectiveTime, type=DATE"`
+	Active             bool   `parquet:"name=active, type=BOOLEAN"`
+	ModuleID           int64  `parquet:"name=moduleId, type=INT64"`
+	ConceptID          int64  `parquet:"name=conceptId, type=INT64"`
+	LanguageCode       string `parquet:"name=languageCode, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	TypeID             int64  `parquet:"name=typeId, type=INT64"`
+	Term               string `parquet:"name=term, type=UTF8"`
+	CaseSignificanceID int64  `parquet:"name=caseSignificanceId, type=INT64"`
+}
+
+type parquetRelationship struct {
+	ID                   int64 `parquet:"name=id, type=INT64"`
+	EffectiveTime        int32 `parquet:"name=effectiveTime, type=DATE"`
+	Active               bool  `parquet:"name=active, type=BOOLEAN"`
+	ModuleID             int64 `parquet:"name=moduleId, type=INT64"`
+	SourceID             int64 `parquet:"name=sourceId, type=INT64"`
+	DestinationID        int64 `parquet:"name=destinationId, type=INT64"`
+	RelationshipGroup    int64 `parquet:"name=relationshipGroup, type=INT64"`
+	TypeID               int64 `parquet:"name=typeId, type=INT64"`
+	CharacteristicTypeID int64 `parquet:"name=characteristicTypeId, type=INT64"`
+	ModifierID           int64 `parquet:"name=modifierId, type=INT64"`
+}
+
+type parquetRefsetItem struct {
+	ID                     string  `parquet:"name=id, type=UTF8"`
+	EffectiveTime          int32   `parquet:"name=effectiveTime, type=DATE"`
+	Active                 bool    `parquet:"name=active, type=BOOLEAN"`
+	ModuleID               int64   `parquet:"name=moduleId, type=INT64"`
+	RefsetID               int64   `parquet:"name=refsetId, type=INT64"`
+	ReferencedComponentID  int64   `parquet:"name=referencedComponentId, type=INT64"`
+	AcceptabilityID        *int64  `parquet:"name=acceptabilityId, type=INT64, repetitiontype=OPTIONAL"`
+	MapTarget              *string `parquet:"name=mapTarget, type=UTF8, repetitiontype=OPTIONAL"`
+	MapGroup               *int64  `parquet:"name=mapGroup, type=INT64, repetitiontype=OPTIONAL"`
+	MapPriority            *int64  `parquet:"name=mapPriority, type=INT64, repetitiontype=OPTIONAL"`
+	MapRule                *string `parquet:"name=mapRule, type=UTF8, repetitiontype=OPTIONAL"`
+	MapAdvice              *string `parquet:"name=mapAdvice, type=UTF8, repetitiontype=OPTIONAL"`
+	CorrelationID          *int64  `parquet:"name=correlationId, type=INT64, repetitiontype=OPTIONAL"`
+	MapCategoryID          *int64  `parquet:"name=mapCategoryId, type=INT64, repetitiontype=OPTIONAL"`
+	MapBlock               *int64  `parquet:"name=mapBlock, type=INT64, repetitiontype=OPTIONAL"`
+	TargetComponentID      *int64  `parquet:"name=targetComponentId, type=INT64, repetitiontype=OPTIONAL"`
+	ValueID                *int64  `parquet:"name=valueId, type=INT64, repetitiontype=OPTIONAL"`
+	AttributeDescriptionID *int64  `parquet:"name=attributeDescriptionId, type=INT64, repetitiontype=OPTIONAL"`
+	AttributeTypeID        *int64  `parquet:"name=attributeTypeId, type=INT64, repetitiontype=OPTIONAL"`
+	AttributeOrder         *int64  `parquet:"name=attributeOrder, type=INT64, repetitiontype=OPTIONAL"`
+	SourceEffectiveTime    *int32  `parquet:"name=sourceEffectiveTime, type=DATE, repetitiontype=OPTIONAL"`
+	TargetEffectiveTime    *int32  `parquet:"name=targetEffectiveTime, type=DATE, repetitiontype=OPTIONAL"`
+	Fields                 *string `parquet:"name=fields, type=UTF8, repetitiontype=OPTIONAL"`
+}
+
+// NewParquetStorer creates a storer that writes Parquet files within the directory specified
+func NewParquetStorer(dir string) (*ParquetStorer, error) {
+	if err := os.MkdirAll(dir, 0771); err != nil {
+		return nil, err
+	}
+	return &ParquetStorer{dir: dir, files: make(map[string]*parquetTable)}, nil
+}
+
+// parquetFile adapts a file to the interface required by the Parquet writer
+type parquetFile struct {
+	*os.File
+}
+
+// Open opens the file specified, or reopens the same file if no name is specified
+func (pf parquetFile) Open(name string) (source.ParquetFile, error) {
+	if name == "" {
+		name = pf.Name()
+	}
+	f, err := os.Open(name)
+	return parquetFile{f}, err
+}
+
+// Create creates the file specified
+func (pf parquetFile) Create(name string) (source.ParquetFile, error) {
+	f, err := os.Create(name)
+	return parquetFile{f}, err
+}
+
+// table returns the table with the name specified, creating it with the schema of the row if necessary
+func (ps *ParquetStorer) table(name string, row interface{}) (*parquetTable, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if t, ok := ps.files[name]; ok {
+		return t, nil
+	}
+	f, err := os.Create(filepath.Join(ps.dir, name+".parquet"))
+	if err != nil {
+		return nil, err
+	}
+	pw, err := writer.NewParquetWriter(parquetFile{f}, row, 1)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	pw.RowGroupSize = parquetRowGroupSize
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+	result := &parquetTable{f: f, pw: pw}
+	ps.files[name] = result
+	return result, nil
+}
+
+// Put writes a batch of SNOMED components, which must be a slice of a type of component.
+func (ps *ParquetStorer) Put(context context.Context, components interface{}) error {
+	var name string
+	var schema interface{}
+	var rows []interface{}
+	switch components := components.(type) {
+	case []*snomed.Concept:
+		name, schema = conceptsFilename, new(parquetConcept)
+		for _, c := range components {
+			rows = append(rows, parquetConcept{ID: c.Id, EffectiveTime: parquetDate(c.EffectiveTime), Active: c.Active,
+				ModuleID: c.ModuleId, DefinitionStatusID: c.DefinitionStatusId})
+		}
+	case []*snomed.Description:
+		name, schema = descriptionsFilename, new(parquetDescription)
+		for _, d := range components {
+			rows = append(rows, parquetDescription{ID: d.Id, EffectiveTime: parquetDate(d.EffectiveTime), Active: d.Active,
+				ModuleID: d.ModuleId, ConceptID: d.ConceptId, LanguageCode: d.LanguageCode, TypeID: d.TypeId, Term: d.Term,
+				CaseSignificanceID: d.CaseSignificance})
+		}
+	case []*snomed.Relationship:
+		name, schema = relationshipsFilename, new(parquetRelationship)
+		for _, r := range components {
+			rows = append(rows, parquetRelationship{ID: r.Id, EffectiveTime: parquetDate(r.EffectiveTime), Active: r.Active,
+				ModuleID: r.ModuleId, SourceID: r.SourceId, DestinationID: r.DestinationId, RelationshipGroup: r.RelationshipGroup,
+				TypeID: r.TypeId, CharacteristicTypeID: r.CharacteristicTypeId, ModifierID: r.ModifierId})
+		}
+	case []*snomed.ReferenceSetItem:
+		name, schema = refsetItemsFilename, new(parquetRefsetItem)
+		for _, item := range components {
+			row, err := newParquetRefsetItem(item)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, row)
+		}
+	default:
+		return fmt.Errorf("unknown component type: %T", components)
+	}
+	t, err := ps.table(name, schema)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, row := range rows {
+		if err := t.pw.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newParquetRefsetItem creates a row for a reference set item, populating the columns for its type of reference set
+func newParquetRefsetItem(item *snomed.ReferenceSetItem) (parquetRefsetItem, error) {
+	row := parquetRefsetItem{ID: item.Id, EffectiveTime: parquetDate(item.EffectiveTime), Active: item.Active,
+		ModuleID: item.ModuleId, RefsetID: item.RefsetId, ReferencedComponentID: item.ReferencedComponentId}
+	switch body := item.Body.(type) {
+	case *snomed.ReferenceSetItem_Language:
+		row.AcceptabilityID = &body.Language.AcceptabilityId
+	case *snomed.ReferenceSetItem_SimpleMap:
+		row.MapTarget = &body.SimpleMap.MapTarget
+	case *snomed.ReferenceSetItem_ComplexMap:
+		cm := body.ComplexMap
+		row.MapGroup, row.MapPriority, row.MapRule, row.MapAdvice = &cm.MapGroup, &cm.MapPriority, &cm.MapRule, &cm.MapAdvice
+		row.MapTarget, row.CorrelationID = &cm.MapTarget, &cm.Correlation
+		if cm.MapCategory != 0 { // an extended map, otherwise a complex map
+			row.MapCategoryID = &cm.MapCategory
+		} else {
+			row.MapBlock = &cm.MapBlock
+		}
+	case *snomed.ReferenceSetItem_Association:
+		row.TargetComponentID = &body.Association.TargetComponentId
+	case *snomed.ReferenceSetItem_AttributeValue:
+		row.ValueID = &body.AttributeValue.ValueId
+	case *snomed.ReferenceSetItem_RefsetDescriptor:
+		rd := body.RefsetDescriptor
+		order := int64(rd.AttributeOrder)
+		row.AttributeDescriptionID, row.AttributeTypeID, row.AttributeOrder = &rd.AttributeDescriptionId, &rd.AttributeTypeId, &order
+	case *snomed.ReferenceSetItem_ModuleDependency:
+		from, to := parquetDate(body.ModuleDependency.SourceEffectiveTime), parquetDate(body.ModuleDependency.TargetEffectiveTime)
+		row.SourceEffectiveTime, row.TargetEffectiveTime = &from, &to
+	case *snomed.ReferenceSetItem_Generic:
+		fields := make(map[string]string, len(body.Generic.Fields))
+		for _, f := range body.Generic.Fields {
+			fields[f.Name] = f.Format()
+		}
+		b, err := json.Marshal(fields)
+		if err != nil {
+			return row, err
+		}
+		s := string(b)
+		row.Fields = &s
+	}
+	return row, nil
+}
+
+// parquetDate returns a timestamp as a Parquet date; the number of days since the Unix epoch
+func parquetDate(ts *timestamppb.Timestamp) int32 {
+	return int32(ts.AsTime().Unix() / (24 * 60 * 60))
+}
+
+// Close writes the footer of each Parquet file, and closes it
+func (ps *ParquetStorer) Close() error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	var result error
+	for name, t := range ps.files {
+		if err := t.pw.WriteStop(); err != nil && result == nil {
+			result = fmt.Errorf("error writing %s: %w", name, err)
+		}
+		if err := t.f.Close(); err != nil && result == nil {
+			result = err
+		}
+	}
+	return result
+}
@@ -0,0 +1,177 @@
+package terminology
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"github.com/xitongsys/parquet-go/reader"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// writeRelease writes a small RF2 release to the directory specified
+func writeRelease(t *testing.T, dir string) []*snomed.ReferenceSetItem {
+	date := time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+	et := timestamppb.New(date)
+	w := snomed.NewWriter(dir, snomed.Snapshot, "INT", date)
+	for _, id := range []int64{24700007, 6118003} {
+		if err := w.WriteConcept(&snomed.Concept{Id: id, EffectiveTime: et, Active: true, ModuleId: 900000000000207008, DefinitionStatusId: 900000000000074008}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.WriteDescription(&snomed.Description{Id: 41398015, EffectiveTime: et, Active: true, ModuleId: 900000000000207008, ConceptId: 24700007,
+		LanguageCode: "en", TypeId: int64(snomed.Synonym), Term: "Multiple sclerosis", CaseSignificance: 900000000000448009}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRelationship(&snomed.Relationship{Id: 1399025, EffectiveTime: et, Active: true, ModuleId: 900000000000207008, SourceId: 24700007,
+		DestinationId: 6118003, TypeId: snomed.IsA, CharacteristicTypeId: snomed.InferredRelationship, ModifierId: 900000000000451002}); err != nil {
+		t.Fatal(err)
+	}
+	items := []*snomed.ReferenceSetItem{
+		{Id: "2d0b9d58-3d1f-4d5e-8d1c-000000000001", EffectiveTime: et, Active: true, ModuleId: 900000000000207008, RefsetId: 900000000000508004, ReferencedComponentId: 41398015,
+			Body: &snomed.ReferenceSetItem_Language{Language: &snomed.LanguageReferenceSet{AcceptabilityId: 900000000000548007}}},
+		{Id: "2d0b9d58-3d1f-4d5e-8d1c-000000000002", EffectiveTime: et, Active: true, ModuleId: 900000000000207008, RefsetId: 447562003, ReferencedComponentId: 24700007,
+			Body: &snomed.ReferenceSetItem_ComplexMap{ComplexMap: &snomed.ComplexMapReferenceSet{MapGroup: 1, MapPriority: 1, MapRule: "TRUE", MapAdvice: "ALWAYS G35", MapTarget: "G35", Correlation: 447561005, MapCategory: 447637006}}},
+		{Id: "2d0b9d58-3d1f-4d5e-8d1c-000000000003", EffectiveTime: et, Active: true, ModuleId: 999000031000000106, RefsetId: 999002271000000101, ReferencedComponentId: 24700007,
+			Body: &snomed.ReferenceSetItem_ComplexMap{ComplexMap: &snomed.ComplexMapReferenceSet{MapGroup: 1, MapPriority: 1, MapRule: "TRUE", MapAdvice: "ALWAYS G35", MapTarget: "G35X", Correlation: 447561005, MapBlock: 2}}},
+	}
+	for _, item := range items {
+		if err := w.WriteReferenceSetItem(item); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return items
+}
+
+func TestJSONLinesStorer(t *testing.T) {
+	root, err := ioutil.TempDir("", "convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	items := writeRelease(t, filepath.Join(root, "rf2"))
+	out := filepath.Join(root, "jsonl")
+	storer, err := NewJSONLinesStorer(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewImporter(storer, 500, 0, false).Import(context.Background(), filepath.Join(root, "rf2")); err != nil {
+		t.Fatal(err)
+	}
+	if err := storer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	counts := map[string]int{"concepts.jsonl": 2, "descriptions.jsonl": 1, "relationships.jsonl": 1, "refsetItems.jsonl": 3}
+	for filename, expected := range counts {
+		f, err := os.Open(filepath.Join(out, filename))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		n := 0
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			n++
+			if filename != "refsetItems.jsonl" {
+				continue
+			}
+			var item snomed.ReferenceSetItem
+			if err := protojson.Unmarshal(scanner.Bytes(), &item); err != nil {
+				t.Fatal(err)
+			}
+			found := false
+			for _, i := range items {
+				found = found || proto.Equal(&item, i)
+			}
+			if !found {
+				t.Errorf("reference set item not converted: %v", &item)
+			}
+		}
+		if n != expected {
+			t.Errorf("%s: expected %d rows, got %d", filename, expected, n)
+		}
+	}
+}
+
+func TestParquetStorer(t *testing.T) {
+	root, err := ioutil.TempDir("", "convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	writeRelease(t, filepath.Join(root, "rf2"))
+	out := filepath.Join(root, "parquet")
+	storer, err := NewParquetStorer(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewImporter(storer, 500, 0, false).Import(context.Background(), filepath.Join(root, "rf2")); err != nil {
+		t.Fatal(err)
+	}
+	if err := storer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var pf parquetFile
+	f, err := pf.Open(filepath.Join(out, "descriptions.parquet"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	pr, err := reader.NewParquetReader(f, new(parquetDescription), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.ReadStop()
+	descriptions := make([]parquetDescription, pr.GetNumRows())
+	if err := pr.Read(&descriptions); err != nil {
+		t.Fatal(err)
+	}
+	if len(descriptions) != 1 || descriptions[0].Term != "Multiple sclerosis" || descriptions[0].EffectiveTime != 18353 {
+		t.Fatalf("description not converted: %v", descriptions)
+	}
+	f2, err := pf.Open(filepath.Join(out, "refsetItems.parquet"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f2.Close()
+	pr2, err := reader.NewParquetReader(f2, new(parquetRefsetItem), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr2.ReadStop()
+	items := make([]parquetRefsetItem, pr2.GetNumRows())
+	if err := pr2.Read(&items); err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 3 {
+		t.Fatalf("expected 3 reference set items, got %d", len(items))
+	}
+	for _, item := range items {
+		switch item.RefsetID {
+		case 900000000000508004:
+			if item.AcceptabilityID == nil || *item.AcceptabilityID != 900000000000548007 || item.MapTarget != nil {
+				t.Errorf("language reference set item not converted: %+v", item)
+			}
+		case 447562003:
+			if item.MapTarget == nil || *item.MapTarget != "G35" || item.MapCategoryID == nil || item.MapBlock != nil || item.AcceptabilityID != nil {
+				t.Errorf("map reference set item not converted: %+v", item)
+			}
+		case 999002271000000101:
+			if item.MapTarget == nil || *item.MapTarget != "G35X" || item.MapBlock == nil || *item.MapBlock != 2 || item.MapCategoryID != nil {
+				t.Errorf("complex map reference set item not converted: %+v", item)
+			}
+		default:
+			t.Errorf("unexpected reference set item: %+v", item)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"html"
+	"strings"
+
+	"github.com/wardle/go-terminology/snomed"
+)
+
+// highlight adds the parts of the matched and preferred terms of a search result that match the search string
+func (svc *Svc) highlight(sr *snomed.SearchRequest, item *snomed.SearchResponse_Item, matched *snomed.Description, preferred *snomed.Description) error {
+	var err error
+	if item.TermMatches, err = svc.search.Highlight(sr, matched); err != nil {
+		return err
+	}
+	if item.PreferredTermMatches, err = svc.search.Highlight(sr, preferred); err != nil {
+		return err
+	}
+	item.HighlightedTerm = markMatches(item.Term, item.TermMatches)
+	item.HighlightedPreferredTerm = markMatches(item.PreferredTerm, item.PreferredTermMatches)
+	return nil
+}
+
+// markMatches returns a term as HTML, with the parts matched marked using <mark> elements
+func markMatches(term string, matches []*snomed.SearchResponse_Match) string {
+	runes := []rune(term)
+	var sb strings.Builder
+	last := 0
+	for _, m := range matches {
+		start, end := int(m.Start), int(m.End)
+		if start < last || end > len(runes) {
+			continue
+		}
+		sb.WriteString(html.EscapeString(string(runes[last:start])))
+		sb.WriteString("<mark>")
+		sb.WriteString(html.EscapeString(string(runes[start:end])))
+		sb.WriteString("</mark>")
+		last = end
+	}
+	sb.WriteString(html.EscapeString(string(runes[last:])))
+	return sb.String()
+}
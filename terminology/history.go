@@ -0,0 +1,311 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// versioned is a SNOMED CT component with an effective time
+type versioned interface {
+	proto.Message
+	GetEffectiveTime() *timestamppb.Timestamp
+}
+
+// RecordHistory enables or disables the recording of every version of every component,
+// as is needed when importing a full release. The setting is persisted in the database descriptor.
+func (svc *Svc) RecordHistory(enable bool) error {
+	return svc.updateDescriptor(func(d *Descriptor) {
+		d.History = enable
+	})
+}
+
+// historyKey returns the key for a version of a component: its identifier and its effective time
+func historyKey(id []byte, effectiveTime *timestamppb.Timestamp) []byte {
+	t := make([]byte, 8)
+	binary.BigEndian.PutUint64(t, uint64(effectiveTime.GetSeconds()))
+	return compoundKey(id, t)
+}
+
+// recordVersion records a version of a component in its history
+func (svc *Svc) recordVersion(history bucket, id []byte, v versioned) error {
+	return svc.store.Update(func(batch Batch) error {
+		batch.Put(history, historyKey(id, v.GetEffectiveTime()), v)
+		return nil
+	})
+}
+
+// versions iterates through the recorded versions of a component, in order of effective time
+func versions(batch Batch, history bucket, id []byte, f func(effectiveTime time.Time, value []byte) error) error {
+	l := len(history.name()) + len(id) + 8
+	return batch.Iterate(history, id, func(key, value []byte) error {
+		if len(key) != l { // a different component sharing this prefix
+			return nil
+		}
+		t := time.Unix(int64(binary.BigEndian.Uint64(key[l-8:])), 0).UTC()
+		return f(t, value)
+	})
+}
+
+// history returns all recorded versions of a component, or only the current version if no history has been recorded
+func (svc *Svc) history(history bucket, current bucket, id []byte, newFn func() versioned) ([]versioned, error) {
+	result := make([]versioned, 0)
+	err := svc.store.View(func(batch Batch) error {
+		err := versions(batch, history, id, func(t time.Time, value []byte) error {
+			v := newFn()
+			if err := proto.Unmarshal(value, v); err != nil {
+				return err
+			}
+			result = append(result, v)
+			return nil
+		})
+		if err != nil || len(result) > 0 {
+			return err
+		}
+		v := newFn()
+		if err := batch.Get(current, id, v); err != nil {
+			return err
+		}
+		result = append(result, v)
+		return nil
+	})
+	return result, err
+}
+
+// versionAt returns the version of a component that was current at the specified time, falling back to
+// the current version if no history has been recorded. Returns ErrNotFound if the component did not exist.
+func versionAt(batch Batch, history bucket, current bucket, id []byte, t time.Time, v versioned) error {
+	var found []byte
+	err := versions(batch, history, id, func(effectiveTime time.Time, value []byte) error {
+		if !effectiveTime.After(t) {
+			found = append(found[:0], value...) // the iterator may reuse the value buffer
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if found != nil {
+		return proto.Unmarshal(found, v)
+	}
+	if err := batch.Get(current, id, v); err != nil {
+		return err
+	}
+	if v.GetEffectiveTime().AsTime().After(t) {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ConceptHistory returns all versions of the specified concept, in order of effective time
+func (svc *Svc) ConceptHistory(conceptID int64) ([]*snomed.Concept, error) {
+	vs, err := svc.history(bkConceptHistory, bkConcepts, sctKey(conceptID), func() versioned { return new(snomed.Concept) })
+	result := make([]*snomed.Concept, len(vs))
+	for i, v := range vs {
+		result[i] = v.(*snomed.Concept)
+	}
+	return result, err
+}
+
+// DescriptionHistory returns all versions of the specified description, in order of effective time
+func (svc *Svc) DescriptionHistory(descriptionID int64) ([]*snomed.Description, error) {
+	vs, err := svc.history(bkDescriptionHistory, bkDescriptions, sctKey(descriptionID), func() versioned { return new(snomed.Description) })
+	result := make([]*snomed.Description, len(vs))
+	for i, v := range vs {
+		result[i] = v.(*snomed.Description)
+	}
+	return result, err
+}
+
+// RelationshipHistory returns all versions of the specified relationship, in order of effective time
+func (svc *Svc) RelationshipHistory(relationshipID int64) ([]*snomed.Relationship, error) {
+	vs, err := svc.history(bkRelationshipHistory, bkRelationships, sctKey(relationshipID), func() versioned { return new(snomed.Relationship) })
+	result := make([]*snomed.Relationship, len(vs))
+	for i, v := range vs {
+		result[i] = v.(*snomed.Relationship)
+	}
+	return result, err
+}
+
+// ReferenceSetItemHistory returns all versions of the specified reference set item, in order of effective time
+func (svc *Svc) ReferenceSetItemHistory(itemID string) ([]*snomed.ReferenceSetItem, error) {
+	vs, err := svc.history(bkRefsetItemHistory, bkRefsetItems, []byte(itemID), func() versioned { return new(snomed.ReferenceSetItem) })
+	result := make([]*snomed.ReferenceSetItem, len(vs))
+	for i, v := range vs {
+		result[i] = v.(*snomed.ReferenceSetItem)
+	}
+	return result, err
+}
+
+// ConceptAt returns the specified concept as it was at the specified time
+func (svc *Svc) ConceptAt(conceptID int64, t time.Time) (*snomed.Concept, error) {
+	var c snomed.Concept
+	return &c, svc.store.View(func(batch Batch) error {
+		return versionAt(batch, bkConceptHistory, bkConcepts, sctKey(conceptID), t, &c)
+	})
+}
+
+// DescriptionAt returns the specified description as it was at the specified time
+func (svc *Svc) DescriptionAt(descriptionID int64, t time.Time) (*snomed.Description, error) {
+	var d snomed.Description
+	return &d, svc.store.View(func(batch Batch) error {
+		return versionAt(batch, bkDescriptionHistory, bkDescriptions, sctKey(descriptionID), t, &d)
+	})
+}
+
+// RelationshipAt returns the specified relationship as it was at the specified time
+func (svc *Svc) RelationshipAt(relationshipID int64, t time.Time) (*snomed.Relationship, error) {
+	var r snomed.Relationship
+	return &r, svc.store.View(func(batch Batch) error {
+		return versionAt(batch, bkRelationshipHistory, bkRelationships, sctKey(relationshipID), t, &r)
+	})
+}
+
+// ReferenceSetItemAt returns the specified reference set item as it was at the specified time
+func (svc *Svc) ReferenceSetItemAt(itemID string, t time.Time) (*snomed.ReferenceSetItem, error) {
+	var item snomed.ReferenceSetItem
+	return &item, svc.store.View(func(batch Batch) error {
+		return versionAt(batch, bkRefsetItemHistory, bkRefsetItems, []byte(itemID), t, &item)
+	})
+}
+
+// DescriptionsAt returns the descriptions for a concept as they were at the specified time,
+// omitting those that did not then exist.
+func (svc *Svc) DescriptionsAt(conceptID int64, t time.Time) ([]*snomed.Description, error) {
+	result := make([]*snomed.Description, 0)
+	return result, svc.store.View(func(batch Batch) error {
+		values, err := batch.GetIndexEntries(ixConceptDescriptions, sctKey(conceptID))
+		if err != nil {
+			return err
+		}
+		for _, v := range values {
+			d := new(snomed.Description)
+			err := versionAt(batch, bkDescriptionHistory, bkDescriptions, v, t, d)
+			if err == ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			result = append(result, d)
+		}
+		return nil
+	})
+}
+
+// ParentRelationshipsAt returns the parent relationships for a concept as they were at the specified time,
+// omitting those that did not then exist.
+func (svc *Svc) ParentRelationshipsAt(conceptID int64, t time.Time) ([]*snomed.Relationship, error) {
+	return svc.relationshipsAt(conceptID, ixConceptParentRelationships, t)
+}
+
+// ChildRelationshipsAt returns the child relationships for a concept as they were at the specified time,
+// omitting those that did not then exist.
+func (svc *Svc) ChildRelationshipsAt(conceptID int64, t time.Time) ([]*snomed.Relationship, error) {
+	return svc.relationshipsAt(conceptID, ixConceptChildRelationships, t)
+}
+
+func (svc *Svc) relationshipsAt(conceptID int64, idx bucket, t time.Time) ([]*snomed.Relationship, error) {
+	result := make([]*snomed.Relationship, 0)
+	return result, svc.store.View(func(batch Batch) error {
+		values, err := batch.GetIndexEntries(idx, sctKey(conceptID))
+		if err != nil {
+			return err
+		}
+		for _, v := range values {
+			r := new(snomed.Relationship)
+			err := versionAt(batch, bkRelationshipHistory, bkRelationships, v, t, r)
+			if err == ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			result = append(result, r)
+		}
+		return nil
+	})
+}
+
+// ParentsAt returns the direct IS-A parents of a concept as they were at the specified time
+func (svc *Svc) ParentsAt(conceptID int64, t time.Time) ([]int64, error) {
+	rels, err := svc.ParentRelationshipsAt(conceptID, t)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]int64, 0)
+	seen := make(map[int64]struct{})
+	for _, r := range rels {
+		if r.Active && r.TypeId == snomed.IsA {
+			if _, ok := seen[r.DestinationId]; !ok {
+				seen[r.DestinationId] = struct{}{}
+				result = append(result, r.DestinationId)
+			}
+		}
+	}
+	return result, nil
+}
+
+// ComponentFromReferenceSetAt returns the items for the specified component in the specified reference set,
+// as they were at the specified time, omitting those that did not then exist.
+func (svc *Svc) ComponentFromReferenceSetAt(refset int64, component int64, t time.Time) ([]*snomed.ReferenceSetItem, error) {
+	result := make([]*snomed.ReferenceSetItem, 0)
+	return result, svc.store.View(func(batch Batch) error {
+		values, err := batch.GetIndexEntries(ixReferenceSetComponentItems, compoundKey(sctKey(refset), sctKey(component)))
+		if err != nil {
+			return err
+		}
+		for _, v := range values {
+			item := new(snomed.ReferenceSetItem)
+			err := versionAt(batch, bkRefsetItemHistory, bkRefsetItems, v, t, item)
+			if err == ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			result = append(result, item)
+		}
+		return nil
+	})
+}
+
+// IsInReferenceSetAt returns whether the specified component was an active member of the specified
+// reference set at the specified time.
+func (svc *Svc) IsInReferenceSetAt(component int64, refset int64, t time.Time) (bool, error) {
+	items, err := svc.ComponentFromReferenceSetAt(refset, component, t)
+	if err != nil {
+		return false, err
+	}
+	for _, item := range items {
+		if item.Active {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// sctKey returns the key for the specified SNOMED CT identifier
+func sctKey(id int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(id))
+	return key
+}
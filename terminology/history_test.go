@@ -0,0 +1,115 @@
+package terminology
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHistory(t *testing.T) {
+	filename := "history-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	if err := svc.RecordHistory(true); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	t1 := time.Date(2002, 1, 31, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2010, 7, 31, 0, 0, 0, 0, time.UTC)
+	t3 := time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC)
+	// import versions out of order, as batches of a full release may be stored in any order
+	versions := []*snomed.Concept{
+		{Id: 24700007, EffectiveTime: timestamppb.New(t2), Active: false},
+		{Id: 24700007, EffectiveTime: timestamppb.New(t1), Active: true},
+		{Id: 24700007, EffectiveTime: timestamppb.New(t3), Active: true},
+	}
+	for _, c := range versions {
+		if err := svc.Put(ctx, []*snomed.Concept{c}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	history, err := svc.ConceptHistory(24700007)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 3 || !history[0].EffectiveTime.AsTime().Equal(t1) || !history[2].EffectiveTime.AsTime().Equal(t3) {
+		t.Fatalf("incorrect concept history: %v", history)
+	}
+	current, err := svc.Concept(24700007)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !current.EffectiveTime.AsTime().Equal(t3) {
+		t.Fatalf("current version not the latest: %v", current)
+	}
+	tests := []struct {
+		at     time.Time
+		active bool
+		err    error
+	}{
+		{time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC), false, ErrNotFound},
+		{t1, true, nil},
+		{time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC), false, nil},
+		{time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), true, nil},
+	}
+	for _, test := range tests {
+		c, err := svc.ConceptAt(24700007, test.at)
+		if err != test.err {
+			t.Fatalf("concept at %s: expected error %v, got %v", test.at, test.err, err)
+		}
+		if err == nil && c.Active != test.active {
+			t.Fatalf("concept at %s: expected active=%t, got %v", test.at, test.active, c)
+		}
+	}
+	// versions within a single batch, as read from the same full release file
+	batch := []*snomed.Description{
+		{Id: 41000001, ConceptId: 24700007, EffectiveTime: timestamppb.New(t3), Active: true, Term: "Multiple sclerosis"},
+		{Id: 41000001, ConceptId: 24700007, EffectiveTime: timestamppb.New(t1), Active: true, Term: "Disseminated sclerosis"},
+	}
+	if err := svc.Put(ctx, batch); err != nil {
+		t.Fatal(err)
+	}
+	d, err := svc.Description(41000001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Term != "Multiple sclerosis" {
+		t.Fatalf("current version not the latest within a batch: %v", d)
+	}
+	// versions in batches stored concurrently, as by the importer
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			et := timestamppb.New(t1.AddDate(0, 0, i))
+			ds := make([]*snomed.Description, 500)
+			for j := range ds {
+				ds[j] = &snomed.Description{Id: 42000000 + int64(j), ConceptId: 24700007, EffectiveTime: et, Active: true, Term: "MS"}
+			}
+			if err := svc.Put(ctx, ds); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	latest := t1.AddDate(0, 0, 19)
+	for j := int64(0); j < 500; j++ {
+		d, err := svc.Description(42000000 + j)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !d.EffectiveTime.AsTime().Equal(latest) {
+			t.Fatalf("current version not the latest of those stored concurrently: %v", d)
+		}
+	}
+}
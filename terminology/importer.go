@@ -18,7 +18,10 @@ package terminology
 import (
 	"context"
 	"fmt"
+	"os"
 	"runtime"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -28,14 +31,86 @@ import (
 
 // Importer manages the import of SNOMED CT components from the filesystem
 type Importer struct {
-	storer Storer
-	snomed.ImportChannels
-	batchSize                                          int // size of each batch.
-	threads                                            int // number of threads importing a type of component
-	verbose                                            bool
+	storer                                             Storer
+	checkpointer                                       Checkpointer // records progress, if supported by the storer
+	files                                              map[string]*fileProgress
+	filesMu                                            sync.Mutex
+	batchSize                                          int                // size of each batch.
+	threads                                            int                // number of threads importing a type of component
+	release                                            snomed.ReleaseType // type of RF2 release files to import
+	strict                                             bool               // whether to stop at the first error in a file
+	handler                                            ImportEventHandler
+	summary                                            ImportSummary
+	summaryMu                                          sync.Mutex
 	nconcepts, ndescriptions, nrelationships, nrefsets int32
 }
 
+// ImportEventType is the type of an event reported during an import
+type ImportEventType int
+
+// Types of import event
+const (
+	ImportProgressEvent ImportEventType = iota // periodic report of progress
+	ImportErrorEvent                           // an error in a distribution file, which has been skipped
+	ImportCompleteEvent                        // the import has completed
+)
+
+// ImportEvent reports the progress of an import, or an error encountered
+type ImportEvent struct {
+	Type    ImportEventType
+	Elapsed time.Duration
+	Counts  ImportCounts       // components stored so far
+	Error   *snomed.ParseError // for an error event
+}
+
+// ImportEventHandler receives the events of an import. It may be called concurrently.
+type ImportEventHandler func(ImportEvent)
+
+// ImportCounts records the number of components stored
+type ImportCounts struct {
+	Concepts          int
+	Descriptions      int
+	Relationships     int
+	ReferenceSetItems int
+}
+
+// maxSummaryErrors is the maximum number of errors recorded in an import summary, although all are counted
+const maxSummaryErrors = 100
+
+// ImportSummary summarises an import, including the errors encountered
+type ImportSummary struct {
+	Duration   time.Duration
+	Counts     ImportCounts
+	ErrorCount int                  // number of errors, by which rows or files were skipped
+	FileErrors map[string]int       // number of errors, by filename
+	Errors     []*snomed.ParseError // the first errors encountered
+}
+
+func (s *ImportSummary) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Imported %d concepts, %d descriptions, %d relationships and %d refset items in %s\n",
+		s.Counts.Concepts, s.Counts.Descriptions, s.Counts.Relationships, s.Counts.ReferenceSetItems, s.Duration)
+	if s.ErrorCount == 0 {
+		return b.String()
+	}
+	fmt.Fprintf(&b, "%d errors:\n", s.ErrorCount)
+	filenames := make([]string, 0, len(s.FileErrors))
+	for filename := range s.FileErrors {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+	for _, filename := range filenames {
+		fmt.Fprintf(&b, "  %s: %d\n", filename, s.FileErrors[filename])
+	}
+	for _, err := range s.Errors {
+		fmt.Fprintf(&b, "  %v\n", err)
+	}
+	if len(s.Errors) < s.ErrorCount {
+		fmt.Fprintf(&b, "  ... and %d more\n", s.ErrorCount-len(s.Errors))
+	}
+	return b.String()
+}
+
 // Storer defines the behaviour of a service that can accept a batch of SNOMED components for storage.
 type Storer interface {
 	Put(context context.Context, components interface{}) error
@@ -62,32 +137,95 @@ func NewImporter(storer Storer, batchSize int, threads int, verbose bool) *Impor
 	}
 	importer := &Importer{
 		storer:    storer,
-		verbose:   verbose,
 		batchSize: batchSize,
 		threads:   threads,
 	}
+	if verbose {
+		importer.handler = PrintImportEvents
+	}
 	return importer
 }
 
-// Import starts the import process
-func (im *Importer) Import(ctx context.Context, root string) {
+// SetStrict sets whether the import stops at the first error in a file. By default, rows that cannot be
+// parsed, and files with unexpected columns, are skipped, and reported as events and in the summary.
+func (im *Importer) SetStrict(strict bool) {
+	im.strict = strict
+}
+
+// SetEventHandler sets the handler to receive the progress of imports, and any errors encountered.
+func (im *Importer) SetEventHandler(h ImportEventHandler) {
+	im.handler = h
+}
+
+// Summary returns a summary of the most recent import
+func (im *Importer) Summary() ImportSummary {
+	im.summaryMu.Lock()
+	defer im.summaryMu.Unlock()
+	return im.summary
+}
+
+// PrintImportEvents is an event handler that reports progress and errors to stderr
+func PrintImportEvents(ev ImportEvent) {
+	c := ev.Counts
+	switch ev.Type {
+	case ImportProgressEvent:
+		fmt.Fprintf(os.Stderr, "\rimporting: %s: %d concepts, %d descriptions, %d relationships and %d refset items...",
+			ev.Elapsed.Round(time.Second), c.Concepts, c.Descriptions, c.Relationships, c.ReferenceSetItems)
+	case ImportErrorEvent:
+		fmt.Fprintf(os.Stderr, "\nerror: %v\n", ev.Error)
+	case ImportCompleteEvent:
+		fmt.Fprintf(os.Stderr, "\rImport complete. Processed: %s: %d concepts, %d descriptions, %d relationships and %d refset items\n",
+			ev.Elapsed, c.Concepts, c.Descriptions, c.Relationships, c.ReferenceSetItems)
+	}
+}
+
+// SetReleaseType sets the type of RF2 release files to be imported, which defaults to snapshot files.
+// Delta files may be imported into an existing database, and if that database has already been
+// precomputed, the affected indices should then be updated using PerformIncrementalPrecomputations.
+func (im *Importer) SetReleaseType(release snomed.ReleaseType) {
+	im.release = release
+}
+
+// Import imports the SNOMED CT release files found within root, returning the first error encountered.
+// If the storer is a Checkpointer, progress is recorded as each batch is stored so that an interrupted
+// import can be resumed by importing from the same root again; batches already stored are skipped.
+func (im *Importer) Import(ctx context.Context, root string) error {
 	start := time.Now()
-	im.ImportChannels = *snomed.Import(ctx, root, im.batchSize)
+	ctx, errs := newFirstError(ctx)
+	defer errs.cancel()
+	im.summaryMu.Lock()
+	im.summary = ImportSummary{FileErrors: make(map[string]int)}
+	im.summaryMu.Unlock()
+	opts := snomed.ImportOptions{BatchSize: im.batchSize, Release: im.release, Strict: im.strict}
+	opts.OnError = func(pe *snomed.ParseError) {
+		im.recordError(pe)
+		im.emit(ImportEvent{Type: ImportErrorEvent, Elapsed: time.Since(start), Error: pe})
+	}
+	if cp, ok := im.storer.(Checkpointer); ok {
+		im.checkpointer = cp
+		im.files = make(map[string]*fileProgress)
+		opts.SkipFile = func(filename string) bool {
+			return cp.ImportProgress(filename).Complete
+		}
+		opts.SkipBatch = func(p snomed.Provenance) bool {
+			return p.Batch < cp.ImportProgress(p.Filename).Batches
+		}
+	}
+	channels := snomed.ImportWithOptions(ctx, root, opts)
 	var conceptsWg, descriptionsWg, relationshipsWg, refsetsWg sync.WaitGroup
 	done := make(chan struct{})
-	if im.verbose {
+	if im.handler != nil {
 		go func() {
+			ticker := time.NewTicker(time.Second)
+			defer ticker.Stop()
 			for {
-				for _, r := range `-\|/` {
-					select {
-					case <-ctx.Done():
-						return
-					case <-done:
-						return
-					default:
-						im.progress(start, string(r)+" importing")
-						time.Sleep(1 * time.Second)
-					}
+				select {
+				case <-ctx.Done():
+					return
+				case <-done:
+					return
+				case <-ticker.C:
+					im.emit(ImportEvent{Type: ImportProgressEvent, Elapsed: time.Since(start)})
 				}
 			}
 		}()
@@ -96,28 +234,28 @@ func (im *Importer) Import(ctx context.Context, root string) {
 		conceptsWg.Add(1)
 		go func() {
 			defer conceptsWg.Done()
-			im.importConcepts(ctx, im.Concepts)
+			errs.set(im.importConcepts(ctx, channels.Concepts))
 		}()
 	}
 	for i := 0; i < im.threads; i++ {
 		descriptionsWg.Add(1)
 		go func() {
 			defer descriptionsWg.Done()
-			im.importDescriptions(ctx, im.Descriptions)
+			errs.set(im.importDescriptions(ctx, channels.Descriptions))
 		}()
 	}
 	for i := 0; i < im.threads; i++ {
 		relationshipsWg.Add(1)
 		go func() {
 			defer relationshipsWg.Done()
-			im.importRelationships(ctx, im.Relationships)
+			errs.set(im.importRelationships(ctx, channels.Relationships))
 		}()
 	}
 	for i := 0; i < im.threads; i++ {
 		refsetsWg.Add(1)
 		go func() {
 			defer refsetsWg.Done()
-			im.importRefsets(ctx, im.Refsets)
+			errs.set(im.importRefsets(ctx, channels.Refsets))
 		}()
 	}
 	conceptsWg.Wait()
@@ -125,85 +263,159 @@ func (im *Importer) Import(ctx context.Context, root string) {
 	relationshipsWg.Wait()
 	refsetsWg.Wait()
 	close(done)
-	im.progress(start, "Import complete. Processed: ")
+	errs.set(channels.Err())
+	im.summaryMu.Lock()
+	im.summary.Duration = time.Since(start)
+	im.summary.Counts = im.counts()
+	im.summaryMu.Unlock()
+	if err := errs.get(); err != nil {
+		return err
+	}
+	im.emit(ImportEvent{Type: ImportCompleteEvent, Elapsed: time.Since(start)})
+	return nil
 }
 
-func (im *Importer) progress(start time.Time, prefix string) {
-	fmt.Printf("\r%s: %s: %d concepts, %d descriptions, %d relationships and %d refset items...",
-		prefix,
-		time.Since(start),
-		atomic.LoadInt32(&im.nconcepts),
-		atomic.LoadInt32(&im.ndescriptions),
-		atomic.LoadInt32(&im.nrelationships),
-		atomic.LoadInt32(&im.nrefsets))
+// counts returns the number of components stored so far
+func (im *Importer) counts() ImportCounts {
+	return ImportCounts{
+		Concepts:          int(atomic.LoadInt32(&im.nconcepts)),
+		Descriptions:      int(atomic.LoadInt32(&im.ndescriptions)),
+		Relationships:     int(atomic.LoadInt32(&im.nrelationships)),
+		ReferenceSetItems: int(atomic.LoadInt32(&im.nrefsets)),
+	}
 }
 
-func (im *Importer) importConcepts(ctx context.Context, cc <-chan []*snomed.Concept) {
+// emit sends an event, with the current counts, to the event handler, if any
+func (im *Importer) emit(ev ImportEvent) {
+	if im.handler != nil {
+		ev.Counts = im.counts()
+		im.handler(ev)
+	}
+}
+
+// recordError records an error in the summary of the import
+func (im *Importer) recordError(pe *snomed.ParseError) {
+	im.summaryMu.Lock()
+	defer im.summaryMu.Unlock()
+	im.summary.ErrorCount++
+	im.summary.FileErrors[pe.Filename]++
+	if len(im.summary.Errors) < maxSummaryErrors {
+		im.summary.Errors = append(im.summary.Errors, pe)
+	}
+}
+
+// fileProgress tracks the batches stored from a single file, which may complete out of order
+type fileProgress struct {
+	FileCheckpoint
+	stored map[int]struct{} // batches stored beyond the contiguous checkpoint
+	last   int              // index of the final batch, or -1 if not yet known
+}
+
+// stored records that a batch has been stored, checkpointing progress through its file
+func (im *Importer) stored(p snomed.Provenance) error {
+	if im.checkpointer == nil {
+		return nil
+	}
+	im.filesMu.Lock()
+	defer im.filesMu.Unlock()
+	fp, ok := im.files[p.Filename]
+	if !ok {
+		fp = &fileProgress{FileCheckpoint: im.checkpointer.ImportProgress(p.Filename), stored: make(map[int]struct{}), last: -1}
+		im.files[p.Filename] = fp
+	}
+	fp.stored[p.Batch] = struct{}{}
+	if p.Last {
+		fp.last = p.Batch
+	}
+	before := fp.Batches
+	for {
+		if _, ok := fp.stored[fp.Batches]; !ok {
+			break
+		}
+		delete(fp.stored, fp.Batches)
+		fp.Batches++
+	}
+	if fp.Batches == before {
+		return nil
+	}
+	fp.Complete = fp.last >= 0 && fp.Batches > fp.last
+	return im.checkpointer.RecordImportProgress(p.Filename, fp.FileCheckpoint)
+}
+
+func (im *Importer) importConcepts(ctx context.Context, cc <-chan snomed.ConceptBatch) error {
 	for {
 		select {
 		case <-ctx.Done():
-			return
-		case batch := <-cc:
-			if batch == nil {
-				return
+			return nil
+		case batch, ok := <-cc:
+			if !ok {
+				return nil
+			}
+			if err := im.storer.Put(ctx, batch.Concepts); err != nil {
+				return fmt.Errorf("%s: %w", batch.Filename, err)
 			}
-			err := im.storer.Put(ctx, batch)
-			if err != nil {
-				panic(err)
+			atomic.AddInt32(&im.nconcepts, int32(len(batch.Concepts)))
+			if err := im.stored(batch.Provenance); err != nil {
+				return err
 			}
-			atomic.AddInt32(&im.nconcepts, int32(len(batch)))
 		}
 	}
 }
-func (im *Importer) importDescriptions(ctx context.Context, dd <-chan []*snomed.Description) {
+func (im *Importer) importDescriptions(ctx context.Context, dd <-chan snomed.DescriptionBatch) error {
 	for {
 		select {
 		case <-ctx.Done():
-			return
-		case batch := <-dd:
-			if batch == nil {
-				return
+			return nil
+		case batch, ok := <-dd:
+			if !ok {
+				return nil
 			}
-			err := im.storer.Put(ctx, batch)
-			if err != nil {
-				panic(err)
+			if err := im.storer.Put(ctx, batch.Descriptions); err != nil {
+				return fmt.Errorf("%s: %w", batch.Filename, err)
+			}
+			atomic.AddInt32(&im.ndescriptions, int32(len(batch.Descriptions)))
+			if err := im.stored(batch.Provenance); err != nil {
+				return err
 			}
-			atomic.AddInt32(&im.ndescriptions, int32(len(batch)))
 		}
 	}
 }
-func (im *Importer) importRelationships(ctx context.Context, rels <-chan []*snomed.Relationship) {
+func (im *Importer) importRelationships(ctx context.Context, rels <-chan snomed.RelationshipBatch) error {
 	for {
 		select {
 		case <-ctx.Done():
-			return
-		case batch := <-rels:
-			if batch == nil {
-				return
+			return nil
+		case batch, ok := <-rels:
+			if !ok {
+				return nil
+			}
+			if err := im.storer.Put(ctx, batch.Relationships); err != nil {
+				return fmt.Errorf("%s: %w", batch.Filename, err)
 			}
-			err := im.storer.Put(ctx, batch)
-			if err != nil {
-				panic(err)
+			atomic.AddInt32(&im.nrelationships, int32(len(batch.Relationships)))
+			if err := im.stored(batch.Provenance); err != nil {
+				return err
 			}
-			atomic.AddInt32(&im.nrelationships, int32(len(batch)))
 		}
 	}
 }
 
-func (im *Importer) importRefsets(ctx context.Context, refsets <-chan []*snomed.ReferenceSetItem) {
+func (im *Importer) importRefsets(ctx context.Context, refsets <-chan snomed.ReferenceSetItemBatch) error {
 	for {
 		select {
 		case <-ctx.Done():
-			return
-		case batch := <-refsets:
-			if batch == nil {
-				return
+			return nil
+		case batch, ok := <-refsets:
+			if !ok {
+				return nil
+			}
+			if err := im.storer.Put(ctx, batch.Items); err != nil {
+				return fmt.Errorf("%s: %w", batch.Filename, err)
 			}
-			err := im.storer.Put(ctx, batch)
-			if err != nil {
-				panic(err)
+			atomic.AddInt32(&im.nrefsets, int32(len(batch.Items)))
+			if err := im.stored(batch.Provenance); err != nil {
+				return err
 			}
-			atomic.AddInt32(&im.nrefsets, int32(len(batch)))
 		}
 	}
 }
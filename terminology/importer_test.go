@@ -0,0 +1,207 @@
+package terminology
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/wardle/go-terminology/snomed"
+)
+
+// interruptedStorer stores components until it reaches a specific concept, simulating an interruption
+type interruptedStorer struct {
+	*Svc
+	failAt   int64 // identifier of concept that fails to be stored, or zero
+	concepts int32 // number of concepts stored
+}
+
+func (is *interruptedStorer) Put(ctx context.Context, components interface{}) error {
+	if concepts, ok := components.([]*snomed.Concept); ok {
+		for _, c := range concepts {
+			if c.Id == is.failAt {
+				return errors.New("interrupted")
+			}
+		}
+		atomic.AddInt32(&is.concepts, int32(len(concepts)))
+	}
+	return is.Svc.Put(ctx, components)
+}
+
+func TestResumeImport(t *testing.T) {
+	filename := "importer-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	root, err := ioutil.TempDir("", "rf2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	ids := []int64{24700007, 6118003, 64572001, 22298006, 73211009}
+	var b strings.Builder
+	b.WriteString("id\teffectiveTime\tactive\tmoduleId\tdefinitionStatusId\n")
+	for _, id := range ids {
+		b.WriteString(fmt.Sprintf("%d\t20190731\t1\t900000000000207008\t900000000000074008\n", id))
+	}
+	conceptFile := filepath.Join(root, "sct2_Concept_Snapshot_INT_20190731.txt")
+	if err := ioutil.WriteFile(conceptFile, []byte(b.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	storer := &interruptedStorer{Svc: svc, failAt: 73211009}
+	if err := NewImporter(storer, 2, 1, false).Import(ctx, root); err == nil {
+		t.Fatal("interrupted import did not return an error")
+	}
+	progress := svc.ImportProgress(conceptFile)
+	if progress.Complete || progress.Batches > 2 {
+		t.Fatalf("incorrect checkpoint for interrupted import: %+v", progress)
+	}
+	resumed := &interruptedStorer{Svc: svc}
+	if err := NewImporter(resumed, 2, 1, false).Import(ctx, root); err != nil {
+		t.Fatal(err)
+	}
+	if expected := int32(len(ids) - 2*progress.Batches); resumed.concepts != expected {
+		t.Fatalf("resumed import stored %d concepts, expected %d", resumed.concepts, expected)
+	}
+	if progress := svc.ImportProgress(conceptFile); !progress.Complete || progress.Batches != 3 {
+		t.Fatalf("incorrect checkpoint for completed import: %+v", progress)
+	}
+	for _, id := range ids {
+		if _, err := svc.Concept(id); err != nil {
+			t.Fatalf("concept %d not imported: %v", id, err)
+		}
+	}
+	// a completed file is skipped entirely, until the checkpoints are cleared
+	again := &interruptedStorer{Svc: svc}
+	if err := NewImporter(again, 2, 1, false).Import(ctx, root); err != nil || again.concepts != 0 {
+		t.Fatalf("completed file imported again: %d concepts (%v)", again.concepts, err)
+	}
+	if err := svc.ClearImportCheckpoints(); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewImporter(again, 2, 1, false).Import(ctx, root); err != nil || again.concepts != int32(len(ids)) {
+		t.Fatalf("file not imported after clearing checkpoints: %d concepts (%v)", again.concepts, err)
+	}
+}
+
+func TestImportSummary(t *testing.T) {
+	root, err := ioutil.TempDir("", "rf2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	concepts := "id\teffectiveTime\tactive\tmoduleId\tdefinitionStatusId\n" +
+		"24700007\t20190731\t1\t900000000000207008\t900000000000074008\n" +
+		"6118003\t20190731\tyes\t900000000000207008\t900000000000074008\n"
+	if err := ioutil.WriteFile(filepath.Join(root, "sct2_Concept_Snapshot_INT_20190731.txt"), []byte(concepts), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var mu sync.Mutex
+	var events []ImportEvent
+	importer := NewImporter(&NoopStorer{}, 500, 1, false)
+	importer.SetEventHandler(func(ev ImportEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, ev)
+	})
+	if err := importer.Import(context.Background(), root); err != nil {
+		t.Fatal(err)
+	}
+	summary := importer.Summary()
+	if summary.Counts.Concepts != 1 || summary.ErrorCount != 1 || len(summary.Errors) != 1 || summary.Errors[0].Column != "active" {
+		t.Fatalf("incorrect summary: %v", &summary)
+	}
+	var errorEvents, completeEvents int
+	for _, ev := range events {
+		switch ev.Type {
+		case ImportErrorEvent:
+			errorEvents++
+		case ImportCompleteEvent:
+			completeEvents++
+		}
+	}
+	if errorEvents != 1 || completeEvents != 1 {
+		t.Fatalf("incorrect events: %v", events)
+	}
+	importer.SetStrict(true)
+	if err := importer.Import(context.Background(), root); err == nil {
+		t.Fatal("strict import did not fail")
+	}
+}
+
+// recordingSearch records the descriptions indexed
+type recordingSearch struct {
+	*bleveService
+	mu      sync.Mutex
+	indexed map[int64]bool // indexed descriptions, keyed by concept identifier
+}
+
+func (rs *recordingSearch) Index(eds []*snomed.ExtendedDescription) error {
+	rs.mu.Lock()
+	for _, ed := range eds {
+		rs.indexed[ed.Description.ConceptId] = true
+	}
+	rs.mu.Unlock()
+	return rs.bleveService.Index(eds)
+}
+
+func TestResumeSearchIndex(t *testing.T) {
+	filename := "index-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	ctx := context.Background()
+	root := snomed.Root.Integer()
+	ids := []int64{root, 6118003, 22298006, 24700007, 64572001, 73211009}
+	var concepts []*snomed.Concept
+	var descriptions []*snomed.Description
+	var relationships []*snomed.Relationship
+	for _, id := range ids {
+		concepts = append(concepts, &snomed.Concept{Id: id, Active: true})
+		descriptions = append(descriptions, &snomed.Description{Id: id*10 + 1, ConceptId: id, Active: true, TypeId: int64(snomed.Synonym), Term: fmt.Sprintf("Concept %d", id), LanguageCode: "en"})
+		if id != root {
+			relationships = append(relationships, &snomed.Relationship{Id: id*10 + 2, SourceId: id, TypeId: snomed.IsA, DestinationId: root, Active: true})
+		}
+	}
+	for _, c := range []interface{}{concepts, descriptions, relationships} {
+		if err := svc.Put(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	// simulate an interruption after indexing the concepts up to, and including, 24700007
+	if err := svc.recordPrecomputeStage(stageReferenceSets); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.recordSearchIndexProgress(24700007); err != nil {
+		t.Fatal(err)
+	}
+	rs := &recordingSearch{bleveService: svc.search.(*bleveService), indexed: make(map[int64]bool)}
+	svc.search = rs
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range ids {
+		if expected := id > 24700007; rs.indexed[id] != expected {
+			t.Errorf("concept %d: expected indexed %v, got %v", id, expected, rs.indexed[id])
+		}
+	}
+	if svc.precomputeStage() != stageNone || svc.searchIndexProgress() != 0 {
+		t.Errorf("checkpoint not cleared after precomputation: %+v", svc.Checkpoint)
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+)
+
+// flags for concepts pending re-indexing
+const (
+	pendingConcept   byte = 'c' // the concept itself has changed
+	pendingHierarchy byte = 'h' // the concept's IS-A relationships have changed, affecting it and its descendants
+)
+
+// markPending records that the specified concept needs re-indexing
+func markPending(batch Batch, conceptID int64, hierarchy bool) {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(conceptID))
+	if hierarchy {
+		batch.AddIndexEntry(ixPendingConcepts, key, []byte{pendingHierarchy})
+	} else {
+		batch.AddIndexEntry(ixPendingConcepts, key, []byte{pendingConcept})
+	}
+}
+
+// markPendingComponent records that the concept for the specified component needs re-indexing.
+// Components other than concepts and descriptions do not affect the search index.
+func markPendingComponent(batch Batch, componentID int64) error {
+	id := snomed.Identifier(componentID)
+	if id.IsConcept() {
+		markPending(batch, componentID, false)
+	} else if id.IsDescription() {
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, uint64(componentID))
+		var d snomed.Description
+		err := batch.Get(bkDescriptions, key, &d)
+		if err == ErrNotFound { // the concept will be marked when the description is stored
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		markPending(batch, d.ConceptId, false)
+	}
+	return nil
+}
+
+// pendingConcepts returns the concepts needing re-indexing, and those whose hierarchy has changed
+func (svc *Svc) pendingConcepts() (concepts map[int64]struct{}, hierarchy map[int64]struct{}, err error) {
+	concepts = make(map[int64]struct{})
+	hierarchy = make(map[int64]struct{})
+	err = svc.store.View(func(batch Batch) error {
+		entries, err := batch.GetIndexEntries(ixPendingConcepts, nil)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			conceptID := int64(binary.BigEndian.Uint64(entry[:8]))
+			concepts[conceptID] = struct{}{}
+			if entry[8] == pendingHierarchy {
+				hierarchy[conceptID] = struct{}{}
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// PendingPrecomputations returns the number of concepts awaiting incremental re-indexing
+// following updates to an already precomputed database.
+func (svc *Svc) PendingPrecomputations() (int, error) {
+	concepts, _, err := svc.pendingConcepts()
+	return len(concepts), err
+}
+
+// PerformIncrementalPrecomputations updates the precomputed indices and the search index
+// for only those concepts affected by components stored since the last precomputation,
+// such as after the import of a delta release into an existing, precomputed database.
+// Descriptions and reference set items are indexed as they are stored, but the IS-A hierarchy
+// is reconciled here, as a component may be stored in any order, before re-indexing the
+// affected concepts, and their descendants, in the search index.
+func (svc *Svc) PerformIncrementalPrecomputations(ctx context.Context, verbose bool) error {
+	start := time.Now()
+	concepts, hierarchy, err := svc.pendingConcepts()
+	if err != nil || len(concepts) == 0 {
+		return err
+	}
+	if verbose {
+		fmt.Printf("Updating indices for %d changed concepts...\n", len(concepts))
+	}
+	for conceptID := range hierarchy {
+		if err := svc.reconcileParents(conceptID); err != nil {
+			return err
+		}
+	}
+	svc.caches.purge()
+	for conceptID := range hierarchy {
+		if err := svc.allDescendants(ctx, conceptID, concepts); err != nil {
+			return err
+		}
+	}
+	if err := svc.refreshLanguages(); err != nil { // refresh list of available languages
+		return err
+	}
+	if verbose {
+		fmt.Printf("Updating search index for %d concepts...\n", len(concepts))
+	}
+	if err := svc.reindexConcepts(ctx, concepts, verbose); err != nil {
+		return err
+	}
+	if err := svc.recordRelease(); err != nil {
+		return err
+	}
+	if err := svc.store.Update(func(batch Batch) error {
+		batch.ClearIndexEntries(ixPendingConcepts)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if verbose {
+		fmt.Printf("Incremental precomputations complete. Total time: %s\n", time.Since(start))
+	}
+	return nil
+}
+
+// reconcileParents updates the parent and children indices for a concept to match its active IS-A relationships
+func (svc *Svc) reconcileParents(conceptID int64) error {
+	rels, err := svc.ParentRelationships(conceptID)
+	if err != nil {
+		return err
+	}
+	active := make(map[int64]struct{})
+	for _, r := range rels {
+		if r.Active && r.TypeId == snomed.IsA {
+			active[r.DestinationId] = struct{}{}
+		}
+	}
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(conceptID))
+	return svc.store.Update(func(batch Batch) error {
+		current, err := batch.GetIndexEntries(ixConceptParents, key)
+		if err != nil {
+			return err
+		}
+		for _, parent := range current {
+			parentID := int64(binary.BigEndian.Uint64(parent))
+			if _, ok := active[parentID]; ok {
+				delete(active, parentID)
+				continue
+			}
+			batch.DeleteIndexEntry(ixConceptParents, key, parent)
+			batch.DeleteIndexEntry(ixConceptChildren, parent, key)
+		}
+		for parentID := range active {
+			parent := make([]byte, 8)
+			binary.BigEndian.PutUint64(parent, uint64(parentID))
+			batch.AddIndexEntry(ixConceptParents, key, parent)
+			batch.AddIndexEntry(ixConceptChildren, parent, key)
+		}
+		return nil
+	})
+}
+
+// allDescendants adds all of the descendants of the specified concept to the set given
+func (svc *Svc) allDescendants(ctx context.Context, conceptID int64, result map[int64]struct{}) error {
+	visited := map[int64]struct{}{conceptID: {}}
+	queue := []int64{conceptID}
+	for len(queue) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		children, err := svc.Children(queue[0])
+		if err != nil {
+			return err
+		}
+		queue = queue[1:]
+		for _, child := range children {
+			if _, ok := visited[child]; ok {
+				continue
+			}
+			visited[child] = struct{}{}
+			result[child] = struct{}{}
+			queue = append(queue, child)
+		}
+	}
+	return nil
+}
+
+// reindexConcepts replaces the search index documents for all of the descriptions of the specified concepts
+func (svc *Svc) reindexConcepts(ctx context.Context, concepts map[int64]struct{}, verbose bool) error {
+	tags := svc.searchIndexTags()
+	ids := make([]int64, 0, len(concepts))
+	for id := range concepts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	batchSize := 10000
+	batch := make([]*snomed.ExtendedDescription, 0, batchSize)
+	total := 0
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		concept, err := svc.Concept(id)
+		if err == ErrNotFound { // e.g. a refset member referencing a concept not in this distribution
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		eds, err := svc.extendedDescriptions(concept, tags)
+		if err != nil {
+			return err
+		}
+		batch = append(batch, eds...)
+		if len(batch) >= batchSize {
+			if err := svc.search.Index(batch); err != nil {
+				return err
+			}
+			total += len(batch)
+			if verbose {
+				fmt.Fprintf(os.Stderr, "\rSearch index: processed %d descriptions...", total)
+			}
+			batch = make([]*snomed.ExtendedDescription, 0, batchSize)
+		}
+	}
+	total += len(batch)
+	if verbose {
+		fmt.Fprintf(os.Stderr, "\rSearch index: processed %d descriptions.\n", total)
+	}
+	return svc.search.Index(batch)
+}
@@ -0,0 +1,110 @@
+package terminology
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestIncrementalPrecomputations(t *testing.T) {
+	filename := "incremental-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	ctx := context.Background()
+	d1 := timestamppb.New(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC))
+	d2 := timestamppb.New(time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC))
+	synonym := int64(snomed.Synonym)
+	root := snomed.Root.Integer()
+	concepts := []*snomed.Concept{
+		{Id: root, EffectiveTime: d1, Active: true},
+		{Id: snomed.IsA, EffectiveTime: d1, Active: true},
+		{Id: 24700007, EffectiveTime: d1, Active: true},
+		{Id: 6118003, EffectiveTime: d1, Active: true},
+		{Id: 64572001, EffectiveTime: d1, Active: true},
+	}
+	descriptions := []*snomed.Description{
+		{Id: 220309016, ConceptId: root, EffectiveTime: d1, Active: true, Term: "SNOMED CT Concept", TypeId: synonym, LanguageCode: "en"},
+		{Id: 181114011, ConceptId: snomed.IsA, EffectiveTime: d1, Active: true, Term: "Is a", TypeId: synonym, LanguageCode: "en"},
+		{Id: 41398015, ConceptId: 24700007, EffectiveTime: d1, Active: true, Term: "Multiple sclerosis", TypeId: synonym, LanguageCode: "en"},
+		{Id: 11161017, ConceptId: 6118003, EffectiveTime: d1, Active: true, Term: "Demyelinating disease", TypeId: synonym, LanguageCode: "en"},
+		{Id: 1230985017, ConceptId: 64572001, EffectiveTime: d1, Active: true, Term: "Disease", TypeId: synonym, LanguageCode: "en"},
+	}
+	relationships := []*snomed.Relationship{
+		{Id: 1, Active: true, EffectiveTime: d1, SourceId: 6118003, DestinationId: root, TypeId: snomed.IsA},
+		{Id: 2, Active: true, EffectiveTime: d1, SourceId: 64572001, DestinationId: root, TypeId: snomed.IsA},
+		{Id: 3, Active: true, EffectiveTime: d1, SourceId: 24700007, DestinationId: 6118003, TypeId: snomed.IsA},
+	}
+	for _, components := range []interface{}{concepts, descriptions, relationships} {
+		if err := svc.Put(ctx, components); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	// now apply a delta: move multiple sclerosis and add a new synonym
+	delta := []interface{}{
+		[]*snomed.Relationship{
+			{Id: 3, Active: false, EffectiveTime: d2, SourceId: 24700007, DestinationId: 6118003, TypeId: snomed.IsA},
+			{Id: 4, Active: true, EffectiveTime: d2, SourceId: 24700007, DestinationId: 64572001, TypeId: snomed.IsA},
+		},
+		[]*snomed.Description{
+			{Id: 1223979019, ConceptId: 24700007, EffectiveTime: d2, Active: true, Term: "Disseminated sclerosis", TypeId: synonym, LanguageCode: "en"},
+		},
+	}
+	for _, components := range delta {
+		if err := svc.Put(ctx, components); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n, err := svc.PendingPrecomputations(); err != nil || n != 1 {
+		t.Fatalf("expected one pending concept, got %d (%v)", n, err)
+	}
+	if err := svc.PerformIncrementalPrecomputations(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := svc.PendingPrecomputations(); err != nil || n != 0 {
+		t.Fatalf("expected no pending concepts, got %d (%v)", n, err)
+	}
+	if v := svc.ReleaseVersion(); v != "20190201" {
+		t.Fatalf("release version not updated incrementally: %s", v)
+	}
+	parents, err := svc.Parents(24700007)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parents) != 1 || parents[0] != 64572001 {
+		t.Fatalf("parents not updated incrementally: %v", parents)
+	}
+	children, err := svc.Children(6118003)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 0 {
+		t.Fatalf("children not updated incrementally: %v", children)
+	}
+	tags := []language.Tag{language.BritishEnglish}
+	response, err := svc.Search(ctx, &snomed.SearchRequest{S: "dissem", IsA: []int64{64572001}}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Items) != 1 || response.Items[0].ConceptId != 24700007 {
+		t.Fatalf("search index not updated incrementally: %v", response.Items)
+	}
+	response, err = svc.Search(ctx, &snomed.SearchRequest{S: "multiple", IsA: []int64{6118003}}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Items) != 0 {
+		t.Fatalf("search index returned concept from its previous location in the hierarchy: %v", response.Items)
+	}
+}
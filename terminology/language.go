@@ -17,23 +17,25 @@ package terminology
 
 import (
 	"sort"
+	"strconv"
 
+	"github.com/wardle/go-terminology/snomed"
 	"golang.org/x/text/language"
 )
 
-// Language defines a mapping between standard ISO language tags and the associated SNOMED-CT language reference sets
-// TODO: add more supported languages
-// TODO: check that the refset identifiers are correct
-// TODO: add tests for other languages
+// Language defines a mapping between standard ISO language tags and well-known SNOMED-CT language reference sets.
+// Other language reference sets are discovered from the reference set descriptor of the installed distribution.
 type Language int
 
-// Supported languages
+// Well-known languages
 const (
 	AmericanEnglish Language = iota
 	BritishEnglish
 	French
 	Spanish
 	Danish
+	Dutch
+	Swedish
 	lastLanguage
 )
 
@@ -43,6 +45,8 @@ var tags = map[Language]language.Tag{
 	French:          language.French,
 	Spanish:         language.Spanish,
 	Danish:          language.Danish,
+	Dutch:           language.Dutch,
+	Swedish:         language.Swedish,
 }
 
 var identifiers = map[Language]int64{
@@ -50,7 +54,9 @@ var identifiers = map[Language]int64{
 	BritishEnglish:  999001261000000100,
 	French:          722131000,
 	Spanish:         450828004,
-	Danish:          31000146106,
+	Danish:          554461000005103,
+	Dutch:           31000146106,
+	Swedish:         46011000052107,
 }
 
 // Tag returns the language tag for this language
@@ -80,22 +86,224 @@ func LanguageForTag(tag language.Tag) Language {
 
 // AvailableLanguages returns the languages supported by the currently installed distribution
 // Note: the sorting of the results is important for language matching, because the first matching language
-// will be chosen, so we finish by sorting the result.
+// will be chosen, so well-known languages are listed first, in order, followed by any others discovered.
+// A language may be listed more than once, if more than one reference set is installed for it.
 func (svc *Svc) AvailableLanguages() ([]language.Tag, error) {
+	available, _, err := svc.discoverLanguages()
+	return available, err
+}
+
+// LanguageReferenceSets returns the language of each installed language reference set, keyed by reference set identifier
+func (svc *Svc) LanguageReferenceSets() map[int64]language.Tag {
+	available, refsets := svc.installedLanguages()
+	result := make(map[int64]language.Tag, len(refsets))
+	for i, refsetID := range refsets {
+		result[refsetID] = available[i]
+	}
+	return result
+}
+
+// installedLanguages returns the cached list of available languages, and the reference set for each
+func (svc *Svc) installedLanguages() ([]language.Tag, []int64) {
+	svc.languageMu.RLock()
+	defer svc.languageMu.RUnlock()
+	return svc.availableLanguages, svc.languageRefsets
+}
+
+// refreshLanguages updates the cached list of available languages and their reference sets
+func (svc *Svc) refreshLanguages() error {
+	available, refsets, err := svc.discoverLanguages()
+	if err != nil {
+		return err
+	}
+	svc.languageMu.Lock()
+	defer svc.languageMu.Unlock()
+	svc.availableLanguages, svc.languageRefsets = available, refsets
+	return nil
+}
+
+// discoverLanguages determines the installed language reference sets, and the language of each, returning
+// the languages and their reference sets in the same order.
+// A reference set is a language reference set if it is well-known, or if the reference set descriptor
+// defines it as having an acceptability attribute. The language of a reference set that is not well-known
+// is configured, or determined from the language code of the descriptions that it references, distinguished
+// as a dialect by dialectTag.
+func (svc *Svc) discoverLanguages() ([]language.Tag, []int64, error) {
 	installed, err := svc.InstalledReferenceSets()
 	if err != nil && err != ErrDatabaseNotInitialised {
+		return nil, nil, err
+	}
+	available := make([]language.Tag, 0)
+	refsets := make([]int64, 0)
+	known := make(map[int64]struct{})
+	used := make(map[language.Tag]struct{})
+	for l := AmericanEnglish; l < lastLanguage; l++ {
+		if _, ok := installed[identifiers[l]]; ok {
+			available = append(available, tags[l])
+			refsets = append(refsets, identifiers[l])
+			known[identifiers[l]] = struct{}{}
+			used[tags[l]] = struct{}{}
+		}
+	}
+	described, err := svc.describedLanguageReferenceSets()
+	if err != nil {
+		return nil, nil, err
+	}
+	type discoveredLanguage struct {
+		tag      language.Tag
+		refsetID int64
+	}
+	discovered := make([]discoveredLanguage, 0)
+	for _, refsetID := range described {
+		if _, ok := installed[refsetID]; !ok {
+			continue
+		}
+		if _, ok := known[refsetID]; ok {
+			continue
+		}
+		tag, ok := svc.configuredLanguageTag(refsetID)
+		if !ok {
+			code, err := svc.referenceSetLanguageCode(refsetID)
+			if err != nil {
+				return nil, nil, err
+			}
+			if tag, err = language.Parse(code); err != nil {
+				continue // not a valid language code, so cannot be matched
+			}
+			tag = dialectTag(refsetID, tag, used)
+		}
+		used[tag] = struct{}{}
+		discovered = append(discovered, discoveredLanguage{tag: tag, refsetID: refsetID})
+	}
+	sort.SliceStable(discovered, func(i, j int) bool { // described reference sets are already in order of identifier
+		return discovered[i].tag.String() < discovered[j].tag.String()
+	})
+	for _, d := range discovered {
+		available = append(available, d.tag)
+		refsets = append(refsets, d.refsetID)
+	}
+	return available, refsets, nil
+}
+
+// namespaceRegions are the regions of the national release centres to which namespaces are allocated,
+// used to distinguish the dialects of a language in the reference sets of different national editions
+var namespaceRegions = map[int64]string{
+	1000000: "GB",
+	1000005: "DK",
+	1000036: "AU",
+	1000052: "SE",
+	1000087: "CA",
+	1000124: "US",
+	1000146: "NL",
+	1000172: "BE",
+	1000195: "CH",
+	1000202: "NO",
+	1000210: "NZ",
+	1000220: "IE",
+}
+
+// dialectTag returns a distinct tag for a language reference set, given the language of the descriptions that it
+// references, which is usually only a language without a region. The region of the namespace of the reference set
+// is added, if known, and if the tag is still used by another reference set, the item identifier of the reference set
+// is added as a private use subtag, so that each reference set can be requested.
+func dialectTag(refsetID int64, tag language.Tag, used map[language.Tag]struct{}) language.Tag {
+	if _, confidence := tag.Region(); confidence != language.Exact {
+		if region, ok := namespaceRegions[snomed.Identifier(refsetID).Namespace()]; ok {
+			if t, err := language.Compose(tag, language.MustParseRegion(region)); err == nil {
+				tag = t
+			}
+		}
+	}
+	if _, exists := used[tag]; !exists {
+		return tag
+	}
+	id := strconv.FormatInt(refsetID, 10)
+	item := id[:len(id)-3] // without partition and check digit
+	if snomed.Identifier(refsetID).Namespace() != 0 {
+		item = id[:len(id)-10] // and without namespace
+	}
+	if len(item) > 8 { // the maximum length of a subtag
+		item = item[len(item)-8:]
+	}
+	if t, err := language.Parse(tag.String() + "-x-" + item); err == nil {
+		return t
+	}
+	return tag
+}
+
+// SetLanguageTag configures the language of a language reference set that is not well-known, such as to distinguish
+// a dialect, rather than determining it from the descriptions that it references.
+func (svc *Svc) SetLanguageTag(refsetID int64, tag language.Tag) error {
+	if err := svc.updateDescriptor(func(d *Descriptor) {
+		if d.Languages == nil {
+			d.Languages = make(map[int64]string)
+		}
+		d.Languages[refsetID] = tag.String()
+	}); err != nil {
+		return err
+	}
+	err := svc.refreshLanguages()
+	svc.caches.purge()
+	return err
+}
+
+// configuredLanguageTag returns the language configured for a language reference set, if any
+func (svc *Svc) configuredLanguageTag(refsetID int64) (language.Tag, bool) {
+	svc.descriptorMu.Lock()
+	defer svc.descriptorMu.Unlock()
+	s, ok := svc.Descriptor.Languages[refsetID]
+	if !ok {
+		return language.Und, false
+	}
+	tag, err := language.Parse(s)
+	return tag, err == nil
+}
+
+// describedLanguageReferenceSets returns the reference sets defined by the reference set descriptor
+// as having an acceptability attribute, in order of identifier.
+func (svc *Svc) describedLanguageReferenceSets() ([]int64, error) {
+	components, err := svc.ReferenceSetComponents(snomed.RefsetDescriptorReferenceSet)
+	if err != nil {
 		return nil, err
 	}
-	allTags := make([]language.Tag, 0)
-	for l, t := range tags {
-		if refsetID := identifiers[l]; refsetID != 0 {
-			if _, ok := installed[refsetID]; ok {
-				allTags = append(allTags, t)
+	result := make([]int64, 0)
+	for refsetID := range components {
+		items, err := svc.ComponentFromReferenceSet(snomed.RefsetDescriptorReferenceSet, refsetID)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if item.Active && item.GetRefsetDescriptor().GetAttributeDescriptionId() == snomed.AcceptabilityAttribute {
+				result = append(result, refsetID)
+				break
 			}
 		}
 	}
-	sort.Slice(allTags, func(i, j int) bool {
-		return LanguageForTag(allTags[i]) < LanguageForTag(allTags[j])
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result, nil
+}
+
+// referenceSetLanguageCode returns the language code of the first description referenced by a reference set
+func (svc *Svc) referenceSetLanguageCode(refsetID int64) (string, error) {
+	var code string
+	err := svc.store.View(func(batch Batch) error {
+		prefix := len(ixReferenceSetComponentItems.name()) + 8
+		err := batch.Iterate(ixReferenceSetComponentItems, sctKey(refsetID), func(key, value []byte) error {
+			var d snomed.Description
+			err := batch.Get(bkDescriptions, key[prefix:prefix+8], &d)
+			if err == ErrNotFound {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			code = d.LanguageCode
+			return errStopIteration
+		})
+		if err == errStopIteration {
+			return nil
+		}
+		return err
 	})
-	return allTags, nil
+	return code, err
 }
@@ -0,0 +1,303 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Edition is a set of modules, with optional version constraints, that together form a consistent
+// distribution, such as the UK edition. Once declared, only components from these modules are imported,
+// and a conflict between versions of a component from different modules is resolved in favour of
+// the module listed first, rather than the most recent version.
+type Edition struct {
+	Name    string
+	Modules []ModuleConstraint // in order of precedence, highest first
+}
+
+// ModuleConstraint is a module within an edition, with the versions permitted
+type ModuleConstraint struct {
+	ModuleID   int64
+	MinVersion string `json:",omitempty"` // earliest permitted version (YYYYMMDD), or empty for any
+	MaxVersion string `json:",omitempty"` // latest permitted version (YYYYMMDD), or empty for any
+}
+
+// versions returns a description of the versions permitted
+func (mc ModuleConstraint) versions() string {
+	switch {
+	case mc.MinVersion == "" && mc.MaxVersion == "":
+		return "any"
+	case mc.MinVersion == mc.MaxVersion:
+		return mc.MinVersion
+	case mc.MaxVersion == "":
+		return ">=" + mc.MinVersion
+	case mc.MinVersion == "":
+		return "<=" + mc.MaxVersion
+	}
+	return mc.MinVersion + "-" + mc.MaxVersion
+}
+
+// Module is a module installed in the database
+type Module struct {
+	ID           int64
+	Version      string             // version (YYYYMMDD) declared in the module dependency reference set, if any
+	Latest       string             // latest effective time (YYYYMMDD) of any concept or description in the module
+	Dependencies []ModuleDependency // modules, and their versions, required by this version of the module
+}
+
+// ModuleDependency is a dependency of one module upon a specific version of another
+type ModuleDependency struct {
+	ModuleID int64
+	Version  string // required version (YYYYMMDD)
+}
+
+// UnsatisfiedDependency is a module dependency, or a constraint of the declared edition, that is not satisfied
+type UnsatisfiedDependency struct {
+	ModuleID         int64  // module with the dependency, or zero for a constraint of the edition
+	DependsOn        int64  // module required
+	RequiredVersion  string // version required, or a range of versions for a constraint of the edition
+	InstalledVersion string // version installed, or empty if the module is not installed
+}
+
+func (ud UnsatisfiedDependency) String() string {
+	installed := ud.InstalledVersion
+	if installed == "" {
+		installed = "not installed"
+	}
+	if ud.ModuleID == 0 {
+		return fmt.Sprintf("edition requires module %d version %s: %s", ud.DependsOn, ud.RequiredVersion, installed)
+	}
+	return fmt.Sprintf("module %d requires module %d version %s: %s", ud.ModuleID, ud.DependsOn, ud.RequiredVersion, installed)
+}
+
+// version returns the version of the module; the declared version if known, or the latest effective time of its components
+func (m *Module) version() string {
+	if m.Version != "" {
+		return m.Version
+	}
+	return m.Latest
+}
+
+// ReadEdition reads the declaration of an edition from a file in JSON format
+func ReadEdition(filename string) (*Edition, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var e Edition
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("invalid edition %s: %w", filename, err)
+	}
+	return &e, nil
+}
+
+// validate checks that the edition is well-formed
+func (e *Edition) validate() error {
+	if len(e.Modules) == 0 {
+		return fmt.Errorf("edition '%s' has no modules", e.Name)
+	}
+	seen := make(map[int64]struct{})
+	for _, mc := range e.Modules {
+		if _, dup := seen[mc.ModuleID]; dup {
+			return fmt.Errorf("edition '%s': module %d listed more than once", e.Name, mc.ModuleID)
+		}
+		seen[mc.ModuleID] = struct{}{}
+		for _, v := range []string{mc.MinVersion, mc.MaxVersion} {
+			if v == "" {
+				continue
+			}
+			if _, err := time.Parse("20060102", v); err != nil {
+				return fmt.Errorf("edition '%s': invalid version for module %d: %w", e.Name, mc.ModuleID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// SetEdition declares the edition to be imported, or clears it if nil. This should be set before
+// importing, as it determines which components are imported and how conflicts between modules are resolved.
+func (svc *Svc) SetEdition(e *Edition) error {
+	if e != nil {
+		if err := e.validate(); err != nil {
+			return err
+		}
+	}
+	return svc.updateDescriptor(func(d *Descriptor) {
+		d.Edition = e
+	})
+}
+
+// editionRules determine which components are imported, and how conflicting versions are resolved,
+// mapping each module in the declared edition to its precedence, with zero the highest.
+// A nil map means that no edition has been declared.
+type editionRules map[int64]int
+
+func (svc *Svc) editionRules() editionRules {
+	svc.descriptorMu.Lock()
+	defer svc.descriptorMu.Unlock()
+	if svc.Edition == nil {
+		return nil
+	}
+	rules := make(editionRules, len(svc.Edition.Modules))
+	for i, mc := range svc.Edition.Modules {
+		rules[mc.ModuleID] = i
+	}
+	return rules
+}
+
+// includes returns whether components from the module specified should be imported
+func (er editionRules) includes(moduleID int64) bool {
+	if er == nil {
+		return true
+	}
+	_, ok := er[moduleID]
+	return ok
+}
+
+// supersedes returns whether a version of a component should replace the existing version of that component.
+// Versions from different modules in the edition are resolved by precedence, and otherwise the most recent wins.
+func (er editionRules) supersedes(moduleID int64, effectiveTime *timestamppb.Timestamp, existingModuleID int64, existingTime *timestamppb.Timestamp) bool {
+	if moduleID != existingModuleID {
+		rank, ok1 := er[moduleID]
+		existingRank, ok2 := er[existingModuleID]
+		if ok1 && ok2 {
+			return rank < existingRank
+		}
+	}
+	return effectiveTime.AsTime().After(existingTime.AsTime())
+}
+
+// Modules returns the modules installed, ordered by identifier, with their versions and dependencies
+// as declared in the module dependency reference set.
+// This requires that precomputations have been performed.
+func (svc *Svc) Modules() ([]*Module, error) {
+	modules := make(map[int64]*Module)
+	module := func(moduleID int64) *Module {
+		m, ok := modules[moduleID]
+		if !ok {
+			m = &Module{ID: moduleID}
+			modules[moduleID] = m
+		}
+		return m
+	}
+	svc.descriptorMu.Lock()
+	for moduleID, latest := range svc.Descriptor.Modules {
+		module(moduleID).Latest = latest
+	}
+	svc.descriptorMu.Unlock()
+	targets, err := svc.ReferenceSetComponents(snomed.ModuleDependencyRefset)
+	if err != nil {
+		return nil, err
+	}
+	type dependency struct {
+		source int64
+		ModuleDependency
+		sourceVersion string
+	}
+	var dependencies []dependency
+	for targetID := range targets {
+		items, err := svc.ComponentFromReferenceSet(snomed.ModuleDependencyRefset, targetID)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			md := item.GetModuleDependency()
+			if !item.Active || md == nil {
+				continue
+			}
+			dep := dependency{
+				source:           item.ModuleId,
+				ModuleDependency: ModuleDependency{ModuleID: targetID, Version: md.TargetEffectiveTime.AsTime().Format("20060102")},
+				sourceVersion:    md.SourceEffectiveTime.AsTime().Format("20060102"),
+			}
+			if m := module(dep.source); dep.sourceVersion > m.Version {
+				m.Version = dep.sourceVersion
+			}
+			dependencies = append(dependencies, dep)
+		}
+	}
+	// only report the dependencies of the installed version of each module
+	for _, dep := range dependencies {
+		if m := modules[dep.source]; m.Version == dep.sourceVersion {
+			m.Dependencies = append(m.Dependencies, dep.ModuleDependency)
+		}
+	}
+	result := make([]*Module, 0, len(modules))
+	for _, m := range modules {
+		sort.Slice(m.Dependencies, func(i, j int) bool { return m.Dependencies[i].ModuleID < m.Dependencies[j].ModuleID })
+		result = append(result, m)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+// CheckDependencies checks that the dependencies of the installed modules, or of the modules in the declared
+// edition, are satisfied, and that the installed modules meet the version constraints of that edition.
+// A dependency is satisfied if the version of the module installed is that required; for a module without
+// a declared version, none of its components must be more recent than the version required.
+// This requires that precomputations have been performed.
+func (svc *Svc) CheckDependencies() ([]UnsatisfiedDependency, error) {
+	modules, err := svc.Modules()
+	if err != nil {
+		return nil, err
+	}
+	installed := make(map[int64]*Module, len(modules))
+	for _, m := range modules {
+		installed[m.ID] = m
+	}
+	svc.descriptorMu.Lock()
+	edition := svc.Edition
+	svc.descriptorMu.Unlock()
+	result := make([]UnsatisfiedDependency, 0)
+	if edition != nil {
+		for _, mc := range edition.Modules {
+			m, ok := installed[mc.ModuleID]
+			if ok && (mc.MinVersion == "" || m.version() >= mc.MinVersion) && (mc.MaxVersion == "" || m.version() <= mc.MaxVersion) {
+				continue
+			}
+			ud := UnsatisfiedDependency{DependsOn: mc.ModuleID, RequiredVersion: mc.versions()}
+			if ok {
+				ud.InstalledVersion = m.version()
+			}
+			result = append(result, ud)
+		}
+	}
+	rules := svc.editionRules()
+	for _, m := range modules {
+		if !rules.includes(m.ID) {
+			continue
+		}
+		for _, dep := range m.Dependencies {
+			target, ok := installed[dep.ModuleID]
+			if ok && (target.Version == dep.Version || target.Version == "" && target.Latest <= dep.Version) {
+				continue
+			}
+			ud := UnsatisfiedDependency{ModuleID: m.ID, DependsOn: dep.ModuleID, RequiredVersion: dep.Version}
+			if ok {
+				ud.InstalledVersion = target.version()
+			}
+			result = append(result, ud)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,110 @@
+package terminology
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEdition(t *testing.T) {
+	filename := "module-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	uk, core, model, excluded := int64(999000011000000103), int64(900000000000207008), int64(900000000000012004), int64(449080006)
+	edition := &Edition{Name: "UK", Modules: []ModuleConstraint{{ModuleID: uk}, {ModuleID: core}, {ModuleID: model}}}
+	if err := svc.SetEdition(edition); err != nil {
+		t.Fatal(err)
+	}
+	date := func(s string) *timestamppb.Timestamp {
+		d, err := time.Parse("20060102", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return timestamppb.New(d)
+	}
+	synonym := int64(snomed.Synonym)
+	ctx := context.Background()
+	components := []interface{}{
+		[]*snomed.Concept{
+			{Id: 900000000000441003, ModuleId: model, EffectiveTime: date("20190131"), Active: true},
+			{Id: 24700007, ModuleId: uk, EffectiveTime: date("20190131"), Active: false},
+			{Id: 24700007, ModuleId: core, EffectiveTime: date("20190731"), Active: true},
+			{Id: 22298006, ModuleId: excluded, EffectiveTime: date("20190731"), Active: true},
+			{Id: 73211009, ModuleId: uk, EffectiveTime: date("20191001"), Active: true},
+		},
+		[]*snomed.Description{
+			{Id: 900000000000896014, ConceptId: 900000000000441003, ModuleId: model, EffectiveTime: date("20190131"), Active: true, Term: "SNOMED CT Model Component", TypeId: synonym, LanguageCode: "en"},
+			{Id: 41398015, ConceptId: 24700007, ModuleId: core, EffectiveTime: date("20190731"), Active: true, Term: "Multiple sclerosis", TypeId: synonym, LanguageCode: "en"},
+			{Id: 121589010, ConceptId: 73211009, ModuleId: uk, EffectiveTime: date("20191001"), Active: true, Term: "Diabetes mellitus", TypeId: synonym, LanguageCode: "en"},
+		},
+		[]*snomed.ReferenceSetItem{
+			{Id: "1244116f-ec4f-4e2b-8d7a-22b7b4a0c001", ModuleId: uk, EffectiveTime: date("20191001"), Active: true, RefsetId: snomed.ModuleDependencyRefset, ReferencedComponentId: core,
+				Body: &snomed.ReferenceSetItem_ModuleDependency{ModuleDependency: &snomed.ModuleDependencyReferenceSet{SourceEffectiveTime: date("20191001"), TargetEffectiveTime: date("20190731")}}},
+			{Id: "1244116f-ec4f-4e2b-8d7a-22b7b4a0c002", ModuleId: core, EffectiveTime: date("20190731"), Active: true, RefsetId: snomed.ModuleDependencyRefset, ReferencedComponentId: model,
+				Body: &snomed.ReferenceSetItem_ModuleDependency{ModuleDependency: &snomed.ModuleDependencyReferenceSet{SourceEffectiveTime: date("20190731"), TargetEffectiveTime: date("20190731")}}},
+		},
+	}
+	for _, c := range components {
+		if err := svc.Put(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Concept(22298006); err != ErrNotFound {
+		t.Fatalf("concept from module outside edition imported: %v", err)
+	}
+	// the UK module takes precedence, even though the version from the core module is more recent
+	if c, err := svc.Concept(24700007); err != nil || c.ModuleId != uk || c.Active {
+		t.Fatalf("conflict not resolved by module precedence: %v (%v)", c, err)
+	}
+	modules, err := svc.Modules()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(modules) != 3 {
+		t.Fatalf("expected 3 modules, got %v", modules)
+	}
+	for _, m := range modules {
+		switch m.ID {
+		case uk:
+			if m.Version != "20191001" || len(m.Dependencies) != 1 || m.Dependencies[0].ModuleID != core {
+				t.Errorf("incorrect UK module: %+v", m)
+			}
+		case model:
+			if m.Version != "" || m.Latest != "20190131" || len(m.Dependencies) != 0 {
+				t.Errorf("incorrect model component module: %+v", m)
+			}
+		}
+	}
+	unsatisfied, err := svc.CheckDependencies()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unsatisfied) != 0 {
+		t.Fatalf("unexpected unsatisfied dependencies: %v", unsatisfied)
+	}
+	edition.Modules[0].MinVersion = "20200401"
+	if err := svc.SetEdition(edition); err != nil {
+		t.Fatal(err)
+	}
+	unsatisfied, err = svc.CheckDependencies()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unsatisfied) != 1 || unsatisfied[0].DependsOn != uk || unsatisfied[0].InstalledVersion != "20191001" {
+		t.Fatalf("edition version constraint not checked: %v", unsatisfied)
+	}
+	if err := svc.SetEdition(&Edition{Name: "invalid", Modules: []ModuleConstraint{{ModuleID: uk}, {ModuleID: uk}}}); err == nil {
+		t.Fatal("invalid edition accepted")
+	}
+}
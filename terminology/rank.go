@@ -0,0 +1,159 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+)
+
+// defaultMaximumHits is the number of results returned by a search, if not specified
+const defaultMaximumHits = 100
+
+// minimumCandidates is the minimum number of candidates obtained from the search index for ranking
+const minimumCandidates = 50
+
+// Weights of each of the factors by which search results are ranked
+const (
+	rankRelevance = 1.0 // relevance as determined by the search index, relative to the most relevant
+	rankExact     = 2.0 // the term matches the search string exactly
+	rankPrefix    = 1.0 // the term starts with the search string
+	rankPreferred = 1.0 // the term is the preferred synonym in the requested language
+	rankShort     = 0.5 // shorter terms, in inverse proportion to the number of characters
+	rankRefset    = 1.0 // the concept is in one of the reference sets to be boosted
+	rankHint      = 1.0 // the concept is one of, or a descendant of one of, the concepts given as context
+	rankFeedback  = 1.5 // the concept has been selected for similar searches, relative to the most frequently selected
+)
+
+// rankedHit is a search result, with the components needed to rank and to return that result
+type rankedHit struct {
+	description *snomed.Description
+	preferred   *snomed.Description
+	score       float64
+	local       bool // whether the description is a synonym from a local dictionary
+}
+
+// rank orders the hits from the search index, returning those that are most highly ranked.
+func (svc *Svc) rank(req *snomed.SearchRequest, tags []language.Tag, hits []SearchHit, maximum int) ([]rankedHit, error) {
+	s := strings.ToLower(strings.Join(strings.Fields(req.GetS()), " "))
+	var best float64
+	for _, hit := range hits {
+		if hit.Score > best {
+			best = hit.Score
+		}
+	}
+	boostRefsets := make(map[int64]struct{}, len(req.GetBoostRefsets()))
+	for _, refsetID := range req.GetBoostRefsets() {
+		boostRefsets[refsetID] = struct{}{}
+	}
+	hints := make(map[int64]struct{}, len(req.GetHints()))
+	for _, conceptID := range req.GetHints() {
+		hints[conceptID] = struct{}{}
+	}
+	var selected map[int64]float64
+	var mostSelected float64
+	if svc.feedback != nil {
+		var err error
+		if selected, err = svc.feedback.Scores(req); err != nil {
+			return nil, err
+		}
+		for _, frequency := range selected {
+			if frequency > mostSelected {
+				mostSelected = frequency
+			}
+		}
+	}
+	result := make([]rankedHit, len(hits))
+	for i, hit := range hits {
+		d := hit.Local
+		if d == nil {
+			var err error
+			if d, err = svc.Description(hit.DescriptionID); err != nil {
+				return nil, err
+			}
+		}
+		pd, err := svc.PreferredSynonym(d.ConceptId, tags)
+		if err != nil {
+			return nil, err
+		}
+		score := 0.0
+		if best > 0 {
+			score += rankRelevance * hit.Score / best
+		}
+		term := strings.ToLower(d.Term)
+		if term == s {
+			score += rankExact
+		} else if strings.HasPrefix(term, s) {
+			score += rankPrefix
+		}
+		if pd.Id == d.Id {
+			score += rankPreferred
+		}
+		score += rankShort / (1 + float64(len(d.Term))/20)
+		if len(boostRefsets) > 0 {
+			refsets, err := svc.ComponentReferenceSets(d.ConceptId)
+			if err != nil {
+				return nil, err
+			}
+			for _, refsetID := range refsets {
+				if _, ok := boostRefsets[refsetID]; ok {
+					score += rankRefset
+					break
+				}
+			}
+		}
+		if len(hints) > 0 {
+			in, err := svc.isAnyOf(d.ConceptId, hints)
+			if err != nil {
+				return nil, err
+			}
+			if in {
+				score += rankHint
+			}
+		}
+		if mostSelected > 0 {
+			score += rankFeedback * selected[d.ConceptId] / mostSelected
+		}
+		result[i] = rankedHit{description: d, preferred: pd, score: score, local: hit.Local != nil}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].score > result[j].score
+	})
+	if len(result) > maximum {
+		result = result[:maximum]
+	}
+	return result, nil
+}
+
+// isAnyOf returns whether the concept is one of, or a descendant of one of, the concepts specified
+func (svc *Svc) isAnyOf(conceptID int64, concepts map[int64]struct{}) (bool, error) {
+	if _, ok := concepts[conceptID]; ok {
+		return true, nil
+	}
+	parents, err := svc.AllParentIDs(conceptID)
+	if err != nil {
+		return false, err
+	}
+	for _, parent := range parents {
+		if _, ok := concepts[parent]; ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,123 @@
+package terminology
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+)
+
+func TestSearchRanking(t *testing.T) {
+	filename := "rank-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	ctx := context.Background()
+	root, synonym, gb := snomed.Root.Integer(), int64(snomed.Synonym), int64(999001261000000100)
+	preferred, acceptable := int64(900000000000548007), int64(900000000000549004)
+	parents := map[int64]int64{
+		6118003:   root,      // demyelinating disease
+		24700007:  6118003,   // multiple sclerosis
+		105969002: root,      // connective tissue disease
+		89155008:  105969002, // systemic sclerosis
+	}
+	concepts := []*snomed.Concept{{Id: root, Active: true}}
+	var relationships []*snomed.Relationship
+	for conceptID, parentID := range parents {
+		concepts = append(concepts, &snomed.Concept{Id: conceptID, Active: true})
+		relationships = append(relationships, &snomed.Relationship{Id: conceptID, SourceId: conceptID, TypeId: snomed.IsA, DestinationId: parentID, Active: true})
+	}
+	terms := []struct {
+		id            int64
+		conceptID     int64
+		term          string
+		acceptability int64
+	}{
+		{1, root, "SNOMED CT Concept", preferred},
+		{2, 6118003, "Demyelinating disease", preferred},
+		{3, 24700007, "Disseminated sclerosis and multiple sclerosis of central nervous system", acceptable},
+		{4, 24700007, "Multiple sclerosis", preferred},
+		{5, 105969002, "Connective tissue disease", preferred},
+		{6, 89155008, "Systemic sclerosis", preferred},
+	}
+	var descriptions []*snomed.Description
+	var items []*snomed.ReferenceSetItem
+	for _, term := range terms {
+		descriptions = append(descriptions, &snomed.Description{Id: term.id, ConceptId: term.conceptID, Active: true, TypeId: synonym, Term: term.term, LanguageCode: "en"})
+		items = append(items, &snomed.ReferenceSetItem{Id: fmt.Sprintf("2d0b9d58-3d1f-4d5e-8d1c-%012d", term.id), RefsetId: gb, ReferencedComponentId: term.id, Active: true,
+			Body: &snomed.ReferenceSetItem_Language{Language: &snomed.LanguageReferenceSet{AcceptabilityId: term.acceptability}}})
+	}
+	items = append(items, &snomed.ReferenceSetItem{Id: "2d0b9d58-3d1f-4d5e-8d1c-000000000100", RefsetId: 991381000000107, ReferencedComponentId: 89155008, Active: true,
+		Body: &snomed.ReferenceSetItem_Simple{Simple: &snomed.SimpleReferenceSet{}}})
+	for _, c := range []interface{}{concepts, descriptions, relationships, items} {
+		if err := svc.Put(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	tags := []language.Tag{language.BritishEnglish}
+	tests := []struct {
+		name     string
+		request  *snomed.SearchRequest
+		expected int64 // the description expected to be ranked first
+	}{
+		{"exact and preferred", &snomed.SearchRequest{S: "multiple scler"}, 4},
+		{"hint", &snomed.SearchRequest{S: "sclerosis", Hints: []int64{105969002}}, 6},
+		{"other hint", &snomed.SearchRequest{S: "sclerosis", Hints: []int64{6118003}}, 4},
+		{"boost refset", &snomed.SearchRequest{S: "sclerosis", BoostRefsets: []int64{991381000000107}}, 6},
+	}
+	for _, test := range tests {
+		response, err := svc.Search(ctx, test.request, tags)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(response.Items) == 0 || response.Items[0].DescriptionId != test.expected {
+			t.Errorf("%s: expected description %d to be ranked first, got %v", test.name, test.expected, response.Items)
+		}
+	}
+	response, err := svc.Search(ctx, &snomed.SearchRequest{S: "sclerosis", MaximumHits: 1}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Items) != 1 {
+		t.Fatalf("expected a single result, got %v", response.Items)
+	}
+	dir, err := ioutil.TempDir("", "feedback")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fb, err := OpenFeedback(dir, DefaultFeedbackHalfLife)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fb.Close()
+	svc.SetFeedback(fb)
+	for _, selection := range []struct {
+		selected []int64 // concepts selected for the search
+		expected int64   // the description then expected to be ranked first
+	}{{[]int64{24700007}, 4}, {[]int64{89155008, 89155008}, 6}} {
+		request := &snomed.SearchRequest{S: "sclerosis"}
+		for _, conceptID := range selection.selected {
+			if err := fb.Record(&snomed.SearchFeedback{Request: request, SelectedConcept: conceptID}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		response, err := svc.Search(ctx, request, tags)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(response.Items) == 0 || response.Items[0].DescriptionId != selection.expected {
+			t.Errorf("feedback: expected description %d to be ranked first, got %v", selection.expected, response.Items)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/wardle/go-terminology/snomed"
+)
+
+// ReferenceSetAttribute describes an attribute of a reference set, as defined by the reference set descriptor
+type ReferenceSetAttribute struct {
+	AttributeID int64  // the attribute description
+	TypeID      int64  // the attribute type
+	Order       uint32 // zero for the referenced component, with additional fields numbered from one
+}
+
+// ReferenceSetAttributes returns the attributes of a reference set, in order, as defined by the reference set descriptor
+func (svc *Svc) ReferenceSetAttributes(refsetID int64) ([]ReferenceSetAttribute, error) {
+	items, err := svc.ComponentFromReferenceSet(snomed.RefsetDescriptorReferenceSet, refsetID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ReferenceSetAttribute, 0, len(items))
+	for _, item := range items {
+		if rd := item.GetRefsetDescriptor(); item.Active && rd != nil {
+			result = append(result, ReferenceSetAttribute{AttributeID: rd.AttributeDescriptionId, TypeID: rd.AttributeTypeId, Order: rd.AttributeOrder})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Order < result[j].Order })
+	return result, nil
+}
+
+// ReferenceSetItemField returns the additional field of a generic reference set item for the attribute specified,
+// as defined by the reference set descriptor, or nil if the item has no such field.
+func (svc *Svc) ReferenceSetItemField(item *snomed.ReferenceSetItem, attributeID int64) (*snomed.ReferenceSetField, error) {
+	attrs, err := svc.ReferenceSetAttributes(item.RefsetId)
+	if err != nil {
+		return nil, err
+	}
+	fields := item.GetGeneric().GetFields()
+	for _, attr := range attrs {
+		if attr.AttributeID == attributeID && attr.Order > 0 && int(attr.Order) <= len(fields) {
+			return fields[attr.Order-1], nil
+		}
+	}
+	return nil, nil
+}
+
+// FindReferenceSetItems returns the items of a reference set with an additional field, of the name specified,
+// with the value specified, as it would appear in a distribution file.
+func (svc *Svc) FindReferenceSetItems(refsetID int64, field string, value string) ([]*snomed.ReferenceSetItem, error) {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(refsetID))
+	result := make([]*snomed.ReferenceSetItem, 0)
+	return result, svc.store.View(func(batch Batch) error {
+		values, err := batch.GetIndexEntries(ixReferenceSetComponentItems, key)
+		if err != nil {
+			return err
+		}
+		for _, v := range values {
+			var item snomed.ReferenceSetItem
+			if err := batch.Get(bkRefsetItems, v[8:], &item); err != nil {
+				return fmt.Errorf("error fetching refset item with identifier %s: %w", v[8:], err)
+			}
+			if f := item.GetGeneric().Field(field); f != nil && f.Format() == value {
+				result = append(result, &item)
+			}
+		}
+		return nil
+	})
+}
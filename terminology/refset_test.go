@@ -0,0 +1,79 @@
+package terminology
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/wardle/go-terminology/snomed"
+)
+
+func TestGenericReferenceSet(t *testing.T) {
+	filename := "refset-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	ctx := context.Background()
+	local := int64(991381000000107)
+	complexMap := int64(447562003)
+	priority, note := int64(900000000000468000), int64(900000000000469008)
+	descriptor := func(id string, refsetID int64, attributeID int64, order uint32) *snomed.ReferenceSetItem {
+		return &snomed.ReferenceSetItem{Id: id, Active: true, RefsetId: snomed.RefsetDescriptorReferenceSet, ReferencedComponentId: refsetID,
+			Body: &snomed.ReferenceSetItem_RefsetDescriptor{RefsetDescriptor: &snomed.RefSetDescriptorReferenceSet{AttributeDescriptionId: attributeID, AttributeOrder: order}}}
+	}
+	generic := func(id string, refsetID int64, componentID int64, fields ...*snomed.ReferenceSetField) *snomed.ReferenceSetItem {
+		return &snomed.ReferenceSetItem{Id: id, Active: true, RefsetId: refsetID, ReferencedComponentId: componentID,
+			Body: &snomed.ReferenceSetItem_Generic{Generic: &snomed.GenericReferenceSet{Fields: fields}}}
+	}
+	integer := func(name string, v int64) *snomed.ReferenceSetField {
+		return &snomed.ReferenceSetField{Name: name, Value: &snomed.ReferenceSetField_IntegerValue{IntegerValue: v}}
+	}
+	str := func(name string, v string) *snomed.ReferenceSetField {
+		return &snomed.ReferenceSetField{Name: name, Value: &snomed.ReferenceSetField_StringValue{StringValue: v}}
+	}
+	items := []*snomed.ReferenceSetItem{
+		descriptor("2d0b9d58-3d1f-4d5e-8d1c-000000000001", local, 449608002, 0),
+		descriptor("2d0b9d58-3d1f-4d5e-8d1c-000000000002", local, priority, 1),
+		descriptor("2d0b9d58-3d1f-4d5e-8d1c-000000000003", local, note, 2),
+		generic("2d0b9d58-3d1f-4d5e-8d1c-000000000004", local, 24700007, integer("priority", 1), str("note", "common")),
+		generic("2d0b9d58-3d1f-4d5e-8d1c-000000000005", local, 6118003, integer("priority", 2), str("note", "rare")),
+		generic("2d0b9d58-3d1f-4d5e-8d1c-000000000006", complexMap, 24700007, integer("mapGroup", 1), str("mapTarget", "G35")),
+	}
+	if err := svc.Put(ctx, items); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	attrs, err := svc.ReferenceSetAttributes(local)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attrs) != 3 || attrs[1].AttributeID != priority || attrs[2].AttributeID != note {
+		t.Fatalf("incorrect reference set attributes: %v", attrs)
+	}
+	item, err := svc.ReferenceSetItem("2d0b9d58-3d1f-4d5e-8d1c-000000000005")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err := svc.ReferenceSetItemField(item, note); err != nil || f.GetStringValue() != "rare" {
+		t.Fatalf("incorrect field for attribute %d: %v (%v)", note, f, err)
+	}
+	found, err := svc.FindReferenceSetItems(local, "priority", "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 || found[0].ReferencedComponentId != 24700007 {
+		t.Fatalf("incorrect items found: %v", found)
+	}
+	mapped, err := svc.MapTarget(complexMap, "G35")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mapped) != 1 || mapped[0].ReferencedComponentId != 24700007 {
+		t.Fatalf("map target of generic reference set not indexed: %v", mapped)
+	}
+}
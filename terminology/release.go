@@ -0,0 +1,90 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"google.golang.org/protobuf/proto"
+)
+
+// ReleaseVersion returns a version for the release contained within this database, as recorded
+// when precomputations were last performed, or the name of the database if no version has been recorded.
+func (svc *Svc) ReleaseVersion() string {
+	if svc.Release != "" {
+		return svc.Release
+	}
+	return filepath.Base(svc.path)
+}
+
+// recordRelease records the release version, the latest effective time of any concept or description,
+// in the database descriptor, together with the latest effective time of each module.
+func (svc *Svc) recordRelease() error {
+	var latest time.Time
+	modules := make(map[int64]time.Time)
+	record := func(moduleID int64, t time.Time) {
+		if t.After(latest) {
+			latest = t
+		}
+		if t.After(modules[moduleID]) {
+			modules[moduleID] = t
+		}
+	}
+	err := svc.store.View(func(batch Batch) error {
+		var c snomed.Concept
+		if err := batch.Iterate(bkConcepts, nil, func(key, value []byte) error {
+			if err := proto.Unmarshal(value, &c); err != nil {
+				return err
+			}
+			record(c.ModuleId, c.EffectiveTime.AsTime())
+			return nil
+		}); err != nil {
+			return err
+		}
+		var d snomed.Description
+		return batch.Iterate(bkDescriptions, nil, func(key, value []byte) error {
+			if err := proto.Unmarshal(value, &d); err != nil {
+				return err
+			}
+			record(d.ModuleId, d.EffectiveTime.AsTime())
+			return nil
+		})
+	})
+	if err != nil || latest.IsZero() {
+		return err
+	}
+	return svc.updateDescriptor(func(d *Descriptor) {
+		d.Release = latest.Format("20060102")
+		d.Modules = make(map[int64]string, len(modules))
+		for moduleID, t := range modules {
+			d.Modules[moduleID] = t.Format("20060102")
+		}
+	})
+}
+
+// Precomputed returns whether precomputations have been performed, as is required for a database to be in service
+func (svc *Svc) Precomputed() bool {
+	return svc.precomputed
+}
+
+// Exists returns whether a terminology database exists at the path specified
+func Exists(path string) bool {
+	_, err := os.Stat(filepath.Join(path, descriptorName))
+	return err == nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RF2Options defines the content of an export in RF2 format
+type RF2Options struct {
+	Release   snomed.ReleaseType // snapshot or delta
+	Since     time.Time          // for a delta, the date of the previous release; only components changed since are exported
+	Modules   []int64            // if not empty, only components from these modules are exported
+	Refsets   []int64            // if not empty, only the items of these reference sets are exported, and no other components
+	Namespace string             // the country and namespace element of filenames, such as GB1000230; default INT
+	Date      time.Time          // the release date element of filenames; default the latest effective time of the content
+}
+
+// ExportRF2 writes the contents of the database, or a subset, as a set of RF2 distribution files within
+// the directory specified, returning the number of components written to each file.
+func (svc *Svc) ExportRF2(ctx context.Context, dir string, opts RF2Options) (map[string]int, error) {
+	if opts.Release == snomed.Full {
+		return nil, fmt.Errorf("unsupported release type for export: %s", opts.Release)
+	}
+	if opts.Release == snomed.Delta && opts.Since.IsZero() {
+		return nil, fmt.Errorf("date of previous release required for a delta")
+	}
+	if opts.Namespace == "" {
+		opts.Namespace = "INT"
+	}
+	modules := make(map[int64]struct{}, len(opts.Modules))
+	for _, moduleID := range opts.Modules {
+		modules[moduleID] = struct{}{}
+	}
+	refsets := make(map[int64]struct{}, len(opts.Refsets))
+	for _, refsetID := range opts.Refsets {
+		refsets[refsetID] = struct{}{}
+	}
+	include := func(moduleID int64, effectiveTime *timestamppb.Timestamp) bool {
+		if _, ok := modules[moduleID]; len(modules) > 0 && !ok {
+			return false
+		}
+		return opts.Release != snomed.Delta || effectiveTime.AsTime().After(opts.Since)
+	}
+	if opts.Date.IsZero() {
+		opts.Date = svc.releaseDate(opts.Modules)
+	}
+	w := snomed.NewWriter(dir, opts.Release, opts.Namespace, opts.Date)
+	for refsetID, tag := range svc.LanguageReferenceSets() {
+		base, _ := tag.Base()
+		w.SetLanguage(refsetID, base.String())
+	}
+	err := svc.writeRF2(ctx, w, len(refsets) == 0, func(item *snomed.ReferenceSetItem) bool {
+		_, ok := refsets[item.RefsetId]
+		return (len(refsets) == 0 || ok) && include(item.ModuleId, item.EffectiveTime)
+	}, include)
+	if err2 := w.Close(); err == nil {
+		err = err2
+	}
+	return w.Files(), err
+}
+
+// releaseDate returns the latest effective time of the modules specified, or of any module if none are specified
+func (svc *Svc) releaseDate(moduleIDs []int64) time.Time {
+	svc.descriptorMu.Lock()
+	defer svc.descriptorMu.Unlock()
+	latest := svc.Release
+	if len(moduleIDs) > 0 {
+		latest = ""
+		for _, moduleID := range moduleIDs {
+			if v := svc.Descriptor.Modules[moduleID]; v > latest {
+				latest = v
+			}
+		}
+	}
+	if date, err := time.Parse("20060102", latest); err == nil {
+		return date
+	}
+	return time.Now()
+}
+
+// writeRF2 writes the components included to the writer; concepts, descriptions and relationships are only written if specified
+func (svc *Svc) writeRF2(ctx context.Context, w *snomed.Writer, components bool, includeItem func(*snomed.ReferenceSetItem) bool, include func(int64, *timestamppb.Timestamp) bool) error {
+	ctx, errs := newFirstError(ctx)
+	defer errs.cancel() // stops the iterators should we return early
+	if components {
+		for c := range svc.IterateConcepts(ctx) {
+			if c.Err != nil {
+				return c.Err
+			}
+			if include(c.Concept.ModuleId, c.Concept.EffectiveTime) {
+				if err := w.WriteConcept(c.Concept); err != nil {
+					return err
+				}
+			}
+		}
+		for batch := range svc.iterateDescriptions(ctx, 5000, errs) {
+			for _, d := range batch {
+				if include(d.ModuleId, d.EffectiveTime) {
+					if err := w.WriteDescription(d); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		if err := errs.get(); err != nil {
+			return err
+		}
+		for batch := range svc.iterateRelationships(ctx, 5000, errs) {
+			for _, r := range batch {
+				if include(r.ModuleId, r.EffectiveTime) {
+					if err := w.WriteRelationship(r); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		if err := errs.get(); err != nil {
+			return err
+		}
+	}
+	for batch := range svc.iterateRefsetItems(ctx, 5000, errs) {
+		for _, item := range batch {
+			if includeItem(item) {
+				if err := w.WriteReferenceSetItem(item); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if err := errs.get(); err != nil {
+		return err
+	}
+	return ctx.Err() // the iterators stop silently if cancelled, so the files may be incomplete
+}
@@ -0,0 +1,113 @@
+package terminology
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestExportRF2(t *testing.T) {
+	filename := "rf2-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	root, err := ioutil.TempDir("", "rf2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	ctx := context.Background()
+	core, local := int64(900000000000207008), int64(999000011000000103)
+	jan, apr := timestamppb.New(time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)), timestamppb.New(time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC))
+	synonym := int64(snomed.Synonym)
+	components := []interface{}{
+		[]*snomed.Concept{
+			{Id: 24700007, ModuleId: core, EffectiveTime: jan, Active: true},
+			{Id: 1231000230108, ModuleId: local, EffectiveTime: apr, Active: true},
+		},
+		[]*snomed.Description{
+			{Id: 41398015, ConceptId: 24700007, ModuleId: core, EffectiveTime: jan, Active: true, Term: "Multiple sclerosis", TypeId: synonym, LanguageCode: "en"},
+			{Id: 1241000230114, ConceptId: 1231000230108, ModuleId: local, EffectiveTime: apr, Active: true, Term: "Relapsing multiple sclerosis", TypeId: synonym, LanguageCode: "en"},
+		},
+		[]*snomed.ReferenceSetItem{
+			{Id: "2d0b9d58-3d1f-4d5e-8d1c-000000000001", ModuleId: core, EffectiveTime: jan, Active: true, RefsetId: 447562003, ReferencedComponentId: 24700007,
+				Body: &snomed.ReferenceSetItem_SimpleMap{SimpleMap: &snomed.SimpleMapReferenceSet{MapTarget: "G35"}}},
+			{Id: "2d0b9d58-3d1f-4d5e-8d1c-000000000002", ModuleId: local, EffectiveTime: apr, Active: true, RefsetId: 991381000000107, ReferencedComponentId: 1231000230108,
+				Body: &snomed.ReferenceSetItem_Simple{Simple: &snomed.SimpleReferenceSet{}}},
+		},
+	}
+	for _, c := range components {
+		if err := svc.Put(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		name     string
+		opts     RF2Options
+		expected map[string]int
+	}{
+		{"all", RF2Options{Date: apr.AsTime()}, map[string]int{
+			"Snapshot/Terminology/sct2_Concept_Snapshot_INT_20200401.txt":         2,
+			"Snapshot/Terminology/sct2_Description_Snapshot-en_INT_20200401.txt":  2,
+			"Snapshot/Refset/Map/der2_sRefset_SimpleMapSnapshot_INT_20200401.txt": 1,
+			"Snapshot/Refset/Content/der2_Refset_SimpleSnapshot_INT_20200401.txt": 1,
+		}},
+		{"module", RF2Options{Modules: []int64{local}, Namespace: "GB1000230", Date: apr.AsTime()}, map[string]int{
+			"Snapshot/Terminology/sct2_Concept_Snapshot_GB1000230_20200401.txt":         1,
+			"Snapshot/Terminology/sct2_Description_Snapshot-en_GB1000230_20200401.txt":  1,
+			"Snapshot/Refset/Content/der2_Refset_SimpleSnapshot_GB1000230_20200401.txt": 1,
+		}},
+		{"refset", RF2Options{Refsets: []int64{447562003}, Date: apr.AsTime()}, map[string]int{
+			"Snapshot/Refset/Map/der2_sRefset_SimpleMapSnapshot_INT_20200401.txt": 1,
+		}},
+		{"delta", RF2Options{Release: snomed.Delta, Since: jan.AsTime(), Date: apr.AsTime()}, map[string]int{
+			"Delta/Terminology/sct2_Concept_Delta_INT_20200401.txt":         1,
+			"Delta/Terminology/sct2_Description_Delta-en_INT_20200401.txt":  1,
+			"Delta/Refset/Content/der2_Refset_SimpleDelta_INT_20200401.txt": 1,
+		}},
+	}
+	for _, test := range tests {
+		dir := filepath.Join(root, test.name)
+		files, err := svc.ExportRF2(ctx, dir, test.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != len(test.expected) {
+			t.Errorf("%s: expected %d files, got %v", test.name, len(test.expected), files)
+		}
+		for f, count := range test.expected {
+			if files[filepath.FromSlash(f)] != count {
+				t.Errorf("%s: expected %d components in %s, got %v", test.name, count, f, files)
+			}
+		}
+	}
+	// a cancelled export is reported as an error, rather than leaving incomplete files as if successful
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := svc.ExportRF2(cancelled, filepath.Join(root, "cancelled"), RF2Options{Date: apr.AsTime()}); err == nil {
+		t.Error("cancelled export did not return an error")
+	}
+	// the local extension can be imported into another database
+	other := "rf2-import-tests.db"
+	svc2, err := NewService(other, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(other)
+	defer svc2.Close()
+	if err := NewImporter(svc2, 500, 0, false).Import(ctx, filepath.Join(root, "module")); err != nil {
+		t.Fatal(err)
+	}
+	if d, err := svc2.Description(1241000230114); err != nil || d.Term != "Relapsing multiple sclerosis" {
+		t.Fatalf("exported description not imported: %v (%v)", d, err)
+	}
+}
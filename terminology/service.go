@@ -25,6 +25,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -36,7 +37,7 @@ import (
 
 const (
 	descriptorName = "sctdb.json"
-	currentVersion = 4
+	currentVersion = 6
 	storeKind      = "level"
 	searchKind     = "bleve"
 )
@@ -47,10 +48,12 @@ const (
 // The current priority of development is correct behaviour rather than optimisation,
 // although most operations are extremely fast already.
 //
-// TODO(mw): once 'correct', profile and optimise the slowest paths, likely
-// adding multiple caches for the most commonly derived data structures and
+// Concepts, preferred synonyms and ancestor sets are held in size-bounded
+// in-process caches, which are invalidated whenever data are written or
+// precomputations are performed.
+//
+// TODO(mw): profile and optimise the remaining slow paths, likely
 // putting more functionality within the backend transaction, when appropriate.
-// It won't be until API complete that we'll understand the pinch points.
 //
 // It is likely that the transitive closure lists will need more caching, but it is
 // unclear whether that is a simple flat list or, more likely now with more complex logic
@@ -62,6 +65,15 @@ type Svc struct {
 	search Search
 	Descriptor
 	availableLanguages []language.Tag
+	languageRefsets    []int64      // installed language reference sets, in the same order as availableLanguages
+	languageMu         sync.RWMutex // guards the available languages, which are refreshed after precomputation
+	caches             *caches
+	precomputed        bool       // whether indices are maintained incrementally on Put
+	descriptorMu       sync.Mutex // serialises changes to the descriptor
+	authoringMu        sync.Mutex // serialises authoring of components in the local extension
+	putMu              sync.Mutex // serialises puts, as each reads the stored version of a component before replacing it
+	feedback           *Feedback   // selections from search results used to rank results, if any
+	dictionary         *Dictionary // local synonyms and abbreviations merged into search results, if any
 }
 
 // Descriptor provides a simple structure for file-backed database versioning
@@ -70,6 +82,13 @@ type Descriptor struct {
 	Version    int32
 	StoreKind  string
 	SearchKind string
+	History    bool   // whether every version of every component is recorded
+	Release    string     // the release version, the latest effective time of any concept or description (YYYYMMDD)
+	Checkpoint Checkpoint // progress of any interrupted import or precomputation
+	Edition    *Edition         `json:",omitempty"` // the edition declared, determining the modules imported and their precedence
+	Modules    map[int64]string `json:",omitempty"` // latest effective time (YYYYMMDD) of any concept or description, by module
+	Extension  *Extension       `json:",omitempty"` // the local extension, if any, in which components are authored
+	Languages  map[int64]string `json:",omitempty"` // languages configured for language reference sets, by reference set
 }
 
 // NewService opens or creates a service at the specified location.
@@ -100,9 +119,15 @@ func NewService(path string, readOnly bool) (*Svc, error) {
 	if err != nil {
 		return nil, err
 	}
-	svc := &Svc{path: path, store: store, search: bleve, Descriptor: *descriptor}
+	svc := &Svc{path: path, store: store, search: bleve, Descriptor: *descriptor, caches: newCaches(DefaultCacheSize)}
 	// cache list of available languages from the current distribution
-	if svc.availableLanguages, err = svc.AvailableLanguages(); err != nil {
+	if err := svc.refreshLanguages(); err != nil {
+		return nil, err
+	}
+	if err := store.View(func(batch Batch) error {
+		svc.precomputed, err = hasEntries(batch, ixConceptDescriptions)
+		return err
+	}); err != nil {
 		return nil, err
 	}
 	return svc, nil
@@ -136,18 +161,36 @@ func createOrOpenDescriptor(path string, storeKind string, searchKind string) (*
 	return &desc, json.Unmarshal(data, &desc)
 }
 
+// saveDescriptor writes the descriptor, replacing any existing descriptor atomically
+// so that it cannot be left incomplete should the process be interrupted.
 func saveDescriptor(path string, descriptor *Descriptor) error {
 	descriptorFilename := filepath.Join(path, descriptorName)
 	data, err := json.Marshal(descriptor)
 	if err != nil {
 		return err
 	}
-	return ioutil.WriteFile(descriptorFilename, data, 0644)
+	tmp := descriptorFilename + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, descriptorFilename)
+}
+
+// updateDescriptor applies a change to the descriptor and saves it
+func (svc *Svc) updateDescriptor(f func(d *Descriptor)) error {
+	svc.descriptorMu.Lock()
+	defer svc.descriptorMu.Unlock()
+	f(&svc.Descriptor)
+	return saveDescriptor(svc.path, &svc.Descriptor)
 }
 
 // Put a slice of SNOMED-CT components into persistent storage.
-// This is polymorphic but expects a slice of SNOMED CT components
+// This is polymorphic but expects a slice of SNOMED CT components.
+// Puts are serialised, so that concurrent batches containing different versions of the same component,
+// as from a full release, always leave the latest version as current.
 func (svc *Svc) Put(context context.Context, components interface{}) error {
+	svc.putMu.Lock()
+	defer svc.putMu.Unlock()
 	var err error
 	switch components := components.(type) {
 	case []*snomed.Concept:
@@ -161,17 +204,25 @@ func (svc *Svc) Put(context context.Context, components interface{}) error {
 	default:
 		err = fmt.Errorf("unknown component type: %T", components)
 	}
+	svc.caches.purge()
 	return err
 }
 
 // Concept returns the concept with the given identifier
 func (svc *Svc) Concept(conceptID int64) (*snomed.Concept, error) {
+	if c, ok := svc.caches.concepts.get(conceptID); ok {
+		return proto.Clone(c.(*snomed.Concept)).(*snomed.Concept), nil // a copy, as a caller may modify it
+	}
 	key := make([]byte, 8)
 	binary.BigEndian.PutUint64(key, uint64(conceptID))
 	var c snomed.Concept
-	return &c, svc.store.View(func(batch Batch) error {
+	err := svc.store.View(func(batch Batch) error {
 		return batch.Get(bkConcepts, key, &c)
 	})
+	if err == nil {
+		svc.caches.concepts.put(conceptID, proto.Clone(&c))
+	}
+	return &c, err
 }
 
 // Concepts returns a list of concepts with the given identifiers
@@ -182,11 +233,16 @@ func (svc *Svc) Concepts(conceptIDs ...int64) ([]*snomed.Concept, error) {
 	r2 := make([]*snomed.Concept, l)
 	err := svc.store.View(func(batch Batch) error {
 		for i, id := range conceptIDs {
+			if c, ok := svc.caches.concepts.get(id); ok {
+				r2[i] = proto.Clone(c.(*snomed.Concept)).(*snomed.Concept)
+				continue
+			}
 			binary.BigEndian.PutUint64(key, uint64(id))
 			if err := batch.Get(bkConcepts, key, &r1[i]); err != nil {
 				return err
 			}
 			r2[i] = &r1[i]
+			svc.caches.concepts.put(id, proto.Clone(r2[i]))
 		}
 		return nil
 	})
@@ -197,17 +253,32 @@ func (svc *Svc) Concepts(conceptIDs ...int64) ([]*snomed.Concept, error) {
 func (svc *Svc) putConcepts(concepts []*snomed.Concept) error {
 	key := make([]byte, 8)
 	var existing snomed.Concept
+	rules := svc.editionRules()
+	written := make(map[int64]*snomed.Concept) // versions written in this batch, not yet visible to Get
 	return svc.store.Update(func(batch Batch) error {
 		for _, c := range concepts {
+			if !rules.includes(c.ModuleId) {
+				continue
+			}
 			binary.BigEndian.PutUint64(key, uint64(c.Id))
-			err := batch.Get(bkConcepts, key, &existing)
-			if err == ErrNotFound {
+			if svc.History {
+				batch.Put(bkConceptHistory, historyKey(key, c.EffectiveTime), c)
+			}
+			prev, ok := written[c.Id]
+			if !ok {
+				err := batch.Get(bkConcepts, key, &existing)
+				if err != nil && err != ErrNotFound {
+					return err
+				}
+				if err == nil {
+					prev = &existing
+				}
+			}
+			if prev == nil || rules.supersedes(c.ModuleId, c.EffectiveTime, prev.ModuleId, prev.EffectiveTime) {
 				batch.Put(bkConcepts, key, c)
-			} else {
-				nt := c.EffectiveTime.AsTime()
-				ot := existing.EffectiveTime.AsTime()
-				if nt.After(ot) {
-					batch.Put(bkConcepts, key, c)
+				written[c.Id] = c
+				if svc.precomputed {
+					markPending(batch, c.Id, false)
 				}
 			}
 		}
@@ -219,17 +290,33 @@ func (svc *Svc) putConcepts(concepts []*snomed.Concept) error {
 func (svc *Svc) putDescriptions(descriptions []*snomed.Description) error {
 	dID := make([]byte, 8)
 	var existing snomed.Description
+	rules := svc.editionRules()
+	written := make(map[int64]*snomed.Description) // versions written in this batch, not yet visible to Get
 	return svc.store.Update(func(batch Batch) error {
 		for _, d := range descriptions {
+			if !rules.includes(d.ModuleId) {
+				continue
+			}
 			binary.BigEndian.PutUint64(dID, uint64(d.Id))
-			err := batch.Get(bkDescriptions, dID, &existing)
-			if err == ErrNotFound {
+			if svc.History {
+				batch.Put(bkDescriptionHistory, historyKey(dID, d.EffectiveTime), d)
+			}
+			prev, ok := written[d.Id]
+			if !ok {
+				err := batch.Get(bkDescriptions, dID, &existing)
+				if err != nil && err != ErrNotFound {
+					return err
+				}
+				if err == nil {
+					prev = &existing
+				}
+			}
+			if prev == nil || rules.supersedes(d.ModuleId, d.EffectiveTime, prev.ModuleId, prev.EffectiveTime) {
 				batch.Put(bkDescriptions, dID, d)
-			} else {
-				nt := d.EffectiveTime.AsTime()
-				ot := existing.EffectiveTime.AsTime()
-				if nt.After(ot) {
-					batch.Put(bkDescriptions, dID, d)
+				written[d.Id] = d
+				if svc.precomputed {
+					svc.indexDescriptions(batch, []*snomed.Description{d})
+					markPending(batch, d.ConceptId, false)
 				}
 			}
 		}
@@ -303,17 +390,37 @@ func (svc *Svc) Descriptions(conceptID int64) (result []*snomed.Description, err
 func (svc *Svc) putRelationships(relationships []*snomed.Relationship) error {
 	rID := make([]byte, 8)
 	var existing snomed.Relationship
+	rules := svc.editionRules()
+	written := make(map[int64]*snomed.Relationship) // versions written in this batch, not yet visible to Get
 	return svc.store.Update(func(batch Batch) error {
 		for _, r := range relationships {
+			if !rules.includes(r.ModuleId) {
+				continue
+			}
 			binary.BigEndian.PutUint64(rID, uint64(r.Id))
-			err := batch.Get(bkRelationships, rID, &existing)
-			if err == ErrNotFound {
+			if svc.History {
+				batch.Put(bkRelationshipHistory, historyKey(rID, r.EffectiveTime), r)
+			}
+			prev, ok := written[r.Id]
+			if !ok {
+				err := batch.Get(bkRelationships, rID, &existing)
+				if err != nil && err != ErrNotFound {
+					return err
+				}
+				if err == nil {
+					prev = &existing
+				}
+			}
+			if prev == nil || rules.supersedes(r.ModuleId, r.EffectiveTime, prev.ModuleId, prev.EffectiveTime) {
 				batch.Put(bkRelationships, rID, r)
-			} else {
-				nt := r.EffectiveTime.AsTime()
-				ot := existing.EffectiveTime.AsTime()
-				if nt.After(ot) {
-					batch.Put(bkRelationships, rID, r)
+				written[r.Id] = r
+				if svc.precomputed {
+					// new IS-A relationships are indexed now, but removal of parents is deferred
+					// until the hierarchy is reconciled in PerformIncrementalPrecomputations.
+					svc.indexRelationships(batch, []*snomed.Relationship{r})
+					if r.TypeId == snomed.IsA {
+						markPending(batch, r.SourceId, true)
+					}
 				}
 			}
 		}
@@ -383,17 +490,38 @@ func (svc *Svc) ReferenceSetItem(itemID string) (*snomed.ReferenceSetItem, error
 
 func (svc *Svc) putReferenceSets(refset []*snomed.ReferenceSetItem) error {
 	var existing snomed.ReferenceSetItem
+	rules := svc.editionRules()
+	written := make(map[string]*snomed.ReferenceSetItem) // versions written in this batch, not yet visible to Get
 	return svc.store.Update(func(batch Batch) error {
 		for _, item := range refset {
+			if !rules.includes(item.ModuleId) {
+				continue
+			}
 			itemID := []byte(item.Id)
-			err := batch.Get(bkRefsetItems, itemID, &existing)
-			if err == ErrNotFound {
+			if svc.History {
+				batch.Put(bkRefsetItemHistory, historyKey(itemID, item.EffectiveTime), item)
+			}
+			prev, ok := written[item.Id]
+			if !ok {
+				err := batch.Get(bkRefsetItems, itemID, &existing)
+				if err != nil && err != ErrNotFound {
+					return err
+				}
+				if err == nil {
+					prev = &existing
+				}
+			}
+			if prev == nil || rules.supersedes(item.ModuleId, item.EffectiveTime, prev.ModuleId, prev.EffectiveTime) {
 				batch.Put(bkRefsetItems, itemID, item)
-			} else {
-				nt := item.EffectiveTime.AsTime()
-				ot := existing.EffectiveTime.AsTime()
-				if nt.After(ot) {
-					batch.Put(bkRefsetItems, itemID, item)
+				written[item.Id] = item
+				if svc.precomputed {
+					if prev != nil {
+						unindexRefsetTarget(batch, prev)
+					}
+					svc.indexRefsetItems(batch, []*snomed.ReferenceSetItem{item})
+					if err := markPendingComponent(batch, item.ReferencedComponentId); err != nil {
+						return err
+					}
 				}
 			}
 		}
@@ -411,13 +539,7 @@ func (svc *Svc) indexRefsetItems(batch Batch, rs []*snomed.ReferenceSetItem) {
 		batch.AddIndexEntry(ixComponentReferenceSets, referencedComponentID, refsetID)
 		batch.AddIndexEntry(ixReferenceSetComponentItems, compoundKey(refsetID, referencedComponentID), itemID)
 		// support cross maps such as simple maps and complex maps
-		var target string
-		if simpleMap := r.GetSimpleMap(); simpleMap != nil {
-			target = simpleMap.GetMapTarget()
-		} else if complexMap := r.GetComplexMap(); complexMap != nil {
-			target = complexMap.GetMapTarget()
-		}
-		if target != "" {
+		if target := refsetItemTarget(r); target != "" {
 			batch.AddIndexEntry(ixRefsetTargetItems, compoundKey(refsetID, []byte(target+" ")), itemID)
 		}
 		// keep track of installed reference sets
@@ -425,6 +547,28 @@ func (svc *Svc) indexRefsetItems(batch Batch, rs []*snomed.ReferenceSetItem) {
 	}
 }
 
+// refsetItemTarget returns the map target for simple and complex map reference set items, and
+// for any other reference set item with a map target field
+func refsetItemTarget(item *snomed.ReferenceSetItem) string {
+	if simpleMap := item.GetSimpleMap(); simpleMap != nil {
+		return simpleMap.GetMapTarget()
+	} else if complexMap := item.GetComplexMap(); complexMap != nil {
+		return complexMap.GetMapTarget()
+	} else if target := item.GetGeneric().Field("mapTarget"); target != nil {
+		return target.Format()
+	}
+	return ""
+}
+
+// unindexRefsetTarget removes the map target index entry for a previous version of a reference set item
+func unindexRefsetTarget(batch Batch, item *snomed.ReferenceSetItem) {
+	if target := refsetItemTarget(item); target != "" {
+		refsetID := make([]byte, 8)
+		binary.BigEndian.PutUint64(refsetID, uint64(item.RefsetId))
+		batch.DeleteIndexEntry(ixRefsetTargetItems, compoundKey(refsetID, []byte(target+" ")), []byte(item.Id))
+	}
+}
+
 // ComponentReferenceSets returns the refset identifiers to which this component is a member
 func (svc *Svc) ComponentReferenceSets(referencedComponentID int64) ([]int64, error) {
 	key := make([]byte, 8)
@@ -774,7 +918,7 @@ func (svc *Svc) IterateConcepts(ctx context.Context) <-chan ConceptStream {
 	return out
 }
 
-func (svc *Svc) iterateDescriptions(ctx context.Context, batchSize int) <-chan []*snomed.Description {
+func (svc *Svc) iterateDescriptions(ctx context.Context, batchSize int, errs *firstError) <-chan []*snomed.Description {
 	ch := make(chan []*snomed.Description)
 	go func() {
 		defer close(ch)
@@ -797,7 +941,7 @@ func (svc *Svc) iterateDescriptions(ctx context.Context, batchSize int) <-chan [
 				return nil
 			})
 			if err != nil {
-				panic(err)
+				return err
 			}
 			if len(job) > 0 {
 				select {
@@ -808,16 +952,13 @@ func (svc *Svc) iterateDescriptions(ctx context.Context, batchSize int) <-chan [
 			}
 			return nil
 		})
-		if err != nil {
-			if err == context.Canceled || err == context.DeadlineExceeded {
-				return
-			}
-			panic(err)
+		if err != nil && err != context.Canceled && err != context.DeadlineExceeded {
+			errs.set(err)
 		}
 	}()
 	return ch
 }
-func (svc *Svc) iterateRelationships(ctx context.Context, batchSize int) <-chan []*snomed.Relationship {
+func (svc *Svc) iterateRelationships(ctx context.Context, batchSize int, errs *firstError) <-chan []*snomed.Relationship {
 	ch := make(chan []*snomed.Relationship)
 	go func() {
 		defer close(ch)
@@ -840,7 +981,7 @@ func (svc *Svc) iterateRelationships(ctx context.Context, batchSize int) <-chan
 				return nil
 			})
 			if err != nil {
-				panic(err)
+				return err
 			}
 			if len(job) > 0 {
 				select {
@@ -851,16 +992,13 @@ func (svc *Svc) iterateRelationships(ctx context.Context, batchSize int) <-chan
 			}
 			return nil
 		})
-		if err != nil {
-			if err == context.Canceled || err == context.DeadlineExceeded {
-				return
-			}
-			panic(err)
+		if err != nil && err != context.Canceled && err != context.DeadlineExceeded {
+			errs.set(err)
 		}
 	}()
 	return ch
 }
-func (svc *Svc) iterateRefsetItems(ctx context.Context, batchSize int) <-chan []*snomed.ReferenceSetItem {
+func (svc *Svc) iterateRefsetItems(ctx context.Context, batchSize int, errs *firstError) <-chan []*snomed.ReferenceSetItem {
 	ch := make(chan []*snomed.ReferenceSetItem)
 	go func() {
 		defer close(ch)
@@ -883,7 +1021,7 @@ func (svc *Svc) iterateRefsetItems(ctx context.Context, batchSize int) <-chan []
 				return nil
 			})
 			if err != nil {
-				panic(err)
+				return err
 			}
 			if len(job) > 0 {
 				select {
@@ -894,11 +1032,8 @@ func (svc *Svc) iterateRefsetItems(ctx context.Context, batchSize int) <-chan []
 			}
 			return nil
 		})
-		if err != nil {
-			if err == context.Canceled || err == context.DeadlineExceeded {
-				return
-			}
-			panic(err)
+		if err != nil && err != context.Canceled && err != context.DeadlineExceeded {
+			errs.set(err)
 		}
 	}()
 	return ch
@@ -1000,39 +1135,170 @@ func (svc *Svc) Statistics(lang string, verbose bool) (Statistics, error) {
 	return stats, nil
 }
 
-// Search searches the SNOMED CT hierarchy
-func (svc *Svc) Search(req *snomed.SearchRequest, tags []language.Tag) (*snomed.SearchResponse, error) {
-	descriptionIDs, err := svc.search.Search(req)
+// Search searches the SNOMED CT hierarchy.
+// Without a search string, the concepts satisfying the filters of the request are listed instead,
+// with their preferred synonyms, in the order requested.
+// Terms are searched in each of the languages requested in turn, until there are results.
+// If the request has an expression constraint or semantic tags, results are limited to the concepts that satisfy
+// that constraint and have one of those semantic tags. If requested, the concepts of the most relevant results are
+// counted by top-level hierarchy, semantic tag and reference set, and the parts of the terms of each result that
+// match the search string are highlighted.
+// If a local dictionary is set, synonyms from that dictionary are searched alongside descriptions, and the search
+// strings resulting from any query expansions in that dictionary are also searched.
+// If there are few or no results, or results were found only by falling back to fuzzy matching, spelling suggestions
+// for the search string are returned, with a corrected search string.
+// Candidate results from the search index are ranked, favouring exact and prefix matches, preferred synonyms
+// in the requested language, shorter terms, concepts in the reference sets to be boosted, and concepts within
+// the context of the hints given.
+func (svc *Svc) Search(ctx context.Context, req *snomed.SearchRequest, tags []language.Tag) (*snomed.SearchResponse, error) {
+	maximum := int(req.GetMaximumHits())
+	if maximum == 0 {
+		maximum = defaultMaximumHits
+	}
+	offset := int(req.GetOffset())
+	if offset < 0 {
+		offset = 0
+	}
+	if strings.TrimSpace(req.GetS()) == "" {
+		return svc.browse(ctx, req, tags, offset, maximum)
+	}
+	candidates := proto.Clone(req).(*snomed.SearchRequest)
+	candidates.MaximumHits = int32(2 * (offset + maximum))
+	if candidates.MaximumHits < minimumCandidates {
+		candidates.MaximumHits = minimumCandidates
+	}
+	ranking := int(candidates.MaximumHits)
+	if req.GetFacets() && candidates.MaximumHits < maxFacetCandidates {
+		candidates.MaximumHits = maxFacetCandidates
+	}
+	var accept func(int64) (bool, error)
+	filter, err := svc.conceptFilter(ctx, req, tags)
 	if err != nil {
 		return nil, err
 	}
-	items := make([]snomed.SearchResponse_Item, len(descriptionIDs))
-	for i, dID := range descriptionIDs {
-		if dID == 0 {
-			continue
+	if filter != nil {
+		accept = func(descriptionID int64) (bool, error) {
+			d, err := svc.Description(descriptionID)
+			if err != nil {
+				return false, err
+			}
+			return filter(d.ConceptId)
 		}
-		d, err := svc.Description(dID)
-		if err != nil {
+	}
+	hits, fellBack, err := svc.search.SearchFiltered(candidates, tags, accept)
+	if err != nil {
+		return nil, err
+	}
+	if fellBack { // results were found only by fuzzy matching, if at all, so expansions and highlighting are fuzzy too
+		candidates.Fuzzy = snomed.SearchRequest_ALWAYS_FUZZY
+	}
+	if svc.dictionary != nil {
+		if hits, err = svc.searchDictionary(req, candidates, tags, accept, filter, hits); err != nil {
 			return nil, err
 		}
-		items[i].DescriptionId = dID
-		items[i].Term = d.Term
-		items[i].ConceptId = d.ConceptId
-		pd, err := svc.PreferredSynonym(d.ConceptId, tags)
-		if err != nil {
+	}
+	valid := hits[:0]
+	for _, hit := range hits {
+		if hit.DescriptionID != 0 || hit.Local != nil {
+			valid = append(valid, hit)
+		}
+	}
+	response := new(snomed.SearchResponse)
+	if len(valid) < fewHits || fellBack {
+		if err := svc.suggest(req.GetS(), response); err != nil {
+			return nil, err
+		}
+	}
+	if req.GetFacets() {
+		facets := svc.newFacetCounter(tags)
+		for _, hit := range valid {
+			d := hit.Local
+			if d == nil {
+				if d, err = svc.Description(hit.DescriptionID); err != nil {
+					return nil, err
+				}
+			}
+			if err := facets.add(d.ConceptId); err != nil {
+				return nil, err
+			}
+		}
+		if err := facets.addTo(response); err != nil {
 			return nil, err
 		}
-		items[i].PreferredTerm = pd.Term
 	}
-	result := make([]*snomed.SearchResponse_Item, len(descriptionIDs))
-	for i := range items {
+	if len(valid) > ranking {
+		valid = valid[:ranking]
+	}
+	ranked, err := svc.rank(req, tags, valid, offset+maximum)
+	if err != nil {
+		return nil, err
+	}
+	if offset > len(ranked) {
+		offset = len(ranked)
+	}
+	ranked = ranked[offset:]
+	items := make([]snomed.SearchResponse_Item, len(ranked))
+	result := make([]*snomed.SearchResponse_Item, len(ranked))
+	for i, hit := range ranked {
+		items[i].DescriptionId = hit.description.Id
+		items[i].Term = hit.description.Term
+		items[i].ConceptId = hit.description.ConceptId
+		items[i].PreferredTerm = hit.preferred.Term
+		items[i].Local = hit.local
+		if req.GetHighlight() {
+			if err := svc.highlight(candidates, &items[i], hit.description, hit.preferred); err != nil {
+				return nil, err
+			}
+		}
 		result[i] = &items[i]
 	}
-	response := new(snomed.SearchResponse)
 	response.Items = result
 	return response, nil
 }
 
+// conceptFilter returns a function accepting only those concepts that satisfy the expression constraint
+// and have one of the semantic tags of the request, or nil if the request has neither.
+func (svc *Svc) conceptFilter(ctx context.Context, req *snomed.SearchRequest, tags []language.Tag) (func(conceptID int64) (bool, error), error) {
+	var concepts map[int64]struct{}
+	if req.GetConstraint() != "" {
+		var err error
+		if concepts, err = svc.ExpandConstraint(ctx, req.GetConstraint()); err != nil {
+			return nil, err
+		}
+	}
+	semanticTags := make(map[string]struct{}, len(req.GetSemanticTags()))
+	for _, tag := range req.GetSemanticTags() {
+		semanticTags[tag] = struct{}{}
+	}
+	if concepts == nil && len(semanticTags) == 0 {
+		return nil, nil
+	}
+	return func(conceptID int64) (bool, error) {
+		if concepts != nil {
+			if _, ok := concepts[conceptID]; !ok {
+				return false, nil
+			}
+		}
+		if len(semanticTags) > 0 {
+			tag, err := svc.SemanticTag(conceptID, tags)
+			if err != nil {
+				return false, err
+			}
+			if _, ok := semanticTags[tag]; !ok {
+				return false, nil
+			}
+		}
+		return true, nil
+	}, nil
+}
+
+// SetFeedback sets the record of selections from search results to be used in ranking
+// the results of future searches, or nil to rank results without feedback.
+// It should be set before the service is used to search.
+func (svc *Svc) SetFeedback(fb *Feedback) {
+	svc.feedback = fb
+}
+
 // IsA tests whether the given concept is a type of the specified
 func (svc *Svc) IsA(concept *snomed.Concept, parent int64) bool {
 	if concept.Id == parent {
@@ -1109,11 +1375,19 @@ func (svc *Svc) PreferredSynonymByReferenceSet(conceptID int64, refsetID int64,
 // PreferredSynonym returns the preferred synonym the specified concept based
 // on the language preferences specified, in order of preference
 func (svc *Svc) PreferredSynonym(conceptID int64, tags []language.Tag) (*snomed.Description, error) {
+	key := newSynonymKey(conceptID, tags)
+	if d, ok := svc.caches.preferredSynonyms.get(key); ok {
+		return proto.Clone(d.(*snomed.Description)).(*snomed.Description), nil // a copy, as a caller may modify it
+	}
 	descs, err := svc.Descriptions(conceptID)
 	if err != nil {
 		return nil, err
 	}
-	return svc.languageMatch(descs, snomed.Synonym, tags)
+	d, err := svc.languageMatch(descs, snomed.Synonym, tags)
+	if err == nil {
+		svc.caches.preferredSynonyms.put(key, proto.Clone(d))
+	}
+	return d, err
 }
 
 // MustGetPreferredSynonym returns the preferred synonym for the specified concept, using the
@@ -1160,17 +1434,40 @@ func (svc *Svc) simpleLanguageMatch(descs []*snomed.Description, typeID snomed.D
 	return ds[i], nil
 }
 
+// languageReferenceSet returns the installed language reference set that best matches the language preferences.
+// A reference set whose tag is exactly that preferred is chosen, as the matcher ignores the private use subtags
+// that may distinguish dialects.
+func (svc *Svc) languageReferenceSet(tags []language.Tag) (int64, bool) {
+	available, refsets := svc.installedLanguages()
+	if len(available) == 0 {
+		return 0, false
+	}
+	if len(tags) > 0 {
+		for i, tag := range available {
+			if tag == tags[0] {
+				return refsets[i], true
+			}
+		}
+	}
+	matcher := language.NewMatcher(available)
+	_, i, _ := matcher.Match(tags...)
+	return refsets[i], true
+}
+
 // refsetLanguageMatch attempts to match the required language by using known language reference sets
 func (svc *Svc) refsetLanguageMatch(descs []*snomed.Description, typeID snomed.DescriptionTypeID, tags []language.Tag) (*snomed.Description, bool, error) {
-	if len(svc.availableLanguages) == 0 {
+	refsetID, ok := svc.languageReferenceSet(tags)
+	if !ok {
 		return nil, false, nil // apparently no language reference sets installed. give up now
 	}
-	matcher := language.NewMatcher(svc.availableLanguages)
-	_, i, _ := matcher.Match(tags...)
-	preferred := LanguageForTag(svc.availableLanguages[i])
+	return svc.refsetPreferred(descs, typeID, refsetID)
+}
+
+// refsetPreferred returns the description of the type specified that is preferred in the language reference set
+func (svc *Svc) refsetPreferred(descs []*snomed.Description, typeID snomed.DescriptionTypeID, refsetID int64) (*snomed.Description, bool, error) {
 	for _, desc := range descs {
 		if desc.TypeId == int64(typeID) {
-			refsetItems, err := svc.ComponentFromReferenceSet(preferred.LanguageReferenceSetIdentifier(), desc.Id)
+			refsetItems, err := svc.ComponentFromReferenceSet(refsetID, desc.Id)
 			if err != nil {
 				return nil, false, err
 			}
@@ -1253,6 +1550,12 @@ func (svc *Svc) AllParents(conceptID int64) ([]*snomed.Concept, error) {
 // AllParentIDs returns a list of the identifiers for all parents
 // TODO(mw): switch to using transitive closure
 func (svc *Svc) AllParentIDs(conceptID int64) ([]int64, error) {
+	if cached, ok := svc.caches.ancestors.get(conceptID); ok {
+		ancestors := cached.([]int64)
+		result := make([]int64, len(ancestors))
+		copy(result, ancestors)
+		return result, nil
+	}
 	parents := make(map[int64]struct{})
 	err := svc.allParents(conceptID, parents)
 	if err != nil {
@@ -1264,6 +1567,9 @@ func (svc *Svc) AllParentIDs(conceptID int64) ([]int64, error) {
 		keys[i] = k
 		i++
 	}
+	cached := make([]int64, len(keys))
+	copy(cached, keys)
+	svc.caches.ancestors.put(conceptID, cached)
 	return keys, nil
 }
 
@@ -1623,6 +1929,7 @@ func (svc *Svc) ConceptReference(conceptID int64, tags []language.Tag) (*snomed.
 
 // ClearPrecomputations clears all pre-computations and indices
 func (svc *Svc) ClearPrecomputations() error {
+	defer svc.caches.purge()
 	// delete all indices
 	svc.store.Update(func(b Batch) error {
 		var wg sync.WaitGroup
@@ -1636,6 +1943,10 @@ func (svc *Svc) ClearPrecomputations() error {
 		wg.Wait()
 		return nil
 	})
+	svc.precomputed = false
+	if err := svc.recordPrecomputeStage(stageNone); err != nil {
+		return err
+	}
 	// close, delete and recreate (empty) search index
 	svc.search.Close()
 	path := filepath.Join(svc.path, "bleve.db")
@@ -1645,17 +1956,15 @@ func (svc *Svc) ClearPrecomputations() error {
 	return err
 }
 
-// PerformPrecomputations runs all pre-computations and generation of indices
+// PerformPrecomputations runs all pre-computations and generation of indices.
+// Progress is checkpointed as each stage completes, and periodically while building the search index,
+// so that if interrupted, precomputations resume from the last checkpoint when next performed.
 func (svc *Svc) PerformPrecomputations(ctx context.Context, batchSize int, verbose bool) error {
 	start := time.Now()
-	ncpu := runtime.NumCPU()
 	if batchSize == 0 {
 		batchSize = 5000
 	}
-	descriptions := svc.iterateDescriptions(ctx, batchSize)
-	relationships := svc.iterateRelationships(ctx, batchSize)
-	refsetItems := svc.iterateRefsetItems(ctx, batchSize)
-	var dWg, rWg, rsWg sync.WaitGroup
+	defer svc.caches.purge()
 	var nd, nr, nri uint32 // counts of components processed
 	done := make(chan bool)
 	if verbose {
@@ -1675,92 +1984,83 @@ func (svc *Svc) PerformPrecomputations(ctx context.Context, batchSize int, verbo
 			}
 		}()
 	}
-	for i := 0; i < ncpu; i++ {
-		dWg.Add(1)
-		go func() {
-			defer dWg.Done()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case ds := <-descriptions:
-					if ds == nil {
-						return
-					}
-					err := svc.store.Update(func(batch Batch) error {
-						svc.indexDescriptions(batch, ds)
-						atomic.AddUint32(&nd, uint32(len(ds)))
-						return nil
-					})
-					if err != nil {
-						panic(err)
-					}
-				}
+	stage := svc.precomputeStage()
+	if stage > stageNone && verbose {
+		fmt.Printf("Resuming precomputations after stage %d\n", stage)
+	}
+	if stage < stageDescriptions {
+		if err := svc.precomputeStep(ctx, stageDescriptions, func(ctx context.Context, errs *firstError) func() (func(Batch), bool) {
+			descriptions := svc.iterateDescriptions(ctx, batchSize, errs)
+			return func() (func(Batch), bool) {
+				ds, ok := <-descriptions
+				return func(batch Batch) {
+					svc.indexDescriptions(batch, ds)
+					atomic.AddUint32(&nd, uint32(len(ds)))
+				}, ok
 			}
-		}()
+		}); err != nil {
+			close(done)
+			return err
+		}
 	}
-	dWg.Wait()
-	for i := 0; i < ncpu; i++ {
-		rWg.Add(1)
-		go func() {
-			defer rWg.Done()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case rs := <-relationships:
-					if rs == nil {
-						return
-					}
-					err := svc.store.Update(func(batch Batch) error {
-						svc.indexRelationships(batch, rs)
-						atomic.AddUint32(&nr, uint32(len(rs)))
-						return nil
-					})
-					if err != nil {
-						panic(err)
-					}
-				}
+	if stage < stageRelationships {
+		if err := svc.precomputeStep(ctx, stageRelationships, func(ctx context.Context, errs *firstError) func() (func(Batch), bool) {
+			relationships := svc.iterateRelationships(ctx, batchSize, errs)
+			return func() (func(Batch), bool) {
+				rs, ok := <-relationships
+				return func(batch Batch) {
+					svc.indexRelationships(batch, rs)
+					atomic.AddUint32(&nr, uint32(len(rs)))
+				}, ok
 			}
-		}()
+		}); err != nil {
+			close(done)
+			return err
+		}
 	}
-	rWg.Wait()
-	for i := 0; i < ncpu; i++ {
-		rsWg.Add(1)
-		go func() {
-			defer rsWg.Done()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case rs := <-refsetItems:
-					if rs == nil {
-						return
-					}
-					err := svc.store.Update(func(batch Batch) error {
-						svc.indexRefsetItems(batch, rs)
-						atomic.AddUint32(&nri, uint32(len(rs)))
-						return nil
-					})
-					if err != nil {
-						panic(err)
-					}
-				}
+	if stage < stageReferenceSets {
+		if err := svc.precomputeStep(ctx, stageReferenceSets, func(ctx context.Context, errs *firstError) func() (func(Batch), bool) {
+			refsetItems := svc.iterateRefsetItems(ctx, batchSize, errs)
+			return func() (func(Batch), bool) {
+				rs, ok := <-refsetItems
+				return func(batch Batch) {
+					svc.indexRefsetItems(batch, rs)
+					atomic.AddUint32(&nri, uint32(len(rs)))
+				}, ok
 			}
-		}()
+		}); err != nil {
+			close(done)
+			return err
+		}
 	}
-	rsWg.Wait()
 	close(done)
 	// and now we have finished indexing, let's build search index
-	if verbose {
-		fmt.Printf("\nBuilding search index...\n")
+	if stage < stageSearchIndex {
+		if verbose {
+			fmt.Printf("\nBuilding search index...\n")
+		}
+		if err := svc.buildSearchIndices(ctx, verbose); err != nil {
+			return err
+		}
+		if err := svc.recordPrecomputeStage(stageSearchIndex); err != nil {
+			return err
+		}
 	}
-
-	if err := svc.buildSearchIndices(ctx, verbose); err != nil {
+	// everything has been indexed, so there is nothing to do incrementally
+	if err := svc.store.Update(func(batch Batch) error {
+		batch.ClearIndexEntries(ixPendingConcepts)
+		return nil
+	}); err != nil {
 		return err
 	}
-	var err error
-	if svc.availableLanguages, err = svc.AvailableLanguages(); err != nil { // refresh list of available languages
+	svc.precomputed = true
+	if err := svc.refreshLanguages(); err != nil { // refresh list of available languages
+		return err
+	}
+	if err := svc.recordRelease(); err != nil {
+		return err
+	}
+	if err := svc.recordPrecomputeStage(stageNone); err != nil {
 		return err
 	}
 	if verbose {
@@ -1768,43 +2068,145 @@ func (svc *Svc) PerformPrecomputations(ctx context.Context, batchSize int, verbo
 	}
 	return nil
 }
+
+// precomputeStep performs a stage of precomputation concurrently, recording its completion.
+// The start function begins iterating the components to be indexed, returning a function that provides
+// the indexing of the next batch of components, or false when there are no more.
+func (svc *Svc) precomputeStep(ctx context.Context, stage int, start func(ctx context.Context, errs *firstError) func() (func(Batch), bool)) error {
+	ctx, errs := newFirstError(ctx)
+	defer errs.cancel()
+	next := start(ctx, errs)
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				index, ok := next()
+				if !ok || ctx.Err() != nil {
+					return
+				}
+				errs.set(svc.store.Update(func(batch Batch) error {
+					index(batch)
+					return nil
+				}))
+			}
+		}()
+	}
+	wg.Wait()
+	if err := errs.get(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return svc.recordPrecomputeStage(stage)
+}
+
+// searchIndexTags returns the language preferences used in building the search index.
+// Each description is indexed in its own language, so these determine only the preferred synonym
+// of each concept, for which the installed languages are used.
+func (svc *Svc) searchIndexTags() []language.Tag {
+	available, _ := svc.installedLanguages()
+	return available
+}
+
+// searchIndexChunk is the number of concepts whose descriptions are indexed before progress is checkpointed
+const searchIndexChunk = 10000
+
+// buildSearchIndices adds the descriptions of every concept to the search index.
+// Concepts are indexed in chunks, in order of identifier, and the last concept of each chunk is checkpointed,
+// so that if interrupted, indexing resumes after the last complete chunk.
 func (svc *Svc) buildSearchIndices(ctx context.Context, verbose bool) error {
-	tags, _, err := language.ParseAcceptLanguage("en-GB") // TODO: better language handling for search index
-	if err != nil {
+	tags := svc.searchIndexTags()
+	ctx, errs := newFirstError(ctx)
+	defer errs.cancel()
+	resume := svc.searchIndexProgress()
+	var total int
+	start := time.Now()
+	chunk := make([]*snomed.Concept, 0, searchIndexChunk)
+	index := func() {
+		n, err := svc.indexConcepts(ctx, chunk, tags)
+		errs.set(err)
+		total += n
+		chunk = chunk[:0]
+		if verbose && total > 0 {
+			elapsed := time.Since(start)
+			fmt.Fprintf(os.Stderr, "\rSearch index: processed %d descriptions in %s. Mean time per description: %s...", total, elapsed, elapsed/time.Duration(total))
+		}
+	}
+	for cs := range svc.IterateConcepts(ctx) {
+		if cs.Err != nil {
+			errs.set(cs.Err)
+			continue // drain the channel
+		}
+		if cs.Concept.Id <= resume || errs.get() != nil {
+			continue
+		}
+		chunk = append(chunk, cs.Concept)
+		if len(chunk) == searchIndexChunk {
+			index()
+		}
+	}
+	if len(chunk) > 0 && errs.get() == nil {
+		index()
+	}
+	if err := errs.get(); err != nil {
 		return err
 	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "\nProcessed total: %d descriptions in %s.\n", total, time.Since(start))
+	return nil
+}
+
+// indexConcepts adds the descriptions of the specified concepts to the search index, and then records
+// the last concept as indexed, returning the number of descriptions indexed.
+func (svc *Svc) indexConcepts(ctx context.Context, concepts []*snomed.Concept, tags []language.Tag) (int, error) {
+	ctx, errs := newFirstError(ctx)
+	defer errs.cancel()
 	batchSize := 10000
 	var total int64
-	start := time.Now()
-	eds := svc.iterateExtendedDescriptions(ctx, tags)
+	work := make(chan *snomed.Concept)
 	var wg sync.WaitGroup
 	for i := 0; i <= runtime.NumCPU(); i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			batch := make([]*snomed.ExtendedDescription, 0)
-			for ed := range eds {
-				batch = append(batch, ed.ExtendedDescription)
-				if len(batch) == batchSize {
-					atomic.AddInt64(&total, int64(batchSize))
-					if verbose {
-						elapsed := time.Since(start)
-						count := atomic.LoadInt64(&total)
-						fmt.Fprintf(os.Stderr, "\rSearch index: processed %d descriptions in %s. Mean time per description: %s...", count, elapsed, elapsed/time.Duration(count))
-					}
-					if err := svc.search.Index(batch); err != nil {
-						panic(err)
-					}
+			for concept := range work {
+				eds, err := svc.extendedDescriptions(concept, tags)
+				if err != nil {
+					errs.set(err)
+					continue // drain the channel
+				}
+				batch = append(batch, eds...)
+				if len(batch) >= batchSize {
+					errs.set(svc.search.Index(batch))
+					atomic.AddInt64(&total, int64(len(batch)))
 					batch = make([]*snomed.ExtendedDescription, 0)
 				}
 			}
-			if err = svc.search.Index(batch); err != nil {
-				panic(err)
-			}
+			errs.set(svc.search.Index(batch))
 			atomic.AddInt64(&total, int64(len(batch)))
 		}()
 	}
+loop:
+	for _, concept := range concepts {
+		select {
+		case work <- concept:
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	close(work)
 	wg.Wait()
-	fmt.Fprintf(os.Stderr, "\nProcessed total: %d descriptions in %s.\n", atomic.LoadInt64(&total), time.Since(start))
-	return nil
+	if err := errs.get(); err != nil {
+		return 0, err
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return int(total), svc.recordSearchIndexProgress(concepts[len(concepts)-1].Id)
 }
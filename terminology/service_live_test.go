@@ -400,7 +400,7 @@ func TestSearch(t *testing.T) {
 		Fuzzy: snomed.SearchRequest_ALWAYS_FUZZY,
 		IsA:   []int64{10363601000001109},
 	}
-	response, err := svc.Search(request, tags)
+	response, err := svc.Search(context.Background(), request, tags)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -420,7 +420,7 @@ func TestSearchOne(t *testing.T) {
 	request := &snomed.SearchRequest{
 		S: "disease",
 	}
-	response, err := svc.Search(request, tags)
+	response, err := svc.Search(context.Background(), request, tags)
 	if err != nil {
 		t.Fatal(err)
 	}
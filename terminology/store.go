@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -18,6 +19,11 @@ const (
 	bkRelationships               // relationships, keyed by SCTID (uint64)
 	bkRefsetItems                 // refset items, keyed by their uuid (string)
 
+	bkConceptHistory      // all versions of concepts, keyed by SCTID-effective_time
+	bkDescriptionHistory  // all versions of descriptions, keyed by SCTID-effective_time
+	bkRelationshipHistory // all versions of relationships, keyed by SCTID-effective_time
+	bkRefsetItemHistory   // all versions of refset items, keyed by uuid-effective_time
+
 	ixConceptDescriptions        // key: concept_id-description_id
 	ixConceptParentRelationships // key: concept_id-relationship_id
 	ixConceptChildRelationships  // key: concept_id-relationship_id
@@ -32,6 +38,8 @@ const (
 
 	ixReferenceSets // key: refset_id
 
+	ixPendingConcepts // key: concept_id-flag, concepts needing re-indexing after incremental update
+
 	lastIndex
 )
 
@@ -41,6 +49,11 @@ var bucketNames = [...][]byte{
 	[]byte("rel"), // key: sct_id value: relationship
 	[]byte("ref"), // key: uuid value: component
 
+	[]byte("cnh"), // key: sct_id-effective_time value: concept
+	[]byte("dsh"), // key: sct_id-effective_time value: description
+	[]byte("rlh"), // key: sct_id-effective_time value: relationship
+	[]byte("rfh"), // key: uuid-effective_time value: refset item
+
 	[]byte("cds"),
 	[]byte("cpr"),
 	[]byte("ccr"),
@@ -54,12 +67,18 @@ var bucketNames = [...][]byte{
 	[]byte("rti"),
 
 	[]byte("rfs"),
+
+	[]byte("pnd"),
 }
 
 func (b bucket) name() []byte {
 	return bucketNames[b]
 }
 
+func (b bucket) String() string {
+	return string(bucketNames[b])
+}
+
 func compoundKey(keys ...[]byte) []byte {
 	return bytes.Join(keys, nil)
 }
@@ -75,6 +94,9 @@ type Batch interface {
 	// Add an index entry for the specified bucket and key, errors deferred until end of batch
 	AddIndexEntry(b bucket, key []byte, value []byte)
 
+	// Delete an index entry for the specified bucket and key, errors deferred until end of batch
+	DeleteIndexEntry(b bucket, key []byte, value []byte)
+
 	// Does an index entry exist?
 	CheckIndexEntry(b bucket, key []byte, value []byte) (bool, error)
 
@@ -110,10 +132,20 @@ var ErrNotFound = errors.New("not found")
 type Search interface {
 	Index(eds []*snomed.ExtendedDescription) error
 	Search(sr *snomed.SearchRequest) ([]int64, error) //TODO: rename autocomplete
+	SearchFiltered(sr *snomed.SearchRequest, tags []language.Tag, accept func(descriptionID int64) (bool, error)) (hits []SearchHit, fellBack bool, err error)
+	Highlight(sr *snomed.SearchRequest, d *snomed.Description) ([]*snomed.SearchResponse_Match, error)
+	Suggest(s string, maximum int) ([]*snomed.SearchResponse_Suggestion, error)
 	Statistics() (uint64, error)
 	Close() error
 }
 
+// SearchHit is a result from a search, with its relevance as determined by the search service
+type SearchHit struct {
+	DescriptionID int64
+	Score         float64
+	Local         *snomed.Description // a synonym from a local dictionary, without an identifier, rather than a description
+}
+
 // Statistics on the persistence store
 type Statistics struct {
 	concepts      uint64
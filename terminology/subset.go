@@ -0,0 +1,243 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+)
+
+// SubsetOptions defines the content of a subset of the terminology to be extracted into a new database
+type SubsetOptions struct {
+	Concepts  map[int64]struct{} // concepts required, such as from the expansion of an expression constraint
+	Roots     []int64            // concepts required, together with all of their descendants
+	Refsets   []int64            // reference sets to include, with their items for the components of the subset
+	Languages []language.Tag     // languages of the descriptions and language reference sets to include; default all
+}
+
+// SubsetSummary records the number of components extracted into a subset
+type SubsetSummary struct {
+	Concepts          int `json:"concepts"`
+	Descriptions      int `json:"descriptions"`
+	Relationships     int `json:"relationships"`
+	ReferenceSetItems int `json:"referenceSetItems"`
+}
+
+// ExtractSubset extracts the closure of the concepts specified into a new database at the path specified,
+// which is then precomputed, so that it is ready for use, including search.
+// The closure includes the ancestors of each concept, the types and values of their attributes, and the
+// metadata concepts of the model, together with descriptions in the languages specified, and the items of
+// language reference sets for those languages, and of the reference sets specified.
+func (svc *Svc) ExtractSubset(ctx context.Context, path string, opts SubsetOptions) (summary SubsetSummary, err error) {
+	if !svc.precomputed {
+		return summary, fmt.Errorf("database must be precomputed before extracting a subset")
+	}
+	if Exists(path) {
+		return summary, fmt.Errorf("database already exists at %s", path)
+	}
+	concepts, err := svc.closure(ctx, opts)
+	if err != nil {
+		return summary, err
+	}
+	bases := make(map[string]struct{}, len(opts.Languages))
+	for _, tag := range opts.Languages {
+		base, _ := tag.Base()
+		bases[base.String()] = struct{}{}
+	}
+	refsets := make(map[int64]struct{})
+	for _, refsetID := range opts.Refsets {
+		refsets[refsetID] = struct{}{}
+	}
+	for refsetID, tag := range svc.LanguageReferenceSets() {
+		base, _ := tag.Base()
+		if _, ok := bases[base.String()]; ok || len(bases) == 0 {
+			refsets[refsetID] = struct{}{}
+		}
+	}
+	// build the subset alongside the destination, so that a failed extraction leaves nothing behind
+	dir, err := ioutil.TempDir(filepath.Dir(path), filepath.Base(path)+".")
+	if err != nil {
+		return summary, err
+	}
+	defer os.RemoveAll(dir) // unless renamed once complete
+	target, err := NewService(dir, false)
+	if err != nil {
+		return summary, err
+	}
+	summary, err = svc.writeSubset(ctx, target, concepts, refsets, bases)
+	if err2 := target.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		return summary, err
+	}
+	return summary, os.Rename(dir, path)
+}
+
+// writeSubset stores the concepts specified in the target, together with their descriptions in the languages
+// specified, their relationships and the items of the reference sets specified, and then precomputes the target
+func (svc *Svc) writeSubset(ctx context.Context, target *Svc, concepts map[int64]struct{}, refsets map[int64]struct{}, bases map[string]struct{}) (summary SubsetSummary, err error) {
+	cs := make([]*snomed.Concept, 0, len(concepts))
+	var ds []*snomed.Description
+	var rs []*snomed.Relationship
+	descriptions := make(map[int64]struct{})
+	for conceptID := range concepts {
+		c, err := svc.Concept(conceptID)
+		if err != nil {
+			return summary, fmt.Errorf("could not extract concept %d: %w", conceptID, err)
+		}
+		cs = append(cs, c)
+		descs, err := svc.Descriptions(conceptID)
+		if err != nil {
+			return summary, err
+		}
+		named := false // whether the concept can be named in the languages specified; if not, include all languages
+		for _, d := range descs {
+			_, ok := bases[d.LanguageCode]
+			named = named || (ok && d.Active && d.TypeId == int64(snomed.Synonym))
+		}
+		for _, d := range descs {
+			if _, ok := bases[d.LanguageCode]; ok || !named || len(bases) == 0 {
+				ds = append(ds, d)
+				descriptions[d.Id] = struct{}{}
+			}
+		}
+		rels, err := svc.ParentRelationships(conceptID)
+		if err != nil {
+			return summary, err
+		}
+		for _, rel := range rels {
+			_, hasType := concepts[rel.TypeId]
+			_, hasDestination := concepts[rel.DestinationId]
+			if hasType && hasDestination {
+				rs = append(rs, rel)
+			}
+		}
+	}
+	var items []*snomed.ReferenceSetItem
+	ctx, errs := newFirstError(ctx)
+	defer errs.cancel()
+	for batch := range svc.iterateRefsetItems(ctx, 5000, errs) {
+		for _, item := range batch {
+			if includeSubsetItem(item, concepts, descriptions, refsets) {
+				items = append(items, item)
+			}
+		}
+	}
+	if err := errs.get(); err != nil {
+		return summary, err
+	}
+	for _, components := range []interface{}{cs, ds, rs, items} {
+		if err := target.Put(ctx, components); err != nil {
+			return summary, err
+		}
+	}
+	summary = SubsetSummary{Concepts: len(cs), Descriptions: len(ds), Relationships: len(rs), ReferenceSetItems: len(items)}
+	return summary, target.PerformPrecomputations(ctx, 0, false)
+}
+
+// includeSubsetItem returns whether a reference set item should be included in a subset
+func includeSubsetItem(item *snomed.ReferenceSetItem, concepts map[int64]struct{}, descriptions map[int64]struct{}, refsets map[int64]struct{}) bool {
+	switch item.RefsetId {
+	case snomed.RefsetDescriptorReferenceSet: // describes the reference sets included
+		_, ok := refsets[item.ReferencedComponentId]
+		return ok
+	case snomed.ModuleDependencyRefset:
+		_, ok := concepts[item.ReferencedComponentId]
+		return ok
+	}
+	if _, ok := refsets[item.RefsetId]; !ok {
+		return false
+	}
+	_, isConcept := concepts[item.ReferencedComponentId]
+	_, isDescription := descriptions[item.ReferencedComponentId]
+	return isConcept || isDescription
+}
+
+// closure returns the concepts required for a subset; those specified, the descendants of the roots,
+// and the reference sets, together with the metadata concepts of the model, and, recursively,
+// the types and values of the active relationships of each concept, which include their ancestors.
+func (svc *Svc) closure(ctx context.Context, opts SubsetOptions) (map[int64]struct{}, error) {
+	work := make([]int64, 0, len(opts.Concepts))
+	for conceptID := range opts.Concepts {
+		work = append(work, conceptID)
+	}
+	work = append(work, opts.Refsets...)
+	for _, rootID := range append([]int64{snomed.ModelComponent}, opts.Roots...) {
+		work = append(work, rootID)
+		descendants := make(map[int64]struct{})
+		if err := svc.descendants(ctx, rootID, descendants, 0); err != nil {
+			return nil, err
+		}
+		for conceptID := range descendants {
+			work = append(work, conceptID)
+		}
+	}
+	result := make(map[int64]struct{})
+	for len(work) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		conceptID := work[len(work)-1]
+		work = work[:len(work)-1]
+		if _, done := result[conceptID]; done {
+			continue
+		}
+		result[conceptID] = struct{}{}
+		rels, err := svc.ParentRelationships(conceptID)
+		if err != nil {
+			return nil, err
+		}
+		for _, rel := range rels {
+			if rel.Active {
+				work = append(work, rel.TypeId, rel.DestinationId)
+			}
+		}
+	}
+	return result, nil
+}
+
+// descendants adds all of the descendants of the concept specified to the result.
+// If a maximum is specified, the walk stops with ErrTooManyConcepts once the result is larger.
+func (svc *Svc) descendants(ctx context.Context, conceptID int64, result map[int64]struct{}, maximum int) error {
+	work := []int64{conceptID}
+	for len(work) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		children, err := svc.Children(work[len(work)-1])
+		if err != nil {
+			return err
+		}
+		work = work[:len(work)-1]
+		for _, child := range children {
+			if _, done := result[child]; !done {
+				result[child] = struct{}{}
+				work = append(work, child)
+			}
+			if maximum > 0 && len(result) > maximum {
+				return ErrTooManyConcepts
+			}
+		}
+	}
+	return nil
+}
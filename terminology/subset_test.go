@@ -0,0 +1,105 @@
+package terminology
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+)
+
+func TestExtractSubset(t *testing.T) {
+	filename, subset := "subset-tests.db", "subset-extracted-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer os.RemoveAll(subset)
+	defer svc.Close()
+	ctx := context.Background()
+	root, findingSite, synonym := snomed.Root.Integer(), int64(363698007), int64(snomed.Synonym)
+	parents := map[int64]int64{
+		snomed.ModelComponent: root,
+		snomed.IsA:            snomed.ModelComponent,
+		findingSite:           snomed.ModelComponent,
+		synonym:               snomed.ModelComponent,
+		404684003:             root,      // clinical finding
+		6118003:               404684003, // demyelinating disease
+		24700007:              6118003,   // multiple sclerosis
+		22298006:              404684003, // myocardial infarction
+		123037004:             root,      // body structure
+		21483005:              123037004, // central nervous system
+		80891009:              123037004, // heart
+	}
+	concepts := []*snomed.Concept{{Id: root, Active: true}}
+	descriptions := []*snomed.Description{
+		{Id: 1, ConceptId: root, Active: true, TypeId: synonym, Term: "SNOMED CT Concept", LanguageCode: "en"},
+		{Id: 2, ConceptId: 24700007, Active: true, TypeId: synonym, Term: "Sclérose en plaques", LanguageCode: "fr"},
+	}
+	var relationships []*snomed.Relationship
+	for conceptID, parentID := range parents {
+		concepts = append(concepts, &snomed.Concept{Id: conceptID, Active: true})
+		n := int64(len(concepts) + 100)
+		descriptions = append(descriptions, &snomed.Description{Id: n, ConceptId: conceptID, Active: true, TypeId: synonym, Term: "Concept", LanguageCode: "en"})
+		relationships = append(relationships, &snomed.Relationship{Id: n, SourceId: conceptID, TypeId: snomed.IsA, DestinationId: parentID, Active: true})
+	}
+	relationships = append(relationships,
+		&snomed.Relationship{Id: 1001, SourceId: 24700007, TypeId: findingSite, DestinationId: 21483005, RelationshipGroup: 1, Active: true},
+		&snomed.Relationship{Id: 1002, SourceId: 22298006, TypeId: findingSite, DestinationId: 80891009, RelationshipGroup: 1, Active: true})
+	for _, c := range []interface{}{concepts, descriptions, relationships} {
+		if err := svc.Put(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := svc.ExtractSubset(ctx, subset, SubsetOptions{Roots: []int64{6118003}}); err == nil {
+		t.Fatal("extracted subset from a database that has not been precomputed")
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	// a failed extraction, here of a reference set that does not exist, leaves nothing behind
+	if _, err := svc.ExtractSubset(ctx, subset, SubsetOptions{Refsets: []int64{991381000000107}}); err == nil {
+		t.Fatal("extracted subset with a reference set that does not exist")
+	}
+	if partial, _ := filepath.Glob(subset + "*"); len(partial) != 0 {
+		t.Fatalf("failed extraction left files behind: %v", partial)
+	}
+	summary, err := svc.ExtractSubset(ctx, subset, SubsetOptions{Roots: []int64{6118003}, Languages: []language.Tag{language.French}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// root, model component, is-a, finding site, synonym, clinical finding, demyelinating disease, multiple sclerosis, body structure, CNS
+	if summary.Concepts != 10 || summary.Relationships != 10 || summary.Descriptions != 10 {
+		t.Fatalf("unexpected subset extracted: %+v", summary)
+	}
+	if _, err := svc.ExtractSubset(ctx, subset, SubsetOptions{Roots: []int64{6118003}}); err == nil {
+		t.Fatal("subset extracted over an existing database")
+	}
+	extracted, err := NewService(subset, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer extracted.Close()
+	if !extracted.Precomputed() {
+		t.Fatal("subset not precomputed")
+	}
+	if _, err := extracted.Concept(22298006); err == nil {
+		t.Fatal("concept outside the closure extracted")
+	}
+	if d, err := extracted.PreferredSynonym(24700007, []language.Tag{language.French}); err != nil || d.Term != "Sclérose en plaques" {
+		t.Fatalf("description in language requested not extracted: %v (%v)", d, err)
+	}
+	if parents, err := extracted.AllParentIDs(24700007); err != nil || len(parents) != 3 {
+		t.Fatalf("ancestors not extracted: %v (%v)", parents, err)
+	}
+	response, err := extracted.Search(ctx, &snomed.SearchRequest{S: "sclerose", MaximumHits: 10}, []language.Tag{language.French})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Items) != 1 || response.Items[0].ConceptId != 24700007 {
+		t.Fatalf("subset not searchable: %v", response.Items)
+	}
+}
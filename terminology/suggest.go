@@ -0,0 +1,62 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"strings"
+
+	"github.com/wardle/go-terminology/snomed"
+)
+
+const (
+	fewHits        = 5 // the number of results of a search below which spelling suggestions are made
+	maxSuggestions = 5 // the maximum number of spelling suggestions for each token of a search string
+)
+
+// suggest adds spelling suggestions for the search string to the search response, and the search string
+// corrected using the best suggestion for each token, if there are any suggestions.
+// The words of the terms in any local dictionary, as well as those of descriptions, are recognised and suggested.
+func (svc *Svc) suggest(s string, response *snomed.SearchResponse) error {
+	suggestions, err := svc.suggestions(s)
+	if err != nil || len(suggestions) == 0 {
+		return err
+	}
+	best := make(map[string]string)
+	for _, suggestion := range suggestions {
+		if _, exists := best[suggestion.Token]; !exists {
+			best[suggestion.Token] = suggestion.Suggestion
+		}
+	}
+	tokens := strings.Fields(s)
+	for i, token := range tokens {
+		if suggestion, ok := best[token]; ok {
+			tokens[i] = suggestion
+		}
+	}
+	response.Suggestions = suggestions
+	response.CorrectedS = strings.Join(tokens, " ")
+	return nil
+}
+
+// suggestions returns spelling suggestions for the tokens of a search string, from the words of the terms of
+// descriptions and, if the search index supports it, of the entries in any local dictionary
+func (svc *Svc) suggestions(s string) ([]*snomed.SearchResponse_Suggestion, error) {
+	bs, ok := svc.search.(*bleveService)
+	if !ok || svc.dictionary == nil {
+		return svc.search.Suggest(s, maxSuggestions)
+	}
+	return suggestWords(s, maxSuggestions, bs, svc.dictionary.bs)
+}
@@ -0,0 +1,108 @@
+package terminology
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wardle/go-terminology/snomed"
+	"golang.org/x/text/language"
+)
+
+func TestSuggest(t *testing.T) {
+	filename := "suggest-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	ctx := context.Background()
+	root := snomed.Root.Integer()
+	terms := map[int64][]string{
+		root:      {"SNOMED CT Concept"},
+		233604007: {"Pneumonia"},
+		53084003:  {"Bacterial pneumonia"},
+		205237003: {"Pneumonitis"},
+		62315008:  {"Diarrhoea", "Diarrhea"},
+		19213003:  {"Infective diarrhoea", "Infectious diarrhea", "Infectious diarrhoea"},
+	}
+	var concepts []*snomed.Concept
+	var descriptions []*snomed.Description
+	var relationships []*snomed.Relationship
+	for conceptID, synonyms := range terms {
+		concepts = append(concepts, &snomed.Concept{Id: conceptID, Active: true})
+		for i, term := range synonyms {
+			descriptions = append(descriptions, &snomed.Description{Id: conceptID*10 + int64(i), ConceptId: conceptID, Active: true, TypeId: int64(snomed.Synonym), Term: term, LanguageCode: "en"})
+		}
+		if conceptID != root {
+			relationships = append(relationships, &snomed.Relationship{Id: conceptID, SourceId: conceptID, TypeId: snomed.IsA, DestinationId: root, Active: true})
+		}
+	}
+	for _, c := range []interface{}{concepts, descriptions, relationships} {
+		if err := svc.Put(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	tags := []language.Tag{language.BritishEnglish}
+	tests := []struct {
+		s           string
+		corrected   string
+		suggestions []string
+	}{
+		{"pnuemonia", "pneumonia", []string{"pneumonia"}},
+		{"infective diarhoea", "infective diarrhoea", []string{"diarrhoea", "diarrhea"}},
+		{"Infectous diarrhea", "infectious diarrhea", []string{"infectious"}},
+		{"pneum", "", nil},
+		{"pneumonia", "", nil},
+	}
+	for _, test := range tests {
+		response, err := svc.Search(ctx, &snomed.SearchRequest{S: test.s}, tags)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.CorrectedS != test.corrected || len(response.Suggestions) != len(test.suggestions) {
+			t.Errorf("%s: expected '%s' %v, got '%s' %v", test.s, test.corrected, test.suggestions, response.CorrectedS, response.Suggestions)
+			continue
+		}
+		for i, suggestion := range response.Suggestions {
+			if suggestion.Suggestion != test.suggestions[i] {
+				t.Errorf("%s: expected %v, got %v", test.s, test.suggestions, response.Suggestions)
+				break
+			}
+		}
+	}
+	// words of the terms in a local dictionary are recognised, and suggested
+	dir, err := ioutil.TempDir("", "suggest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	d, err := OpenDictionary(filepath.Join(dir, "dictionary"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	if err := d.Add([]*snomed.DictionaryEntry{{Term: "Pneumococcal", Expansion: "streptococcus pneumoniae"}}); err != nil {
+		t.Fatal(err)
+	}
+	svc.SetDictionary(d)
+	response, err := svc.Search(ctx, &snomed.SearchRequest{S: "pneumococcal"}, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Suggestions) != 0 {
+		t.Errorf("word in local dictionary corrected: %v", response.Suggestions)
+	}
+	if response, err = svc.Search(ctx, &snomed.SearchRequest{S: "pnuemococcal"}, tags); err != nil {
+		t.Fatal(err)
+	}
+	if response.CorrectedS != "pneumococcal" {
+		t.Errorf("word in local dictionary not suggested: '%s' %v", response.CorrectedS, response.Suggestions)
+	}
+}
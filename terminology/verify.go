@@ -0,0 +1,403 @@
+// Copyright 2020 Mark Wardle / Eldrix Ltd
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//        http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+//
+
+package terminology
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"google.golang.org/protobuf/proto"
+)
+
+// VerificationReport is a structured report of the integrity of the terminology store
+type VerificationReport struct {
+	Concepts              int                 `json:"concepts"`
+	Descriptions          int                 `json:"descriptions"`
+	Relationships         int                 `json:"relationships"`
+	RefsetItems           int                 `json:"refsetItems"`
+	Precomputed           bool                `json:"precomputed"`           // whether precomputed indices were checked
+	DanglingRelationships []DanglingReference `json:"danglingRelationships"` // relationships with a missing source, destination or type
+	OrphanedDescriptions  []DanglingReference `json:"orphanedDescriptions"`  // descriptions without a concept
+	DanglingRefsetItems   []DanglingReference `json:"danglingRefsetItems"`   // refset items referencing missing components
+	Cycles                [][]int64           `json:"cycles"`                // cycles in the IS-A graph, as lists of concept identifiers
+	MissingNames          []MissingName       `json:"missingNames"`          // active concepts without an FSN or preferred synonym
+	MissingIndexEntries   []MissingIndexEntry `json:"missingIndexEntries"`   // components missing from precomputed indices
+}
+
+// DanglingReference records a reference from one component to another that does not exist
+type DanglingReference struct {
+	ComponentID string `json:"componentId"` // identifier of the relationship, description or refset item
+	Field       string `json:"field"`       // the field containing the reference, e.g. "destinationId"
+	TargetID    int64  `json:"targetId"`    // identifier of the missing component
+}
+
+// MissingName records an active concept without a fully specified name or preferred synonym in an installed language
+type MissingName struct {
+	ConceptID int64                    `json:"conceptId"`
+	Language  string                   `json:"language"`
+	TypeID    snomed.DescriptionTypeID `json:"typeId"`
+}
+
+// MissingIndexEntry records a component that is absent from a precomputed index
+type MissingIndexEntry struct {
+	Index       string `json:"index"`
+	ComponentID string `json:"componentId"`
+}
+
+// OK returns whether the verification found no problems
+func (vr *VerificationReport) OK() bool {
+	return len(vr.DanglingRelationships) == 0 &&
+		len(vr.OrphanedDescriptions) == 0 &&
+		len(vr.DanglingRefsetItems) == 0 &&
+		len(vr.Cycles) == 0 &&
+		len(vr.MissingNames) == 0 &&
+		len(vr.MissingIndexEntries) == 0
+}
+
+func (vr *VerificationReport) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Checked %d concepts, %d descriptions, %d relationships and %d reference set items\n", vr.Concepts, vr.Descriptions, vr.Relationships, vr.RefsetItems))
+	b.WriteString(fmt.Sprintf("Dangling relationships: %d\n", len(vr.DanglingRelationships)))
+	b.WriteString(fmt.Sprintf("Descriptions without concepts: %d\n", len(vr.OrphanedDescriptions)))
+	b.WriteString(fmt.Sprintf("Reference set items referencing missing components: %d\n", len(vr.DanglingRefsetItems)))
+	b.WriteString(fmt.Sprintf("Cycles in IS-A hierarchy: %d\n", len(vr.Cycles)))
+	b.WriteString(fmt.Sprintf("Concepts without FSN or preferred synonym: %d\n", len(vr.MissingNames)))
+	if vr.Precomputed {
+		b.WriteString(fmt.Sprintf("Missing index entries: %d\n", len(vr.MissingIndexEntries)))
+	} else {
+		b.WriteString("Warning: precomputations not performed; indices and names not checked.\n")
+	}
+	return b.String()
+}
+
+// Verify scans the store, checking referential integrity, the IS-A hierarchy,
+// the presence of names in installed languages and the precomputed indices.
+func (svc *Svc) Verify(ctx context.Context, verbose bool) (*VerificationReport, error) {
+	start := time.Now()
+	report := new(VerificationReport)
+	concepts := make(map[int64]struct{})
+	active := make([]int64, 0)
+	parents := make(map[int64][]int64) // active IS-A relationships
+	err := svc.store.View(func(batch Batch) error {
+		var err error
+		if report.Precomputed, err = hasEntries(batch, ixConceptDescriptions); err != nil {
+			return err
+		}
+		if verbose {
+			log.Printf("Checking concepts...")
+		}
+		if err := batch.Iterate(bkConcepts, nil, func(key, value []byte) error {
+			c := new(snomed.Concept)
+			if err := proto.Unmarshal(value, c); err != nil {
+				return err
+			}
+			concepts[c.Id] = struct{}{}
+			if c.Active {
+				active = append(active, c.Id)
+			}
+			report.Concepts++
+			return ctx.Err()
+		}); err != nil {
+			return err
+		}
+		if verbose {
+			log.Printf("Checking descriptions...")
+		}
+		if err := batch.Iterate(bkDescriptions, nil, func(key, value []byte) error {
+			d := new(snomed.Description)
+			if err := proto.Unmarshal(value, d); err != nil {
+				return err
+			}
+			report.Descriptions++
+			id := strconv.FormatInt(d.Id, 10)
+			if _, ok := concepts[d.ConceptId]; !ok {
+				report.OrphanedDescriptions = append(report.OrphanedDescriptions, DanglingReference{ComponentID: id, Field: "conceptId", TargetID: d.ConceptId})
+			}
+			if report.Precomputed {
+				if err := report.checkIndex(batch, ixConceptDescriptions, id, d.ConceptId, d.Id); err != nil {
+					return err
+				}
+			}
+			return ctx.Err()
+		}); err != nil {
+			return err
+		}
+		if verbose {
+			log.Printf("Checking relationships...")
+		}
+		if err := batch.Iterate(bkRelationships, nil, func(key, value []byte) error {
+			r := new(snomed.Relationship)
+			if err := proto.Unmarshal(value, r); err != nil {
+				return err
+			}
+			report.Relationships++
+			id := strconv.FormatInt(r.Id, 10)
+			for _, ref := range []reference{{"sourceId", r.SourceId}, {"destinationId", r.DestinationId}, {"typeId", r.TypeId}} {
+				if _, ok := concepts[ref.id]; !ok {
+					report.DanglingRelationships = append(report.DanglingRelationships, DanglingReference{ComponentID: id, Field: ref.field, TargetID: ref.id})
+				}
+			}
+			isA := r.Active && r.TypeId == snomed.IsA
+			if isA {
+				parents[r.SourceId] = append(parents[r.SourceId], r.DestinationId)
+			}
+			if report.Precomputed {
+				if err := report.checkIndex(batch, ixConceptParentRelationships, id, r.SourceId, r.Id); err != nil {
+					return err
+				}
+				if err := report.checkIndex(batch, ixConceptChildRelationships, id, r.DestinationId, r.Id); err != nil {
+					return err
+				}
+				if isA {
+					if err := report.checkIndex(batch, ixConceptParents, id, r.SourceId, r.DestinationId); err != nil {
+						return err
+					}
+					if err := report.checkIndex(batch, ixConceptChildren, id, r.DestinationId, r.SourceId); err != nil {
+						return err
+					}
+				}
+			}
+			return ctx.Err()
+		}); err != nil {
+			return err
+		}
+		if verbose {
+			log.Printf("Checking reference set items...")
+		}
+		return batch.Iterate(bkRefsetItems, nil, func(key, value []byte) error {
+			item := new(snomed.ReferenceSetItem)
+			if err := proto.Unmarshal(value, item); err != nil {
+				return err
+			}
+			report.RefsetItems++
+			if _, ok := concepts[item.RefsetId]; !ok {
+				report.DanglingRefsetItems = append(report.DanglingRefsetItems, DanglingReference{ComponentID: item.Id, Field: "refsetId", TargetID: item.RefsetId})
+			}
+			refs := []reference{{"referencedComponentId", item.ReferencedComponentId}}
+			if association := item.GetAssociation(); association != nil {
+				refs = append(refs, reference{"targetComponentId", association.TargetComponentId})
+			}
+			for _, ref := range refs {
+				exists, err := componentExists(batch, concepts, ref.id)
+				if err != nil {
+					return err
+				}
+				if !exists {
+					report.DanglingRefsetItems = append(report.DanglingRefsetItems, DanglingReference{ComponentID: item.Id, Field: ref.field, TargetID: ref.id})
+				}
+			}
+			if report.Precomputed {
+				if err := report.checkIndex(batch, ixComponentReferenceSets, item.Id, item.ReferencedComponentId, item.RefsetId); err != nil {
+					return err
+				}
+				refsetID := make([]byte, 8)
+				componentID := make([]byte, 8)
+				binary.BigEndian.PutUint64(refsetID, uint64(item.RefsetId))
+				binary.BigEndian.PutUint64(componentID, uint64(item.ReferencedComponentId))
+				ok, err := batch.CheckIndexEntry(ixReferenceSetComponentItems, compoundKey(refsetID, componentID), []byte(item.Id))
+				if err != nil {
+					return err
+				}
+				if !ok {
+					report.MissingIndexEntries = append(report.MissingIndexEntries, MissingIndexEntry{Index: ixReferenceSetComponentItems.String(), ComponentID: item.Id})
+				}
+				if ok, err = batch.CheckIndexEntry(ixReferenceSets, refsetID, nil); err != nil {
+					return err
+				}
+				if !ok {
+					report.MissingIndexEntries = append(report.MissingIndexEntries, MissingIndexEntry{Index: ixReferenceSets.String(), ComponentID: item.Id})
+				}
+			}
+			return ctx.Err()
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if verbose {
+		log.Printf("Checking IS-A hierarchy...")
+	}
+	report.Cycles = findCycles(parents)
+	if report.Precomputed {
+		if verbose {
+			log.Printf("Checking names for %d active concepts...", len(active))
+		}
+		if err := svc.verifyNames(ctx, active, report); err != nil {
+			return nil, err
+		}
+	}
+	if verbose {
+		log.Printf("Verification complete. Total time: %s", time.Since(start))
+	}
+	return report, nil
+}
+
+// reference is a named field in a component that refers to another component
+type reference struct {
+	field string
+	id    int64
+}
+
+// verifyNames checks that each concept has a fully specified name and a preferred synonym in each installed language
+func (svc *Svc) verifyNames(ctx context.Context, conceptIDs []int64, report *VerificationReport) error {
+	available, refsets := svc.installedLanguages()
+	for _, conceptID := range conceptIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		descs, err := svc.Descriptions(conceptID)
+		if err != nil {
+			return err
+		}
+		for _, typeID := range []snomed.DescriptionTypeID{snomed.FullySpecifiedName, snomed.Synonym} {
+			if len(available) == 0 {
+				if _, err := svc.simpleLanguageMatch(descs, typeID, nil); err != nil {
+					report.MissingNames = append(report.MissingNames, MissingName{ConceptID: conceptID, TypeID: typeID})
+				}
+				continue
+			}
+			for i, tag := range available {
+				_, found, err := svc.refsetPreferred(descs, typeID, refsets[i])
+				if err != nil {
+					return err
+				}
+				if !found {
+					report.MissingNames = append(report.MissingNames, MissingName{ConceptID: conceptID, Language: tag.String(), TypeID: typeID})
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkIndex checks that the specified index contains an entry for the key and value
+func (vr *VerificationReport) checkIndex(batch Batch, idx bucket, componentID string, key int64, value int64) error {
+	k := make([]byte, 8)
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(key))
+	binary.BigEndian.PutUint64(v, uint64(value))
+	ok, err := batch.CheckIndexEntry(idx, k, v)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		vr.MissingIndexEntries = append(vr.MissingIndexEntries, MissingIndexEntry{Index: idx.String(), ComponentID: componentID})
+	}
+	return nil
+}
+
+// componentExists determines whether the specified component exists, using its partition identifier
+func componentExists(batch Batch, concepts map[int64]struct{}, id int64) (bool, error) {
+	sctID := snomed.Identifier(id)
+	var b bucket
+	switch {
+	case sctID.IsConcept():
+		_, ok := concepts[id]
+		return ok, nil
+	case sctID.IsDescription():
+		b = bkDescriptions
+	case sctID.IsRelationship():
+		b = bkRelationships
+	default:
+		return false, nil
+	}
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(id))
+	return hasKey(batch, b, key)
+}
+
+// hasKey returns whether the bucket contains the specified key, without unmarshalling its value
+func hasKey(batch Batch, b bucket, key []byte) (bool, error) {
+	found := false
+	err := batch.Iterate(b, key, func(k, v []byte) error {
+		found = true
+		return errStopIteration
+	})
+	if err == errStopIteration {
+		err = nil
+	}
+	return found, err
+}
+
+// hasEntries returns whether the bucket contains any entries
+func hasEntries(batch Batch, b bucket) (bool, error) {
+	return hasKey(batch, b, nil)
+}
+
+var errStopIteration = errors.New("stop iteration")
+
+// findCycles finds cycles in a directed graph, using an iterative depth-first search
+func findCycles(parents map[int64][]int64) [][]int64 {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	ids := make([]int64, 0, len(parents))
+	for id := range parents {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] }) // deterministic results
+	state := make(map[int64]int, len(parents))
+	cycles := make([][]int64, 0)
+	type frame struct {
+		id   int64
+		next int
+	}
+	for _, root := range ids {
+		if state[root] != unvisited {
+			continue
+		}
+		state[root] = inProgress
+		stack := []frame{{id: root}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			ps := parents[top.id]
+			if top.next == len(ps) {
+				state[top.id] = done
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			p := ps[top.next]
+			top.next++
+			switch state[p] {
+			case unvisited:
+				state[p] = inProgress
+				stack = append(stack, frame{id: p})
+			case inProgress:
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i].id == p {
+						cycle := make([]int64, 0, len(stack)-i)
+						for _, f := range stack[i:] {
+							cycle = append(cycle, f.id)
+						}
+						cycles = append(cycles, cycle)
+						break
+					}
+				}
+			}
+		}
+	}
+	return cycles
+}
@@ -0,0 +1,95 @@
+package terminology
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/wardle/go-terminology/snomed"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFindCycles(t *testing.T) {
+	parents := map[int64][]int64{
+		1: {2},
+		2: {3},
+		3: {1},
+		4: {5},
+	}
+	cycles := findCycles(parents)
+	if len(cycles) != 1 || len(cycles[0]) != 3 {
+		t.Fatalf("failed to find cycle: %v", cycles)
+	}
+	if cycles := findCycles(map[int64][]int64{1: {2}, 2: {3}, 4: {2}}); len(cycles) != 0 {
+		t.Fatalf("found cycles in an acyclic graph: %v", cycles)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	filename := "verify-tests.db"
+	svc, err := NewService(filename, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(filename)
+	defer svc.Close()
+	ctx := context.Background()
+	d := timestamppb.New(time.Date(2017, 7, 1, 0, 0, 0, 0, time.UTC))
+	concepts := []*snomed.Concept{
+		{Id: 24700007, EffectiveTime: d, Active: true},
+		{Id: 6118003, EffectiveTime: d, Active: true},
+		{Id: snomed.IsA, EffectiveTime: d, Active: true},
+	}
+	descriptions := []*snomed.Description{
+		{Id: 41398015, ConceptId: 24700007, EffectiveTime: d, Active: true, Term: "Multiple sclerosis", TypeId: int64(snomed.Synonym), LanguageCode: "en"},
+		{Id: 11161018, ConceptId: 6118003, EffectiveTime: d, Active: true, Term: "Demyelinating disease", TypeId: int64(snomed.Synonym), LanguageCode: "en"},
+		{Id: 181114011, ConceptId: snomed.IsA, EffectiveTime: d, Active: true, Term: "Is a", TypeId: int64(snomed.Synonym), LanguageCode: "en"},
+		{Id: 11161017, ConceptId: 999999999, EffectiveTime: d, Active: true, Term: "Orphan", TypeId: int64(snomed.Synonym), LanguageCode: "en"},
+	}
+	relationships := []*snomed.Relationship{
+		{Id: 1, Active: true, EffectiveTime: d, SourceId: 24700007, DestinationId: 6118003, TypeId: snomed.IsA},
+		{Id: 2, Active: true, EffectiveTime: d, SourceId: 6118003, DestinationId: 24700007, TypeId: snomed.IsA},
+		{Id: 3, Active: true, EffectiveTime: d, SourceId: 24700007, DestinationId: 123456789, TypeId: snomed.IsA},
+	}
+	if err := svc.Put(ctx, concepts); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.Put(ctx, descriptions); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.Put(ctx, relationships); err != nil {
+		t.Fatal(err)
+	}
+	report, err := svc.Verify(ctx, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.OK() {
+		t.Fatal("verification did not find any problems")
+	}
+	if report.Precomputed {
+		t.Fatal("verification reported precomputations before they were performed")
+	}
+	if len(report.OrphanedDescriptions) != 1 || report.OrphanedDescriptions[0].TargetID != 999999999 {
+		t.Fatalf("orphaned description not reported: %v", report.OrphanedDescriptions)
+	}
+	if len(report.DanglingRelationships) != 1 || report.DanglingRelationships[0].Field != "destinationId" {
+		t.Fatalf("dangling relationship not reported: %v", report.DanglingRelationships)
+	}
+	if len(report.Cycles) != 1 {
+		t.Fatalf("cycle not reported: %v", report.Cycles)
+	}
+	if err := svc.PerformPrecomputations(ctx, 500, false); err != nil {
+		t.Fatal(err)
+	}
+	if report, err = svc.Verify(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	if !report.Precomputed || len(report.MissingIndexEntries) != 0 {
+		t.Fatalf("incorrect index verification: %v", report.MissingIndexEntries)
+	}
+	if len(report.MissingNames) == 0 {
+		t.Fatal("missing fully specified names not reported")
+	}
+}